@@ -4,9 +4,20 @@ function onCommand_read(user, item, room) {
     SendUserMessage(user.UserId(), "You thumb through your <ansi fg=\"item\">"+item.Name(true)+"</ansi> book.");
     SendRoomMessage(room.RoomId(), user.GetCharacterName(true)+" thumbs through their <ansi fg=\"item\">"+item.Name(true)+"</ansi> book.", user.UserId());   
 
-    if ( user.LearnSpell("curepoison") ) {
+    var learnedSpell = user.LearnSpell("curepoison");
+    var learnedRecipe = user.LearnRecipe("nightshade-draught");
+
+    if ( learnedSpell ) {
         SendUserMessage(user.UserId(), "You discover the the <ansi fg=\"spell-helpful\">Cure Poison</ansi> spell. It can remove a deadly ailment.");
         SendUserMessage(user.UserId(), "Check your <ansi fg=\"command\">spellbook</ansi>.");
+    }
+
+    if ( learnedRecipe ) {
+        SendUserMessage(user.UserId(), "You learn how to brew a <ansi fg=\"item\">nightshade draught</ansi>.");
+        SendUserMessage(user.UserId(), "Check your <ansi fg=\"command\">recipes</ansi>.");
+    }
+
+    if ( learnedSpell || learnedRecipe ) {
         SendUserMessage(user.UserId(), "The book disinigrates in your hands.");
         item.SetUsesLeft(0);
     }
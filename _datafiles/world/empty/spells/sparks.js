@@ -1,6 +1,7 @@
 
 DMG_DICE_QTY = 1;
 DMG_DICE_SIDES = 3;
+DMG_ELEMENT = 'electricity';
 
 // Called when the casting is initialized (cast command)
 // Return false if the casting should be ignored/aborted
@@ -27,7 +28,8 @@ function onMagic(sourceActor, targetActors) {
 
     for (var i = 0; i < targetActors.length; i++) {
         
-        dmgAmt = UtilDiceRoll(DMG_DICE_QTY, DMG_DICE_SIDES) + 1;
+        // Sparks deal electricity damage, so resistances and vulnerabilities apply
+        dmgAmt = targetActors[i].TakeDamage(UtilDiceRoll(DMG_DICE_QTY, DMG_DICE_SIDES) + 1, DMG_ELEMENT);
         dmgAmtStr = String(dmgAmt);
 
         targetUserId = targetActors[i].UserId();
@@ -53,9 +55,6 @@ function onMagic(sourceActor, targetActors) {
             SendRoomMessage(roomId, sourceName+' stops chanting and fires a shower of sparks at themselves, hurting themselves.', sourceUserId, targetUserId);
 
         }
-
-        // Apply the dmg to the target
-        targetActors[i].AddHealth(dmgAmt * -1);
     }
     
 }
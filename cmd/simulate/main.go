@@ -0,0 +1,178 @@
+// ///////////////////////////////////////////////////////////////
+// Combat balance simulator
+//
+//	Loads the world datafiles without starting any listeners and
+//	pits character builds against mobs many times over, reporting
+//	win rates, rounds to kill, damage per round and crit rates.
+//
+//	Run from the project root:
+//	  go run ./cmd/simulate -mobs 1,2 -race human -level 5 -weapons 10001,10002
+//	  go run ./cmd/simulate -mobs 1 -builds builds.yaml -fights 5000 -csv
+//
+// ///////////////////////////////////////////////////////////////
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/GoMudEngine/GoMud/internal/buffs"
+	"github.com/GoMudEngine/GoMud/internal/configs"
+	"github.com/GoMudEngine/GoMud/internal/items"
+	"github.com/GoMudEngine/GoMud/internal/mobs"
+	"github.com/GoMudEngine/GoMud/internal/mudlog"
+	"github.com/GoMudEngine/GoMud/internal/races"
+	"github.com/GoMudEngine/GoMud/internal/spells"
+	"github.com/GoMudEngine/GoMud/internal/util"
+	"gopkg.in/yaml.v2"
+)
+
+func main() {
+
+	buildsFile := flag.String(`builds`, ``, `YAML file of character builds to simulate`)
+	raceName := flag.String(`race`, `human`, `Race of the build when no builds file is given`)
+	level := flag.Int(`level`, 1, `Level of the build when no builds file is given`)
+	weaponList := flag.String(`weapons`, `0`, `Comma separated weapon item ids to compare (0 is unarmed)`)
+	mobList := flag.String(`mobs`, ``, `Comma separated mob ids to fight (required)`)
+	mobLevel := flag.Int(`moblevel`, 0, `Force the level of the mobs (0 uses their normal level)`)
+	fights := flag.Int(`fights`, 1000, `How many fights to run for each build and mob`)
+	maxRounds := flag.Int(`maxrounds`, 200, `Rounds before a fight is called a draw`)
+	seed := flag.Int64(`seed`, 0, `Random seed, for repeatable results (0 picks one)`)
+	asCSV := flag.Bool(`csv`, false, `Output CSV instead of tables`)
+	flag.Parse()
+
+	logLevel := os.Getenv(`LOG_LEVEL`)
+	if logLevel == `` {
+		logLevel = `LOW`
+	}
+	mudlog.SetupLogger(nil, logLevel, os.Getenv(`LOG_PATH`), false)
+
+	mobIds, err := parseIds(*mobList)
+	if err != nil || len(mobIds) == 0 {
+		fmt.Fprintln(os.Stderr, `-mobs must be a comma separated list of mob ids`)
+		flag.Usage()
+		os.Exit(1)
+	}
+
+	// Only what combat needs. Nothing is listening and nothing gets saved.
+	configs.ReloadConfig()
+	spells.LoadSpellFiles()
+	buffs.LoadDataFiles()
+	items.LoadDataFiles()
+	races.LoadDataFiles()
+	mobs.LoadDataFiles()
+
+	if *seed != 0 {
+		util.SeedRand(*seed)
+	}
+
+	var builds []Build
+	if *buildsFile != `` {
+		builds, err = loadBuilds(*buildsFile)
+	} else {
+		builds, err = weaponBuilds(*raceName, *level, *weaponList)
+	}
+
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+
+	sim := Simulation{
+		Fights:    *fights,
+		MaxRounds: *maxRounds,
+		MobLevel:  *mobLevel,
+	}
+
+	results := []Result{}
+	for _, build := range builds {
+		for _, mobId := range mobIds {
+			result, err := sim.Run(build, mobs.MobId(mobId))
+			if err != nil {
+				fmt.Fprintln(os.Stderr, err)
+				os.Exit(1)
+			}
+			results = append(results, result)
+		}
+	}
+
+	if *asCSV {
+		err = writeCSV(os.Stdout, results)
+	} else {
+		err = writeTables(os.Stdout, results, util.GetRandSeed())
+	}
+
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+}
+
+func loadBuilds(filePath string) ([]Build, error) {
+
+	bytes, err := os.ReadFile(filePath)
+	if err != nil {
+		return nil, err
+	}
+
+	builds := []Build{}
+	if err := yaml.Unmarshal(bytes, &builds); err != nil {
+		return nil, fmt.Errorf("%s: %w", filePath, err)
+	}
+
+	if len(builds) == 0 {
+		return nil, fmt.Errorf("%s: no builds found", filePath)
+	}
+
+	return builds, nil
+}
+
+// One build per weapon, so weapons can be compared on an otherwise identical character
+func weaponBuilds(raceName string, level int, weaponList string) ([]Build, error) {
+
+	weaponIds, err := parseIds(weaponList)
+	if err != nil {
+		return nil, fmt.Errorf("-weapons: %w", err)
+	}
+
+	builds := []Build{}
+	for _, weaponId := range weaponIds {
+
+		name := `unarmed`
+		if weaponId > 0 {
+			spec := items.GetItemSpec(weaponId)
+			if spec == nil {
+				return nil, fmt.Errorf("weapon %d not found", weaponId)
+			}
+			name = spec.Name
+		}
+
+		builds = append(builds, Build{
+			Name:   fmt.Sprintf(`%s %d %s`, raceName, level, name),
+			Race:   raceName,
+			Level:  level,
+			Weapon: weaponId,
+		})
+	}
+
+	return builds, nil
+}
+
+func parseIds(list string) ([]int, error) {
+	ids := []int{}
+	for _, part := range strings.Split(list, `,`) {
+		part = strings.TrimSpace(part)
+		if part == `` {
+			continue
+		}
+		id, err := strconv.Atoi(part)
+		if err != nil {
+			return nil, err
+		}
+		ids = append(ids, id)
+	}
+	return ids, nil
+}
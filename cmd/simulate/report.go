@@ -0,0 +1,144 @@
+package main
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"text/tabwriter"
+)
+
+func pct(part int, whole int) float64 {
+	if whole == 0 {
+		return 0
+	}
+	return float64(part) / float64(whole) * 100
+}
+
+func avg(total int, count int) float64 {
+	if count == 0 {
+		return 0
+	}
+	return float64(total) / float64(count)
+}
+
+// Damage per round, grouped by the kind of weapon doing it
+type subtypeTotals struct {
+	Subtype      string
+	Rounds       int
+	AttackRounds int
+	Hits         int
+	Crits        int
+	DamageDealt  int
+}
+
+func totalsBySubtype(results []Result) []subtypeTotals {
+
+	bySubtype := map[string]*subtypeTotals{}
+	for _, r := range results {
+		t, ok := bySubtype[r.Subtype]
+		if !ok {
+			t = &subtypeTotals{Subtype: r.Subtype}
+			bySubtype[r.Subtype] = t
+		}
+		t.Rounds += r.Rounds
+		t.AttackRounds += r.AttackRounds
+		t.Hits += r.Hits
+		t.Crits += r.Crits
+		t.DamageDealt += r.DamageDealt
+	}
+
+	totals := []subtypeTotals{}
+	for _, t := range bySubtype {
+		totals = append(totals, *t)
+	}
+
+	sort.Slice(totals, func(i, j int) bool {
+		return avg(totals[i].DamageDealt, totals[i].Rounds) > avg(totals[j].DamageDealt, totals[j].Rounds)
+	})
+
+	return totals
+}
+
+func writeTables(w io.Writer, results []Result, seed int64) error {
+
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', tabwriter.AlignRight)
+
+	fmt.Fprintf(w, "Seed: %d\n\n", seed)
+
+	fmt.Fprintln(tw, "Build\tMob\tFights\tWin%\tLoss%\tDraw%\tRounds to Kill\tDmg/Round\tTaken/Round\tHit%\tCrit%\tMob Hit%\tMob Crit%\t")
+	for _, r := range results {
+		fmt.Fprintf(tw, "%s\t%s\t%d\t%.1f\t%.1f\t%.1f\t%.1f\t%.2f\t%.2f\t%.1f\t%.1f\t%.1f\t%.1f\t\n",
+			r.Build,
+			r.Mob,
+			r.Fights,
+			pct(r.Wins, r.Fights),
+			pct(r.Losses, r.Fights),
+			pct(r.Draws, r.Fights),
+			avg(r.RoundsToKill, r.Wins),
+			avg(r.DamageDealt, r.Rounds),
+			avg(r.DamageTaken, r.Rounds),
+			pct(r.Hits, r.AttackRounds),
+			pct(r.Crits, r.Hits),
+			pct(r.MobHits, r.MobAttackRnds),
+			pct(r.MobCrits, r.MobHits),
+		)
+	}
+	if err := tw.Flush(); err != nil {
+		return err
+	}
+
+	fmt.Fprintln(w)
+
+	fmt.Fprintln(tw, "Weapon Subtype\tRounds\tDmg/Round\tDmg/Attack\tHit%\tCrit%\t")
+	for _, t := range totalsBySubtype(results) {
+		fmt.Fprintf(tw, "%s\t%d\t%.2f\t%.2f\t%.1f\t%.1f\t\n",
+			t.Subtype,
+			t.Rounds,
+			avg(t.DamageDealt, t.Rounds),
+			avg(t.DamageDealt, t.AttackRounds),
+			pct(t.Hits, t.AttackRounds),
+			pct(t.Crits, t.Hits),
+		)
+	}
+
+	return tw.Flush()
+}
+
+// One row per build and mob, with the raw totals so they can be summed up elsewhere
+func writeCSV(w io.Writer, results []Result) error {
+
+	cw := csv.NewWriter(w)
+
+	cw.Write([]string{
+		`build`, `mob`, `subtype`, `fights`, `wins`, `losses`, `draws`,
+		`rounds`, `rounds_to_kill`, `attack_rounds`, `hits`, `crits`, `damage_dealt`,
+		`mob_attack_rounds`, `mob_hits`, `mob_crits`, `damage_taken`,
+	})
+
+	for _, r := range results {
+		cw.Write([]string{
+			r.Build,
+			r.Mob,
+			r.Subtype,
+			strconv.Itoa(r.Fights),
+			strconv.Itoa(r.Wins),
+			strconv.Itoa(r.Losses),
+			strconv.Itoa(r.Draws),
+			strconv.Itoa(r.Rounds),
+			strconv.Itoa(r.RoundsToKill),
+			strconv.Itoa(r.AttackRounds),
+			strconv.Itoa(r.Hits),
+			strconv.Itoa(r.Crits),
+			strconv.Itoa(r.DamageDealt),
+			strconv.Itoa(r.MobAttackRnds),
+			strconv.Itoa(r.MobHits),
+			strconv.Itoa(r.MobCrits),
+			strconv.Itoa(r.DamageTaken),
+		})
+	}
+
+	cw.Flush()
+	return cw.Error()
+}
@@ -0,0 +1,217 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/GoMudEngine/GoMud/internal/characters"
+	"github.com/GoMudEngine/GoMud/internal/combat"
+	"github.com/GoMudEngine/GoMud/internal/events"
+	"github.com/GoMudEngine/GoMud/internal/items"
+	"github.com/GoMudEngine/GoMud/internal/mobs"
+	"github.com/GoMudEngine/GoMud/internal/races"
+	"github.com/GoMudEngine/GoMud/internal/users"
+)
+
+// A character to put through the simulator
+//
+// Example builds file:
+//
+//	# builds.yaml
+//	- name: dagger rogue
+//	  race: elf
+//	  level: 10
+//	  weapon: 10004
+//	  offhand: 10004
+//	  armor: [20008, 20007]
+//	  skills:
+//	    dual-wield: 2
+type Build struct {
+	Name    string         `yaml:"name"`
+	Race    string         `yaml:"race"`
+	Level   int            `yaml:"level"`
+	Weapon  int            `yaml:"weapon,omitempty"`  // Item id, 0 for unarmed
+	Offhand int            `yaml:"offhand,omitempty"` // Item id of a shield or second weapon
+	Armor   []int          `yaml:"armor,omitempty"`   // Item ids of anything else worn
+	Skills  map[string]int `yaml:"skills,omitempty"`  // Skill name to level
+}
+
+type Simulation struct {
+	Fights    int
+	MaxRounds int
+	MobLevel  int // Forces the mob level when above 0
+}
+
+// Totals for one build fighting one mob many times
+type Result struct {
+	Build   string
+	Mob     string
+	Subtype string // Weapon subtype the build attacks with
+
+	Fights int
+	Wins   int
+	Losses int
+	Draws  int
+
+	Rounds        int // Every round fought
+	RoundsToKill  int // Rounds fought in fights that were won
+	AttackRounds  int // Rounds the build attacked, rather than waiting on a slow weapon
+	Hits          int
+	Crits         int
+	DamageDealt   int
+	DamageTaken   int
+	MobHits       int
+	MobCrits      int
+	MobAttackRnds int
+}
+
+// Builds the character once so that every fight uses the same stats
+func (b Build) newUser() (*users.UserRecord, error) {
+
+	race, ok := races.FindRace(b.Race)
+	if !ok {
+		return nil, fmt.Errorf("build %q: race %q not found", b.Name, b.Race)
+	}
+
+	user := users.NewUserRecord(1, 0)
+	c := user.Character
+
+	c.Name = b.Name
+	c.RaceId = race.Id()
+	c.Level = max(b.Level, 1)
+
+	// Stats are trained the same way mobs are
+	c.StatPoints = c.Level
+	c.AutoTrain()
+
+	for skillName, level := range b.Skills {
+		c.SetSkill(skillName, level)
+	}
+
+	for _, itemId := range append([]int{b.Weapon, b.Offhand}, b.Armor...) {
+		if itemId == 0 {
+			continue
+		}
+
+		itm := items.New(itemId)
+		if itm.ItemId == 0 {
+			return nil, fmt.Errorf("build %q: item %d not found", b.Name, itemId)
+		}
+
+		if _, worn, reason := c.Wear(itm); !worn {
+			return nil, fmt.Errorf("build %q: can't wear %s: %s", b.Name, itm.Name(), reason)
+		}
+	}
+
+	c.Validate(true)
+
+	return user, nil
+}
+
+func (s Simulation) Run(build Build, mobId mobs.MobId) (Result, error) {
+
+	user, err := build.newUser()
+	if err != nil {
+		return Result{}, err
+	}
+
+	spec := mobs.GetMobSpec(mobId)
+	if spec == nil {
+		return Result{}, fmt.Errorf("mob %d not found", mobId)
+	}
+
+	result := Result{
+		Build:   build.Name,
+		Mob:     spec.Character.Name,
+		Subtype: string(items.Generic),
+	}
+
+	if user.Character.Equipment.Weapon.ItemId > 0 {
+		result.Subtype = string(user.Character.Equipment.Weapon.GetSpec().Subtype)
+	} else if race := races.GetRace(user.Character.RaceId); race != nil && race.UnarmedName != `` {
+		result.Subtype = race.UnarmedName
+	}
+
+	for i := 0; i < s.Fights; i++ {
+
+		mob := mobs.NewMobById(mobId, 0, s.MobLevel)
+
+		user.Character.Health = user.Character.HealthMax.Value
+		user.Character.SetAggro(0, mob.InstanceId, characters.DefaultAttack)
+		mob.Character.SetAggro(user.UserId, 0, characters.DefaultAttack)
+
+		s.fight(user, mob, &result)
+
+		mobs.DestroyInstance(mob.InstanceId)
+
+		// Nothing is listening, this just empties out the sounds and messages combat queued up
+		events.ProcessEvents()
+	}
+
+	return result, nil
+}
+
+// Fights until someone drops or MaxRounds pass, in the same order as a real combat round
+func (s Simulation) fight(user *users.UserRecord, mob *mobs.Mob, result *Result) {
+
+	result.Fights++
+
+	for round := 1; round <= s.MaxRounds; round++ {
+
+		result.Rounds++
+
+		if readyToAttack(user.Character.Aggro) {
+			healthBefore := mob.Character.Health
+
+			attack := combat.AttackPlayerVsMob(user, mob)
+
+			result.AttackRounds++
+			result.DamageDealt += healthBefore - mob.Character.Health
+			if attack.Hit {
+				result.Hits++
+			}
+			if attack.Crit {
+				result.Crits++
+			}
+		}
+
+		if mob.Character.Health < 1 {
+			result.Wins++
+			result.RoundsToKill += round
+			return
+		}
+
+		if readyToAttack(mob.Character.Aggro) {
+			healthBefore := user.Character.Health
+
+			attack := combat.AttackMobVsPlayer(mob, user)
+
+			result.MobAttackRnds++
+			result.DamageTaken += healthBefore - user.Character.Health
+			if attack.Hit {
+				result.MobHits++
+			}
+			if attack.Crit {
+				result.MobCrits++
+			}
+		}
+
+		if user.Character.Health < 1 {
+			result.Losses++
+			return
+		}
+	}
+
+	result.Draws++
+}
+
+// Slow weapons make the attacker wait some rounds before swinging
+func readyToAttack(aggro *characters.Aggro) bool {
+	if aggro == nil {
+		return false
+	}
+	if aggro.RoundsWaiting > 0 {
+		aggro.RoundsWaiting--
+		return false
+	}
+	return true
+}
@@ -0,0 +1,383 @@
+package main
+
+import (
+	"errors"
+	"net"
+	"os"
+	"sync"
+
+	"github.com/GoMudEngine/GoMud/internal/configs"
+	"github.com/GoMudEngine/GoMud/internal/connections"
+	"github.com/GoMudEngine/GoMud/internal/inputhandlers"
+	"github.com/GoMudEngine/GoMud/internal/mudlog"
+	"github.com/GoMudEngine/GoMud/internal/plugins"
+	"github.com/GoMudEngine/GoMud/internal/rooms"
+	"github.com/GoMudEngine/GoMud/internal/templates"
+	"github.com/GoMudEngine/GoMud/internal/term"
+	"github.com/GoMudEngine/GoMud/internal/users"
+	"github.com/GoMudEngine/GoMud/internal/util"
+	"gopkg.in/yaml.v2"
+)
+
+//
+// Copyover
+//
+// Saves everything, then replaces the running process with a fresh copy of the binary.
+// Telnet listeners and player sockets are inherited by the new process, which logs
+// players back in where they were, so a new build can be deployed without anyone
+// being disconnected.
+//
+
+const (
+	// Tells the new process where to find the state left behind by the old one
+	copyoverStateEnv      = `COPYOVER_STATE`
+	copyoverStateFilename = `copyover.yaml`
+)
+
+var (
+	errCopyoverNoExecutable = errors.New("could not find the server executable")
+
+	// Captured at startup, since a deploy may replace the file on disk before a copyover
+	executablePath, _ = os.Executable()
+
+	listenerLock sync.Mutex
+	// Every telnet listener by address, so they can be handed to the next process
+	telnetListeners = map[string]*net.TCPListener{}
+	// Listeners handed down by the previous process, waiting to be claimed by address
+	inheritedListeners = map[string]*net.TCPListener{}
+)
+
+type copyoverState struct {
+	Listeners   map[string]uintptr   `yaml:"listeners"` // address => file descriptor
+	Connections []copyoverConnection `yaml:"connections"`
+
+	restored []copyoverPlayer
+}
+
+type copyoverConnection struct {
+	ConnectionId   connections.ConnectionId   `yaml:"connectionid"`
+	UserId         int                        `yaml:"userid"`
+	Fd             uintptr                    `yaml:"fd"`
+	ClientSettings connections.ClientSettings `yaml:"clientsettings"`
+	MCCP2          bool                       `yaml:"mccp2,omitempty"`
+	GMCP           string                     `yaml:"gmcp,omitempty"` // Whatever the gmcp module needs to pick up where it left off
+}
+
+type copyoverPlayer struct {
+	connDetails *connections.ConnectionDetails
+	user        *users.UserRecord
+	mccp2       bool
+}
+
+// Opens a TCP listener, or picks up the one the previous process was using for this address
+func listenTCP(address string) (net.Listener, error) {
+
+	listenerLock.Lock()
+	defer listenerLock.Unlock()
+
+	server, ok := inheritedListeners[address]
+	if ok {
+		delete(inheritedListeners, address)
+		mudlog.Info("Copyover", "listener", address, "status", "inherited")
+	} else {
+
+		l, err := net.Listen("tcp", address)
+		if err != nil {
+			return nil, err
+		}
+
+		if server, ok = l.(*net.TCPListener); !ok {
+			return l, nil
+		}
+	}
+
+	telnetListeners[address] = server
+
+	return server, nil
+}
+
+// Saves the world and execs a new copy of the server.
+// Only returns if the copyover could not happen.
+func (w *World) copyover() error {
+
+	if executablePath == `` {
+		return errCopyoverNoExecutable
+	}
+
+	connections.Broadcast([]byte(templates.AnsiParse(`<ansi fg="yellow-bold">The world shimmers and fades... hold tight.</ansi>` + term.CRLFStr)))
+
+	if err := rooms.SaveAllRooms(); err != nil {
+		return err
+	}
+	users.SaveAllUsers()
+	util.SaveRoundCount(configs.GetFilePathsConfig().DataFiles.String() + `/` + util.RoundCountFilename)
+	plugins.Save()
+
+	state := copyoverState{
+		Listeners:   map[string]uintptr{},
+		Connections: []copyoverConnection{},
+	}
+
+	// Duplicated descriptors, which must stay open until the exec
+	files := []*os.File{}
+	closeFiles := func() {
+		for _, f := range files {
+			f.Close()
+		}
+	}
+
+	listenerLock.Lock()
+	for address, server := range telnetListeners {
+
+		f, err := server.File()
+		if err != nil {
+			listenerLock.Unlock()
+			closeFiles()
+			return err
+		}
+
+		files = append(files, f)
+		state.Listeners[address] = f.Fd()
+	}
+	listenerLock.Unlock()
+
+	getGMCP, _ := plugins.GetPluginRegistry().GetExportedFunction(`GetGMCPSettings`)
+
+	dropped := []connections.ConnectionId{}
+
+	// Compression is only stopped once nothing but the exec can fail
+	compressed := []*connections.ConnectionDetails{}
+
+	for _, connId := range connections.GetAllConnectionIds() {
+
+		cd := connections.Get(connId)
+		user := users.GetByConnectionId(connId)
+
+		if cd == nil || user == nil || cd.State() != connections.LoggedIn {
+			dropped = append(dropped, connId)
+			continue
+		}
+
+		wasCompressed := cd.IsCompressed()
+
+		f, err := cd.File()
+		if err != nil {
+			mudlog.Warn("Copyover", "connectionId", connId, "username", user.Username, "error", err)
+			dropped = append(dropped, connId)
+			continue
+		}
+
+		files = append(files, f)
+
+		if wasCompressed {
+			compressed = append(compressed, cd)
+		}
+
+		conn := copyoverConnection{
+			ConnectionId:   connId,
+			UserId:         user.UserId,
+			Fd:             f.Fd(),
+			ClientSettings: connections.GetClientSettings(connId),
+			MCCP2:          wasCompressed,
+		}
+
+		if getFunc, ok := getGMCP.(func(uint64) string); ok {
+			conn.GMCP = getFunc(connId)
+		}
+
+		state.Connections = append(state.Connections, conn)
+	}
+
+	stateBytes, err := yaml.Marshal(state)
+	if err != nil {
+		closeFiles()
+		return err
+	}
+
+	statePath := util.FilePath(configs.GetFilePathsConfig().DataFiles.String(), `/`, copyoverStateFilename)
+	if err := os.WriteFile(statePath, stateBytes, 0600); err != nil {
+		closeFiles()
+		return err
+	}
+
+	// The new process can't continue a compressed stream, so end it cleanly
+	for _, cd := range compressed {
+		if err := cd.StopCompression(); err != nil {
+			mudlog.Warn("Copyover", "connectionId", cd.ConnectionId(), "error", err)
+		}
+	}
+
+	// Anyone who can't be carried over (logging in, TLS, websocket) has to reconnect.
+	// Their sockets are closed when the process is replaced, so they stay connected if the exec fails.
+	for _, connId := range dropped {
+		connections.SendTo([]byte(templates.AnsiParse(`<ansi fg="red-bold">The server is restarting. Please reconnect in a moment.</ansi>`+term.CRLFStr)), connId)
+	}
+
+	mudlog.Warn("Copyover", "executable", executablePath, "listeners", len(state.Listeners), "connections", len(state.Connections), "dropped", len(dropped))
+
+	err = execCopyover(statePath, files)
+
+	// Still here, so it didn't work.
+	closeFiles()
+	os.Remove(statePath)
+
+	for _, cd := range compressed {
+		if err := cd.StartCompression(); err != nil {
+			mudlog.Warn("Copyover", "connectionId", cd.ConnectionId(), "error", err)
+		}
+	}
+
+	return err
+}
+
+// Reads the state left by a copyover, if this process was started by one.
+// Inherited listeners are held until listenTCP() claims them.
+func loadCopyoverState() *copyoverState {
+
+	statePath := os.Getenv(copyoverStateEnv)
+	if statePath == `` {
+		return nil
+	}
+	os.Unsetenv(copyoverStateEnv)
+
+	stateBytes, err := os.ReadFile(statePath)
+	os.Remove(statePath)
+
+	if err != nil {
+		mudlog.Error("Copyover", "error", err)
+		return nil
+	}
+
+	state := &copyoverState{}
+	if err := yaml.Unmarshal(stateBytes, state); err != nil {
+		mudlog.Error("Copyover", "error", err)
+		return nil
+	}
+
+	listenerLock.Lock()
+	defer listenerLock.Unlock()
+
+	for address, fd := range state.Listeners {
+
+		f := os.NewFile(fd, address)
+		l, err := net.FileListener(f)
+		f.Close()
+
+		if err != nil {
+			mudlog.Error("Copyover", "listener", address, "error", err)
+			continue
+		}
+
+		if tcpListener, ok := l.(*net.TCPListener); ok {
+			inheritedListeners[address] = tcpListener
+		} else {
+			l.Close()
+		}
+	}
+
+	return state
+}
+
+// Re-adopts the player sockets and logs them back in.
+// Must happen before the listeners start accepting, so the old connection ids are still free.
+func (s *copyoverState) restoreConnections() {
+
+	setGMCP, _ := plugins.GetPluginRegistry().GetExportedFunction(`SetGMCPSettings`)
+
+	for _, saved := range s.Connections {
+
+		f := os.NewFile(saved.Fd, `copyover`)
+		conn, err := net.FileConn(f)
+		f.Close()
+
+		if err != nil {
+			mudlog.Error("Copyover", "connectionId", saved.ConnectionId, "error", err)
+			continue
+		}
+
+		cd := connections.Restore(saved.ConnectionId, conn)
+		connections.OverwriteClientSettings(saved.ConnectionId, saved.ClientSettings)
+
+		if setFunc, ok := setGMCP.(func(uint64, string)); ok && saved.GMCP != `` {
+			setFunc(saved.ConnectionId, saved.GMCP)
+		}
+
+		user, err := users.LoadUserById(saved.UserId)
+		if err == nil {
+			user, _, err = users.LoginUser(user, saved.ConnectionId)
+		}
+
+		if err != nil {
+			mudlog.Error("Copyover", "connectionId", saved.ConnectionId, "userId", saved.UserId, "error", err)
+			connections.SendTo([]byte(templates.AnsiParse(`<ansi fg="red-bold">Your character could not be restored. Please reconnect.</ansi>`+term.CRLFStr)), saved.ConnectionId)
+			connections.Remove(saved.ConnectionId)
+			continue
+		}
+
+		s.restored = append(s.restored, copyoverPlayer{
+			connDetails: cd,
+			user:        user,
+			mccp2:       saved.MCCP2,
+		})
+	}
+}
+
+// Puts everyone restored back in the world and starts reading their input again.
+// The world workers must already be running.
+func (s *copyoverState) resume(wg *sync.WaitGroup) {
+
+	netCfg := configs.GetNetworkConfig()
+
+	for _, p := range s.restored {
+
+		cd := p.connDetails
+
+		cd.AddInputHandler("TelnetIACHandler", inputhandlers.TelnetIACHandler)
+		cd.AddInputHandler("AnsiHandler", inputhandlers.AnsiHandler)
+		cd.AddInputHandler("CleanserInputHandler", inputhandlers.CleanserInputHandler)
+		addLoggedInHandlers(cd, p.user)
+
+		// Compression had to stop for the handoff, so offer it again
+		if p.mccp2 && bool(netCfg.MCCP2Enabled) {
+			connections.SendTo(term.Mccp2Enable.BytesWithPayload(nil), cd.ConnectionId())
+		}
+		if netCfg.MCCP3Enabled {
+			cd.AllowInputCompression()
+		}
+
+		connections.SendTo([]byte(templates.AnsiParse(`<ansi fg="yellow-bold">...and comes back into focus.</ansi>`+term.CRLFStr)), cd.ConnectionId())
+
+		clientInput := &connections.ClientInput{
+			ConnectionId: cd.ConnectionId(),
+			DataIn:       []byte{},
+			Buffer:       make([]byte, 0, connections.ReadBufferSize),
+			Clipboard:    []byte{},
+			History:      connections.InputHistory{},
+		}
+
+		worldManager.SendEnterWorld(p.user.UserId, p.user.Character.RoomId)
+
+		wg.Add(1)
+		go func(p copyoverPlayer) {
+			defer wg.Done()
+			telnetInputLoop(p.connDetails, clientInput, map[string]any{}, p.user)
+		}(p)
+	}
+
+	mudlog.Info("Copyover", "status", "complete", "players", len(s.restored))
+
+	s.restored = nil
+}
+
+// Closes any inherited listeners the config no longer uses
+func closeInheritedListeners() {
+
+	listenerLock.Lock()
+	defer listenerLock.Unlock()
+
+	for address, l := range inheritedListeners {
+		mudlog.Info("Copyover", "listener", address, "status", "closed (no longer configured)")
+		l.Close()
+		delete(inheritedListeners, address)
+	}
+}
@@ -0,0 +1,24 @@
+//go:build !windows
+
+package main
+
+import (
+	"os"
+	"syscall"
+)
+
+// Replaces this process with a new copy of the server.
+// The files are the listener and player sockets that should stay open across the exec.
+func execCopyover(statePath string, files []*os.File) error {
+
+	// Descriptors from File() are close-on-exec, clear that so the new process gets them
+	for _, f := range files {
+		if _, _, errno := syscall.Syscall(syscall.SYS_FCNTL, f.Fd(), syscall.F_SETFD, 0); errno != 0 {
+			return errno
+		}
+	}
+
+	env := append(os.Environ(), copyoverStateEnv+`=`+statePath)
+
+	return syscall.Exec(executablePath, os.Args, env)
+}
@@ -0,0 +1,13 @@
+//go:build windows
+
+package main
+
+import (
+	"errors"
+	"os"
+)
+
+// Windows can't exec in place or hand sockets down by descriptor
+func execCopyover(statePath string, files []*os.File) error {
+	return errors.New("copyover is not supported on windows")
+}
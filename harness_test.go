@@ -0,0 +1,482 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"io/fs"
+	"net"
+	"os"
+	"path"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/GoMudEngine/GoMud/internal/clans"
+	"github.com/GoMudEngine/GoMud/internal/configs"
+	"github.com/GoMudEngine/GoMud/internal/connections"
+	"github.com/GoMudEngine/GoMud/internal/events"
+	"github.com/GoMudEngine/GoMud/internal/fileloader"
+	"github.com/GoMudEngine/GoMud/internal/hooks"
+	"github.com/GoMudEngine/GoMud/internal/language"
+	"github.com/GoMudEngine/GoMud/internal/mobs"
+	"github.com/GoMudEngine/GoMud/internal/mudlog"
+	"github.com/GoMudEngine/GoMud/internal/plugins"
+	"github.com/GoMudEngine/GoMud/internal/rooms"
+	"github.com/GoMudEngine/GoMud/internal/scripting"
+	"github.com/GoMudEngine/GoMud/internal/templates"
+	"github.com/GoMudEngine/GoMud/internal/term"
+	"github.com/GoMudEngine/GoMud/internal/usercommands"
+	"github.com/GoMudEngine/GoMud/internal/users"
+	"github.com/GoMudEngine/GoMud/internal/util"
+	textLang "golang.org/x/text/language"
+)
+
+// ///////////////////////////////////////////////////////////////
+// Headless scenario harness
+//
+//	Boots the world from a throwaway copy of the default datafiles,
+//	connects players over in-process pipes instead of sockets, and
+//	only moves time forward when a test asks it to.
+//
+//	The datafiles are copied to a temp folder rather than held in memory.
+//	The loaders and savers (rooms, users, plugins and so on) all go through
+//	the OS filesystem under FilePaths.DataFiles, so an in-memory set would
+//	mean reworking every one of them. The copy gives the same isolation:
+//	nothing is saved over the real world, and it is removed after the run.
+//
+//	h := newTestHarness(t)
+//	p := h.NewPlayer(`Tester`, 1)
+//	p.Input(`north`)
+//	out, ok := p.WaitFor(`drops to the ground`, 20)
+//
+// The world is shared by every test in the package, so scenarios
+// should spawn what they need rather than count on what's already there.
+// ///////////////////////////////////////////////////////////////
+
+const (
+	harnessSeed = 1234
+	// Written after each step so we know when a player has read everything sent before it
+	harnessSyncMarker = "\x00harness-sync\x00"
+)
+
+var (
+	// Output is ANSI-stripped so tests can match on plain text
+	harnessAnsiRegex = regexp.MustCompile(`\x1b\[[0-9;?]*[A-Za-z]`)
+)
+
+func TestMain(m *testing.M) {
+
+	dataFiles, err := copyWorldFiles(`_datafiles/world/default`)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+
+	// Logs are kept out of the test output unless LOG_PATH says where to put them
+	logPath := os.Getenv(`LOG_PATH`)
+	if logPath == `` {
+		logPath = filepath.Join(dataFiles, `harness.log`)
+	}
+	logLevel := os.Getenv(`LOG_LEVEL`)
+	if logLevel == `` {
+		logLevel = `LOW`
+	}
+	mudlog.SetupLogger(nil, logLevel, logPath, false)
+
+	if err = bootWorld(dataFiles); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+
+	code := m.Run()
+
+	os.RemoveAll(dataFiles)
+	os.Exit(code)
+}
+
+// Copies a world to a temp folder, so nothing a test does is saved over the real one
+func copyWorldFiles(worldPath string) (string, error) {
+
+	tmpPath, err := os.MkdirTemp(``, `gomud-harness-*`)
+	if err != nil {
+		return ``, err
+	}
+
+	err = filepath.WalkDir(worldPath, func(filePath string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+
+		relPath, err := filepath.Rel(worldPath, filePath)
+		if err != nil {
+			return err
+		}
+
+		if d.IsDir() {
+			return os.MkdirAll(filepath.Join(tmpPath, relPath), 0755)
+		}
+
+		return fileloader.CopyFileContents(filePath, filepath.Join(tmpPath, relPath))
+	})
+
+	if err != nil {
+		os.RemoveAll(tmpPath)
+		return ``, err
+	}
+
+	return tmpPath, nil
+}
+
+// The parts of main() the game loop needs, minus the listeners and timers
+func bootWorld(dataFiles string) error {
+
+	if err := configs.ReloadConfig(); err != nil {
+		return err
+	}
+
+	configs.AddOverlayOverrides(map[string]any{
+		`FilePaths.DataFiles`: dataFiles,
+	})
+
+	c := configs.GetConfig()
+
+	os.Mkdir(util.FilePath(dataFiles, `/`, `rooms.instances`), os.ModeDir|0755)
+
+	templates.RegisterFS(plugins.GetPluginRegistry())
+	usercommands.AddFunctionExporter(plugins.GetPluginRegistry())
+
+	util.SeedRand(harnessSeed)
+
+	language.InitTranslation(language.BundleCfg{
+		DefaultLanguage: textLang.Make(c.Translation.DefaultLanguage.String()),
+		Language:        textLang.Make(c.Translation.Language.String()),
+		LanguagePaths: []string{
+			path.Join("_datafiles", "localize"),
+			path.Join(dataFiles, "localize"),
+		},
+	})
+
+	hooks.RegisterListeners()
+
+	loadAllDataFiles(false)
+
+	idx := users.NewUserIndex()
+	idx.Create()
+	idx.Rebuild()
+
+	clans.LoadClans()
+
+	scripting.Setup(int(c.Scripting.LoadTimeoutMs), int(c.Scripting.RoomTimeoutMs))
+
+	plugins.Load(dataFiles)
+
+	return nil
+}
+
+type testHarness struct {
+	t       *testing.T
+	world   *World
+	players []*testPlayer
+}
+
+func newTestHarness(t *testing.T) *testHarness {
+	t.Helper()
+
+	h := &testHarness{
+		t:     t,
+		world: worldManager,
+	}
+
+	t.Cleanup(h.close)
+
+	return h
+}
+
+// Creates a new character and drops them into the world at roomId
+func (h *testHarness) NewPlayer(name string, roomId int) *testPlayer {
+	h.t.Helper()
+
+	serverConn, clientConn := net.Pipe()
+
+	p := &testPlayer{
+		h:      h,
+		conn:   clientConn,
+		connId: connections.Add(serverConn, nil).ConnectionId(),
+		synced: make(chan struct{}),
+	}
+
+	go p.readOutput()
+
+	user := users.NewUserRecord(0, p.connId)
+	if err := user.SetUsername(name); err != nil {
+		h.t.Fatalf("NewPlayer(%q): %s", name, err)
+	}
+
+	user.Character.Name = name
+	user.Character.RaceId = 1 // Human
+	user.Character.RoomId = roomId
+	user.Character.Validate(true)
+
+	// Fully rested, so they can get moving right away
+	user.Character.Health = user.Character.HealthMax.Value
+	user.Character.Mana = user.Character.ManaMax.Value
+	user.Character.ActionPoints = user.Character.ActionPointsMax.Value
+
+	util.LockMud()
+	err := users.CreateUser(user)
+	util.UnlockMud()
+
+	if err != nil {
+		h.t.Fatalf("NewPlayer(%q): %s", name, err)
+	}
+
+	connections.Get(p.connId).SetState(connections.LoggedIn)
+
+	p.user = user
+	h.players = append(h.players, p)
+
+	util.LockMud()
+	h.world.enterWorld(user.UserId, roomId)
+	util.UnlockMud()
+
+	h.settle()
+
+	return p
+}
+
+// Puts a new mob in a room and returns it, so tests can give it gear or gold
+func (h *testHarness) SpawnMob(mobId int, roomId int) *mobs.Mob {
+	h.t.Helper()
+
+	util.LockMud()
+	defer util.UnlockMud()
+
+	room := rooms.LoadRoom(roomId)
+	if room == nil {
+		h.t.Fatalf("SpawnMob(%d, %d): room not found", mobId, roomId)
+	}
+
+	mob := mobs.NewMobById(mobs.MobId(mobId), roomId)
+	if mob == nil {
+		h.t.Fatalf("SpawnMob(%d, %d): mob not found", mobId, roomId)
+	}
+
+	room.AddMob(mob.InstanceId)
+
+	return mob
+}
+
+// Advances the game the given number of turns
+func (h *testHarness) Turns(count int) {
+	for i := 0; i < count; i++ {
+		h.turn()
+		h.sync()
+	}
+}
+
+// Advances the game until the given number of rounds have started
+func (h *testHarness) Rounds(count int) {
+	endRound := util.GetRoundCount() + uint64(count)
+	for util.GetRoundCount() < endRound {
+		h.turn()
+	}
+	h.sync()
+}
+
+func (h *testHarness) turn() {
+	util.LockMud()
+	h.world.advanceTurn(configs.GetConfig())
+	h.world.EventLoop()
+	util.UnlockMud()
+}
+
+// Runs the event loop once, as the main worker would between turns
+func (h *testHarness) settle() {
+	util.LockMud()
+	h.world.EventLoop()
+	util.UnlockMud()
+
+	h.sync()
+}
+
+// Waits for every player to read everything that's been sent to them so far
+func (h *testHarness) sync() {
+	for _, p := range h.players {
+		connections.SendTo([]byte(harnessSyncMarker), p.connId)
+
+		select {
+		case <-p.synced:
+		case <-time.After(5 * time.Second):
+			h.t.Fatalf("%s: timed out waiting for output", p.user.Character.Name)
+		}
+	}
+}
+
+// Despawns a player, which logs them out and closes their connection, the same as quitting
+func (h *testHarness) Despawn(p *testPlayer) {
+
+	for i := range h.players {
+		if h.players[i] == p {
+			h.players = append(h.players[:i], h.players[i+1:]...)
+			break
+		}
+	}
+
+	util.LockMud()
+	events.AddToQueue(events.PlayerDespawn{
+		UserId:        p.user.UserId,
+		RoomId:        p.user.Character.RoomId,
+		Username:      p.user.Username,
+		CharacterName: p.user.Character.Name,
+	})
+	util.UnlockMud()
+
+	h.settle()
+
+	// Forget the character, so the name is free for the next test
+	users.NewUserIndex().RemoveByUsername(p.user.Username)
+	os.Remove(util.FilePath(configs.GetFilePathsConfig().DataFiles.String(), `/`, `users`, `/`, strconv.Itoa(p.user.UserId)+`.yaml`))
+}
+
+// Despawns everyone still in the world
+func (h *testHarness) close() {
+	for len(h.players) > 0 {
+		h.Despawn(h.players[0])
+	}
+}
+
+// A player connected over one end of a pipe, reading whatever the server sends to the other
+type testPlayer struct {
+	h      *testHarness
+	user   *users.UserRecord
+	conn   net.Conn
+	connId connections.ConnectionId
+
+	lock   sync.Mutex
+	output bytes.Buffer
+	synced chan struct{}
+}
+
+func (p *testPlayer) User() *users.UserRecord {
+	return p.user
+}
+
+// Sends a line of input through the world, the same way a connection does,
+// then runs the event loop so anything that doesn't need to wait a turn happens.
+func (p *testPlayer) Input(inputText string) {
+
+	go p.h.world.SendInput(WorldInput{
+		FromId:    p.user.UserId,
+		InputText: inputText,
+	})
+
+	// Stand in for InputWorker(), so the input is queued before we carry on
+	p.h.world.queueInput(<-p.h.world.worldInput)
+
+	p.h.settle()
+}
+
+// Returns everything received since the last call, without ANSI codes
+func (p *testPlayer) Output() string {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	out := p.output.String()
+	p.output.Reset()
+
+	out = harnessAnsiRegex.ReplaceAllString(stripTelnet(out), ``)
+	return strings.ReplaceAll(out, "\r", ``)
+}
+
+// Removes telnet negotiation and subnegotiation (such as MSP sounds)
+func stripTelnet(str string) string {
+
+	in := []byte(str)
+	out := make([]byte, 0, len(in))
+
+	for i := 0; i < len(in); i++ {
+
+		if in[i] != term.TELNET_IAC || i+1 >= len(in) {
+			out = append(out, in[i])
+			continue
+		}
+
+		switch in[i+1] {
+		case term.TELNET_SB:
+			end := bytes.Index(in[i:], []byte{term.TELNET_IAC, term.TELNET_SE})
+			if end == -1 {
+				return string(out)
+			}
+			i += end + 1
+		case term.TELNET_WILL, term.TELNET_WONT, term.TELNET_DO, term.TELNET_DONT:
+			i += 2
+		default:
+			i++
+		}
+	}
+
+	return string(out)
+}
+
+// Advances turns until the player sees the text or maxRounds pass.
+// Returns all output seen while waiting.
+func (p *testPlayer) WaitFor(text string, maxRounds int) (string, bool) {
+
+	out := p.Output()
+	if strings.Contains(out, text) {
+		return out, true
+	}
+
+	endRound := util.GetRoundCount() + uint64(maxRounds)
+	for util.GetRoundCount() < endRound {
+
+		p.h.turn()
+		p.h.sync()
+
+		out += p.Output()
+		if strings.Contains(out, text) {
+			return out, true
+		}
+	}
+
+	return out, false
+}
+
+func (p *testPlayer) readOutput() {
+
+	buf := make([]byte, connections.ReadBufferSize)
+	pending := []byte{}
+
+	for {
+		n, err := p.conn.Read(buf)
+		if n > 0 {
+			pending = append(pending, buf[:n]...)
+
+			for {
+				idx := bytes.Index(pending, []byte(harnessSyncMarker))
+				if idx == -1 {
+					break
+				}
+
+				p.lock.Lock()
+				p.output.Write(pending[:idx])
+				p.lock.Unlock()
+
+				pending = pending[idx+len(harnessSyncMarker):]
+				p.synced <- struct{}{}
+			}
+		}
+
+		if err != nil {
+			if err != io.EOF {
+				mudlog.Warn("Harness", "error", err)
+			}
+			return
+		}
+	}
+}
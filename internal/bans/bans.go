@@ -0,0 +1,350 @@
+package bans
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/GoMudEngine/GoMud/internal/configs"
+	"github.com/GoMudEngine/GoMud/internal/mudlog"
+	"github.com/GoMudEngine/GoMud/internal/util"
+	"gopkg.in/yaml.v2"
+)
+
+type BanType string
+
+const (
+	BanFilename = `bans.yaml`
+
+	BanTypeIP       BanType = `ip`       // A single ip address
+	BanTypeCIDR     BanType = `cidr`     // A range of addresses, such as 10.0.0.0/8
+	BanTypeUsername BanType = `username` // An account
+)
+
+var (
+	lock    = sync.RWMutex{}
+	banList = BanList{}
+
+	ErrBanExists   = errors.New(`that ban already exists`)
+	ErrInvalidBan  = errors.New(`not a valid ip, cidr or username`)
+	ErrBanNotFound = errors.New(`ban not found`)
+)
+
+type Ban struct {
+	BanId     int       `yaml:"banid"`
+	Type      BanType   `yaml:"type"`
+	Value     string    `yaml:"value"`
+	Reason    string    `yaml:"reason,omitempty"`
+	CreatedBy string    `yaml:"createdby,omitempty"`
+	Created   time.Time `yaml:"created"`
+	Expires   time.Time `yaml:"expires,omitempty"` // Zero means it never expires
+}
+
+type BanList struct {
+	NextBanId int   `yaml:"nextbanid"`
+	Bans      []Ban `yaml:"bans"`
+}
+
+func (b Ban) Expired() bool {
+	return !b.Expires.IsZero() && time.Now().After(b.Expires)
+}
+
+func (b Ban) Permanent() bool {
+	return b.Expires.IsZero()
+}
+
+// Whether this ban covers an ip address
+func (b Ban) MatchesIP(ip net.IP) bool {
+
+	if ip == nil {
+		return false
+	}
+
+	switch b.Type {
+	case BanTypeIP:
+		if banIP := net.ParseIP(b.Value); banIP != nil {
+			return banIP.Equal(ip)
+		}
+	case BanTypeCIDR:
+		if _, ipNet, err := net.ParseCIDR(b.Value); err == nil {
+			return ipNet.Contains(ip)
+		}
+	}
+
+	return false
+}
+
+// Works out the ban type from the value given.
+// Returns the type and a cleaned up value.
+func ParseBanValue(value string) (BanType, string, error) {
+
+	value = strings.TrimSpace(value)
+
+	if strings.Contains(value, `/`) {
+		_, ipNet, err := net.ParseCIDR(value)
+		if err != nil {
+			return ``, ``, ErrInvalidBan
+		}
+		return BanTypeCIDR, ipNet.String(), nil
+	}
+
+	if ip := net.ParseIP(value); ip != nil {
+		return BanTypeIP, ip.String(), nil
+	}
+
+	if value == `` || strings.ContainsAny(value, ` :`) {
+		return ``, ``, ErrInvalidBan
+	}
+
+	return BanTypeUsername, strings.ToLower(value), nil
+}
+
+// Parses ban lengths such as 30m, 12h, 7d or 2w.
+// "perm" or "permanent" is a zero duration (never expires).
+func ParseBanDuration(str string) (time.Duration, bool) {
+
+	str = strings.ToLower(strings.TrimSpace(str))
+
+	if str == `perm` || str == `permanent` {
+		return 0, true
+	}
+
+	if len(str) < 2 {
+		return 0, false
+	}
+
+	multiplier := time.Duration(0)
+	switch str[len(str)-1] {
+	case 'd':
+		multiplier = 24 * time.Hour
+	case 'w':
+		multiplier = 7 * 24 * time.Hour
+	}
+
+	if multiplier > 0 {
+		n, err := strconv.Atoi(str[:len(str)-1])
+		if err != nil || n < 1 {
+			return 0, false
+		}
+		return time.Duration(n) * multiplier, true
+	}
+
+	d, err := time.ParseDuration(str)
+	if err != nil || d <= 0 {
+		return 0, false
+	}
+
+	return d, true
+}
+
+// Takes a RemoteAddr style string ("1.2.3.4:5678") or a plain ip
+func ParseRemoteIP(remoteAddr string) net.IP {
+
+	if host, _, err := net.SplitHostPort(remoteAddr); err == nil {
+		remoteAddr = host
+	}
+
+	return net.ParseIP(remoteAddr)
+}
+
+// Adds a ban and saves the ban list.
+// A zero duration never expires.
+func AddBan(value string, duration time.Duration, reason string, createdBy string) (Ban, error) {
+
+	banType, value, err := ParseBanValue(value)
+	if err != nil {
+		return Ban{}, err
+	}
+
+	lock.Lock()
+	defer lock.Unlock()
+
+	pruneExpired()
+
+	for _, b := range banList.Bans {
+		if b.Type == banType && b.Value == value {
+			return Ban{}, ErrBanExists
+		}
+	}
+
+	if banList.NextBanId < 1 {
+		banList.NextBanId = 1
+	}
+
+	newBan := Ban{
+		BanId:     banList.NextBanId,
+		Type:      banType,
+		Value:     value,
+		Reason:    reason,
+		CreatedBy: createdBy,
+		Created:   time.Now(),
+	}
+
+	if duration > 0 {
+		newBan.Expires = newBan.Created.Add(duration)
+	}
+
+	banList.NextBanId++
+	banList.Bans = append(banList.Bans, newBan)
+
+	return newBan, save()
+}
+
+// Removes a ban by its id or its value and saves the ban list.
+func RemoveBan(idOrValue string) (Ban, error) {
+
+	lock.Lock()
+	defer lock.Unlock()
+
+	_, cleanValue, _ := ParseBanValue(idOrValue)
+
+	for i, b := range banList.Bans {
+		if fmt.Sprintf(`%d`, b.BanId) == idOrValue || b.Value == cleanValue {
+			banList.Bans = append(banList.Bans[:i], banList.Bans[i+1:]...)
+			return b, save()
+		}
+	}
+
+	return Ban{}, ErrBanNotFound
+}
+
+// Returns all bans that haven't expired, oldest first
+func GetBans() []Ban {
+
+	lock.RLock()
+	defer lock.RUnlock()
+
+	ret := []Ban{}
+	for _, b := range banList.Bans {
+		if !b.Expired() {
+			ret = append(ret, b)
+		}
+	}
+
+	sort.SliceStable(ret, func(i, j int) bool {
+		return ret[i].BanId < ret[j].BanId
+	})
+
+	return ret
+}
+
+// Returns the ban covering a remote address, or nil
+func GetIPBan(remoteAddr string) *Ban {
+
+	ip := ParseRemoteIP(remoteAddr)
+	if ip == nil {
+		return nil
+	}
+
+	lock.RLock()
+	defer lock.RUnlock()
+
+	for _, b := range banList.Bans {
+		if !b.Expired() && b.MatchesIP(ip) {
+			return &b
+		}
+	}
+
+	return nil
+}
+
+// Returns the ban covering a username, or nil
+func GetUsernameBan(username string) *Ban {
+
+	username = strings.ToLower(username)
+
+	lock.RLock()
+	defer lock.RUnlock()
+
+	for _, b := range banList.Bans {
+		if b.Type == BanTypeUsername && b.Value == username && !b.Expired() {
+			return &b
+		}
+	}
+
+	return nil
+}
+
+// Message shown to a banned connection
+func (b Ban) Message() string {
+
+	msg := `You are banned`
+	if !b.Permanent() {
+		msg += ` until ` + b.Expires.Format(`2006-01-02 15:04 MST`)
+	}
+	if b.Reason != `` {
+		msg += `: ` + b.Reason
+	}
+
+	return msg + `.`
+}
+
+func banFilePath() string {
+	return util.FilePath(configs.GetFilePathsConfig().DataFiles.String(), `/`, BanFilename)
+}
+
+// Loads the ban list from the data files folder
+func LoadBans() {
+
+	lock.Lock()
+	defer lock.Unlock()
+
+	banList = BanList{NextBanId: 1}
+
+	bytes, err := os.ReadFile(banFilePath())
+	if err != nil {
+		if !os.IsNotExist(err) {
+			mudlog.Error("LoadBans()", "error", err)
+		}
+		return
+	}
+
+	if err := yaml.Unmarshal(bytes, &banList); err != nil {
+		mudlog.Error("LoadBans()", "error", err)
+		return
+	}
+
+	pruneExpired()
+
+	mudlog.Info("LoadBans()", "loadedCount", len(banList.Bans))
+}
+
+// Drops expired bans. Caller must hold the lock.
+func pruneExpired() {
+
+	current := banList.Bans[:0]
+	for _, b := range banList.Bans {
+		if !b.Expired() {
+			current = append(current, b)
+		}
+	}
+	banList.Bans = current
+}
+
+// Caller must hold the lock.
+func save() error {
+
+	bytes, err := yaml.Marshal(&banList)
+	if err != nil {
+		return err
+	}
+
+	saveFilePath := banFilePath()
+
+	if configs.GetFilePathsConfig().CarefulSaveFiles {
+		// Write to a temp file and rename, so a crash never leaves a half written ban list
+		tmpSaveFilePath := saveFilePath + `.new`
+		if err = os.WriteFile(tmpSaveFilePath, bytes, 0644); err != nil {
+			return err
+		}
+		return os.Rename(tmpSaveFilePath, saveFilePath)
+	}
+
+	return os.WriteFile(saveFilePath, bytes, 0644)
+}
@@ -0,0 +1,120 @@
+package bans
+
+import (
+	"testing"
+	"time"
+)
+
+func TestParseBanValue(t *testing.T) {
+
+	tests := []struct {
+		value     string
+		wantType  BanType
+		wantValue string
+		wantErr   bool
+	}{
+		{`1.2.3.4`, BanTypeIP, `1.2.3.4`, false},
+		{`10.1.2.3/8`, BanTypeCIDR, `10.0.0.0/8`, false},
+		{`::1`, BanTypeIP, `::1`, false},
+		{`SomeUser`, BanTypeUsername, `someuser`, false},
+		{`10.0.0.0/99`, ``, ``, true},
+		{``, ``, ``, true},
+		{`two words`, ``, ``, true},
+	}
+
+	for _, tt := range tests {
+		gotType, gotValue, err := ParseBanValue(tt.value)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("ParseBanValue(%q) error = %v, wantErr %v", tt.value, err, tt.wantErr)
+			continue
+		}
+		if gotType != tt.wantType || gotValue != tt.wantValue {
+			t.Errorf("ParseBanValue(%q) = %q, %q, want %q, %q", tt.value, gotType, gotValue, tt.wantType, tt.wantValue)
+		}
+	}
+}
+
+func TestBanMatchesIP(t *testing.T) {
+
+	tests := []struct {
+		ban        Ban
+		remoteAddr string
+		want       bool
+	}{
+		{Ban{Type: BanTypeIP, Value: `1.2.3.4`}, `1.2.3.4:5000`, true},
+		{Ban{Type: BanTypeIP, Value: `1.2.3.4`}, `1.2.3.5:5000`, false},
+		{Ban{Type: BanTypeCIDR, Value: `10.0.0.0/8`}, `10.20.30.40:22`, true},
+		{Ban{Type: BanTypeCIDR, Value: `10.0.0.0/8`}, `11.0.0.1:22`, false},
+		{Ban{Type: BanTypeUsername, Value: `1.2.3.4`}, `1.2.3.4:5000`, false},
+		{Ban{Type: BanTypeIP, Value: `1.2.3.4`}, `not-an-ip`, false},
+	}
+
+	for _, tt := range tests {
+		if got := tt.ban.MatchesIP(ParseRemoteIP(tt.remoteAddr)); got != tt.want {
+			t.Errorf("Ban{%s %s}.MatchesIP(%q) = %v, want %v", tt.ban.Type, tt.ban.Value, tt.remoteAddr, got, tt.want)
+		}
+	}
+}
+
+func TestBanExpired(t *testing.T) {
+
+	if (Ban{}).Expired() {
+		t.Errorf("a ban without an expiration should never expire")
+	}
+
+	if !(Ban{Expires: time.Now().Add(-time.Minute)}).Expired() {
+		t.Errorf("a ban in the past should be expired")
+	}
+
+	if (Ban{Expires: time.Now().Add(time.Minute)}).Expired() {
+		t.Errorf("a ban in the future should not be expired")
+	}
+}
+
+func TestParseBanDuration(t *testing.T) {
+
+	tests := []struct {
+		str    string
+		want   time.Duration
+		wantOk bool
+	}{
+		{`perm`, 0, true},
+		{`30m`, 30 * time.Minute, true},
+		{`12h`, 12 * time.Hour, true},
+		{`7d`, 7 * 24 * time.Hour, true},
+		{`2w`, 14 * 24 * time.Hour, true},
+		{`0d`, 0, false},
+		{`-5m`, 0, false},
+		{`spamming`, 0, false},
+		{`d`, 0, false},
+	}
+
+	for _, tt := range tests {
+		got, ok := ParseBanDuration(tt.str)
+		if got != tt.want || ok != tt.wantOk {
+			t.Errorf("ParseBanDuration(%q) = %v, %v, want %v, %v", tt.str, got, ok, tt.want, tt.wantOk)
+		}
+	}
+}
+
+func TestLockoutDuration(t *testing.T) {
+
+	tests := []struct {
+		failures int
+		want     time.Duration
+	}{
+		{1, 0},
+		{4, 0},
+		{5, 30 * time.Second},
+		{6, 60 * time.Second},
+		{7, 120 * time.Second},
+		{12, 3600 * time.Second},
+		{500, 3600 * time.Second},
+	}
+
+	for _, tt := range tests {
+		if got := lockoutDuration(tt.failures, 5, 30, 3600); got != tt.want {
+			t.Errorf("lockoutDuration(%d) = %v, want %v", tt.failures, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,190 @@
+package bans
+
+import (
+	"net"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/GoMudEngine/GoMud/internal/configs"
+)
+
+//
+// Failed login throttling
+// Failures are counted per ip address and per account.
+// Once either reaches LoginFailureLimit, logins are locked out for
+// LoginLockoutSeconds, doubling with each further failure, up to LoginLockoutMaxSecs.
+//
+
+var (
+	throttleLock  = sync.Mutex{}
+	loginFailures = map[string]*loginFailure{}
+)
+
+type loginFailure struct {
+	Count       int
+	LastFailure time.Time
+	LockedUntil time.Time
+}
+
+type Lockout struct {
+	Key         string // "ip:1.2.3.4" or "user:name"
+	Failures    int
+	LockedUntil time.Time
+}
+
+func ipKey(remoteAddr string) string {
+	if ip := ParseRemoteIP(remoteAddr); ip != nil {
+		return `ip:` + ip.String()
+	}
+	return ``
+}
+
+func userKey(username string) string {
+	if username == `` {
+		return ``
+	}
+	return `user:` + strings.ToLower(username)
+}
+
+// How long until the ip or account may try to log in again.
+// Zero if not locked out. Either value may be empty.
+func LoginLockedFor(remoteAddr string, username string) time.Duration {
+
+	throttleLock.Lock()
+	defer throttleLock.Unlock()
+
+	now := time.Now()
+	longest := time.Duration(0)
+
+	for _, key := range []string{ipKey(remoteAddr), userKey(username)} {
+		if f, ok := loginFailures[key]; ok && key != `` {
+			if wait := f.LockedUntil.Sub(now); wait > longest {
+				longest = wait
+			}
+		}
+	}
+
+	return longest
+}
+
+// Counts a failed login against the ip and the account.
+// Returns how long they are now locked out for, if at all.
+func RecordLoginFailure(remoteAddr string, username string) time.Duration {
+
+	netConfig := configs.GetNetworkConfig()
+
+	throttleLock.Lock()
+	defer throttleLock.Unlock()
+
+	now := time.Now()
+	pruneFailures(now, time.Duration(netConfig.LoginLockoutMaxSecs)*time.Second)
+
+	longest := time.Duration(0)
+
+	for _, key := range []string{ipKey(remoteAddr), userKey(username)} {
+
+		if key == `` {
+			continue
+		}
+
+		f, ok := loginFailures[key]
+		if !ok {
+			f = &loginFailure{}
+			loginFailures[key] = f
+		}
+
+		f.Count++
+		f.LastFailure = now
+
+		if wait := lockoutDuration(f.Count, int(netConfig.LoginFailureLimit), int(netConfig.LoginLockoutSeconds), int(netConfig.LoginLockoutMaxSecs)); wait > 0 {
+			f.LockedUntil = now.Add(wait)
+			if wait > longest {
+				longest = wait
+			}
+		}
+	}
+
+	return longest
+}
+
+// Clears the failure count for the ip and account after a good login
+func RecordLoginSuccess(remoteAddr string, username string) {
+
+	throttleLock.Lock()
+	defer throttleLock.Unlock()
+
+	delete(loginFailures, ipKey(remoteAddr))
+	delete(loginFailures, userKey(username))
+}
+
+// Currently locked out ips and accounts, longest lockout first
+func GetLockouts() []Lockout {
+
+	throttleLock.Lock()
+	defer throttleLock.Unlock()
+
+	now := time.Now()
+	ret := []Lockout{}
+
+	for key, f := range loginFailures {
+		if f.LockedUntil.After(now) {
+			ret = append(ret, Lockout{Key: key, Failures: f.Count, LockedUntil: f.LockedUntil})
+		}
+	}
+
+	sort.SliceStable(ret, func(i, j int) bool {
+		return ret[i].LockedUntil.After(ret[j].LockedUntil)
+	})
+
+	return ret
+}
+
+// Clears lockouts for an ip or username. Returns false if there were none.
+func ClearLockout(ipOrUsername string) bool {
+
+	throttleLock.Lock()
+	defer throttleLock.Unlock()
+
+	key := userKey(ipOrUsername)
+	if ip := net.ParseIP(ipOrUsername); ip != nil {
+		key = `ip:` + ip.String()
+	}
+
+	if _, ok := loginFailures[key]; !ok {
+		return false
+	}
+
+	delete(loginFailures, key)
+	return true
+}
+
+// failureCount at the limit gets the base lockout, each failure after that doubles it.
+func lockoutDuration(failureCount int, failureLimit int, baseSeconds int, maxSeconds int) time.Duration {
+
+	if failureCount < failureLimit {
+		return 0
+	}
+
+	seconds := baseSeconds
+	for i := failureLimit; i < failureCount && seconds < maxSeconds; i++ {
+		seconds *= 2
+	}
+
+	if seconds > maxSeconds {
+		seconds = maxSeconds
+	}
+
+	return time.Duration(seconds) * time.Second
+}
+
+// Forgets failures that are no longer locked out and haven't happened in a while.
+// Caller must hold the lock.
+func pruneFailures(now time.Time, forgetAfter time.Duration) {
+	for key, f := range loginFailures {
+		if f.LockedUntil.Before(now) && now.Sub(f.LastFailure) > forgetAfter {
+			delete(loginFailures, key)
+		}
+	}
+}
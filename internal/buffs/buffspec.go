@@ -225,6 +225,60 @@ func (b *BuffSpec) GetScriptPath() string {
 	return util.FilePath(fullScriptPath)
 }
 
+// Writes a buff to disk and memory, creating it if it doesn't exist.
+// If the name changed the file (and any script) is moved to match.
+func SaveBuffSpec(buffInfo BuffSpec) error {
+
+	if buffInfo.BuffId < 0 {
+		return fmt.Errorf(`buff id %d cannot be negative`, buffInfo.BuffId)
+	}
+
+	if err := buffInfo.Validate(); err != nil {
+		return err
+	}
+
+	saveModes := []fileloader.SaveOption{}
+	if configs.GetFilePathsConfig().CarefulSaveFiles {
+		saveModes = append(saveModes, fileloader.SaveCareful)
+	}
+
+	basePath := string(configs.GetFilePathsConfig().DataFiles) + `/buffs`
+
+	if err := fileloader.SaveFlatFile[*BuffSpec](basePath, &buffInfo, saveModes...); err != nil {
+		return err
+	}
+
+	if oldBuffInfo, ok := buffs[buffInfo.BuffId]; ok && oldBuffInfo.Filepath() != buffInfo.Filepath() {
+		os.Rename(oldBuffInfo.GetScriptPath(), buffInfo.GetScriptPath())
+		if err := fileloader.DeleteFlatFile[*BuffSpec](basePath, oldBuffInfo); err != nil {
+			return err
+		}
+	}
+
+	buffs[buffInfo.Id()] = &buffInfo
+
+	return nil
+}
+
+// Removes a buff (and any script) from disk and memory.
+func DeleteBuffSpec(buffId int) error {
+
+	buffInfo, ok := buffs[buffId]
+	if !ok {
+		return fmt.Errorf(`buff %d does not exist`, buffId)
+	}
+
+	if err := fileloader.DeleteFlatFile[*BuffSpec](string(configs.GetFilePathsConfig().DataFiles)+`/buffs`, buffInfo); err != nil {
+		return err
+	}
+
+	os.Remove(buffInfo.GetScriptPath())
+
+	delete(buffs, buffId)
+
+	return nil
+}
+
 // file self loads due to init()
 func LoadDataFiles() {
 
@@ -0,0 +1,240 @@
+package channels
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/GoMudEngine/GoMud/internal/configs"
+	"github.com/GoMudEngine/GoMud/internal/fileloader"
+	"github.com/GoMudEngine/GoMud/internal/mudlog"
+	"github.com/GoMudEngine/GoMud/internal/util"
+)
+
+const (
+	DefaultHistorySize = 20
+
+	// Anyone with this role permission ignores channel level restrictions
+	ModeratePermission = `channel.moderate`
+)
+
+// How a user has chosen to participate in a channel
+type Status string
+
+const (
+	StatusJoined Status = `joined`
+	StatusLeft   Status = `left`
+	StatusMuted  Status = `muted` // Still joined, but messages aren't shown
+)
+
+var (
+	allChannels = map[string]*ChannelSpec{}
+
+	historyLock = sync.RWMutex{}
+	history     = map[string][]HistoryEntry{}
+
+	channelIdRegex = regexp.MustCompile(`^[a-z0-9]+$`)
+)
+
+type ChannelSpec struct {
+	ChannelId   string `yaml:"channelid"`             // Also the command used to talk on the channel (e.g. "ooc")
+	Name        string `yaml:"name"`                  // Shown in brackets before each message
+	Description string `yaml:"description,omitempty"` // Shown in the channel list
+	Color       string `yaml:"color,omitempty"`       // Ansi color or alias for the name. Defaults to "channel-prefix"
+	AutoJoin    bool   `yaml:"autojoin,omitempty"`    // Players are in the channel until they leave it
+	MinLevel    int    `yaml:"minlevel,omitempty"`    // Characters below this level can't use the channel
+	MaxLevel    int    `yaml:"maxlevel,omitempty"`    // Characters above this level can't use the channel (e.g. a newbie channel)
+	Permission  string `yaml:"permission,omitempty"`  // Role permission required to use the channel (e.g. "channel.staff")
+	HistorySize int    `yaml:"historysize,omitempty"` // How many messages of scrollback to keep. Defaults to 20
+	Discord     bool   `yaml:"discord,omitempty"`     // Whether messages are relayed to the Discord integration
+}
+
+type HistoryEntry struct {
+	Sent    time.Time
+	Name    string
+	Message string
+}
+
+func (c *ChannelSpec) Id() string {
+	return c.ChannelId
+}
+
+func (c *ChannelSpec) Filename() string {
+	filename := util.ConvertForFilename(c.ChannelId)
+	return fmt.Sprintf("%s.yaml", filename)
+}
+
+func (c *ChannelSpec) Filepath() string {
+	return c.Filename()
+}
+
+func (c *ChannelSpec) Validate() error {
+
+	c.ChannelId = strings.ToLower(c.ChannelId)
+
+	if !channelIdRegex.MatchString(c.ChannelId) {
+		return errors.New(`channelid must be lowercase letters and numbers only`)
+	}
+
+	if c.Name == `` {
+		c.Name = c.ChannelId
+	}
+
+	if c.Color == `` {
+		c.Color = `channel-prefix`
+	}
+
+	if c.HistorySize < 1 {
+		c.HistorySize = DefaultHistorySize
+	}
+
+	return nil
+}
+
+// Whether a character of a given level can use the channel.
+// hasPermission should check the role permissions of the user.
+func (c *ChannelSpec) CanUse(level int, hasPermission func(permissionId string) bool) bool {
+
+	if c.Permission != `` && !hasPermission(c.Permission) {
+		return false
+	}
+
+	if c.MinLevel == 0 && c.MaxLevel == 0 {
+		return true
+	}
+
+	if hasPermission(ModeratePermission) {
+		return true
+	}
+
+	if c.MinLevel > 0 && level < c.MinLevel {
+		return false
+	}
+
+	if c.MaxLevel > 0 && level > c.MaxLevel {
+		return false
+	}
+
+	return true
+}
+
+// Whether a user with the given status (empty if they never joined/left) is in the channel
+func (c *ChannelSpec) IsMember(status Status) bool {
+	if status == `` {
+		return c.AutoJoin
+	}
+	return status == StatusJoined || status == StatusMuted
+}
+
+// The bracketed channel name shown before each message
+func (c *ChannelSpec) Prefix() string {
+	return fmt.Sprintf(`<ansi fg="%s">[%s]</ansi>`, c.Color, c.Name)
+}
+
+// Formats a message as it appears to everyone in the channel
+func (c *ChannelSpec) FormatMessage(name string, message string) string {
+	return fmt.Sprintf(`%s <ansi fg="username">%s</ansi>: <ansi fg="channel-body">%s</ansi>`, c.Prefix(), name, message)
+}
+
+// Records a message in the channel scrollback, dropping the oldest if it's full
+func (c *ChannelSpec) AddHistory(name string, message string) {
+
+	historyLock.Lock()
+	defer historyLock.Unlock()
+
+	entries := append(history[c.ChannelId], HistoryEntry{
+		Sent:    time.Now(),
+		Name:    name,
+		Message: message,
+	})
+
+	if len(entries) > c.HistorySize {
+		entries = entries[len(entries)-c.HistorySize:]
+	}
+
+	history[c.ChannelId] = entries
+}
+
+// Returns a copy of the channel scrollback, oldest first
+func (c *ChannelSpec) GetHistory() []HistoryEntry {
+
+	historyLock.RLock()
+	defer historyLock.RUnlock()
+
+	return append([]HistoryEntry{}, history[c.ChannelId]...)
+}
+
+func GetChannel(channelId string) *ChannelSpec {
+	if c, ok := allChannels[strings.ToLower(channelId)]; ok {
+		return c
+	}
+	return nil
+}
+
+// Finds a channel by id or name.
+// Partial matches are accepted if there is no exact match.
+func FindChannel(search string) *ChannelSpec {
+
+	search = strings.ToLower(strings.TrimSpace(search))
+	if search == `` {
+		return nil
+	}
+
+	if c := GetChannel(search); c != nil {
+		return c
+	}
+
+	var closeMatch *ChannelSpec
+
+	for _, c := range GetAllChannels() {
+
+		name := strings.ToLower(c.Name)
+		if name == search {
+			return c
+		}
+
+		if closeMatch == nil && (strings.HasPrefix(name, search) || strings.HasPrefix(c.ChannelId, search)) {
+			closeMatch = c
+		}
+	}
+
+	return closeMatch
+}
+
+// All channels, sorted by id
+func GetAllChannels() []*ChannelSpec {
+
+	ret := make([]*ChannelSpec, 0, len(allChannels))
+	for _, c := range allChannels {
+		ret = append(ret, c)
+	}
+
+	sort.Slice(ret, func(i, j int) bool {
+		return ret[i].ChannelId < ret[j].ChannelId
+	})
+
+	return ret
+}
+
+func LoadDataFiles() {
+
+	start := time.Now()
+
+	tmpChannels, err := fileloader.LoadAllFlatFiles[string, *ChannelSpec](configs.GetFilePathsConfig().DataFiles.String() + `/channels`)
+	if err != nil {
+		// Worlds without a channels folder just have no custom channels
+		if !os.IsNotExist(err) {
+			panic(err)
+		}
+		tmpChannels = map[string]*ChannelSpec{}
+	}
+
+	allChannels = tmpChannels
+
+	mudlog.Info("channels.LoadDataFiles()", "loadedCount", len(allChannels), "Time Taken", time.Since(start))
+}
@@ -0,0 +1,96 @@
+package channels
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func setupChannels() {
+	allChannels = map[string]*ChannelSpec{}
+	for _, c := range []*ChannelSpec{
+		{ChannelId: `newbie`, Name: `Newbie`, AutoJoin: true, MaxLevel: 10},
+		{ChannelId: `ooc`, Name: `OOC`, AutoJoin: true},
+		{ChannelId: `staff`, Name: `Staff`, Permission: `channel.staff`},
+		{ChannelId: `trade`, Name: `Trade`, MinLevel: 3, HistorySize: 3},
+	} {
+		c.Validate()
+		allChannels[c.ChannelId] = c
+	}
+}
+
+func permissions(permissionIds ...string) func(string) bool {
+	return func(permissionId string) bool {
+		for _, p := range permissionIds {
+			if p == permissionId {
+				return true
+			}
+		}
+		return false
+	}
+}
+
+func TestValidate(t *testing.T) {
+
+	c := &ChannelSpec{ChannelId: `OOC`}
+	assert.NoError(t, c.Validate())
+	assert.Equal(t, `ooc`, c.ChannelId)
+	assert.Equal(t, `ooc`, c.Name)
+	assert.Equal(t, `channel-prefix`, c.Color)
+	assert.Equal(t, DefaultHistorySize, c.HistorySize)
+
+	assert.Error(t, (&ChannelSpec{ChannelId: `out of character`}).Validate())
+	assert.Error(t, (&ChannelSpec{}).Validate())
+}
+
+func TestCanUse(t *testing.T) {
+	setupChannels()
+
+	assert.True(t, GetChannel(`newbie`).CanUse(5, permissions()))
+	assert.False(t, GetChannel(`newbie`).CanUse(11, permissions()))
+	assert.True(t, GetChannel(`newbie`).CanUse(11, permissions(ModeratePermission)), "Moderators ignore level limits")
+
+	assert.False(t, GetChannel(`trade`).CanUse(2, permissions()))
+	assert.True(t, GetChannel(`trade`).CanUse(3, permissions()))
+
+	assert.False(t, GetChannel(`staff`).CanUse(50, permissions()))
+	assert.False(t, GetChannel(`staff`).CanUse(50, permissions(ModeratePermission)))
+	assert.True(t, GetChannel(`staff`).CanUse(1, permissions(`channel.staff`)))
+}
+
+func TestIsMember(t *testing.T) {
+	setupChannels()
+
+	assert.True(t, GetChannel(`ooc`).IsMember(``))
+	assert.False(t, GetChannel(`ooc`).IsMember(StatusLeft))
+	assert.True(t, GetChannel(`ooc`).IsMember(StatusMuted))
+
+	assert.False(t, GetChannel(`trade`).IsMember(``))
+	assert.True(t, GetChannel(`trade`).IsMember(StatusJoined))
+}
+
+func TestFindChannel(t *testing.T) {
+	setupChannels()
+
+	assert.Equal(t, `ooc`, FindChannel(`OOC`).ChannelId)
+	assert.Equal(t, `trade`, FindChannel(`tr`).ChannelId)
+	assert.Nil(t, FindChannel(`gossip`))
+	assert.Nil(t, FindChannel(``))
+}
+
+func TestHistory(t *testing.T) {
+	setupChannels()
+
+	c := GetChannel(`trade`)
+	for i := 1; i <= 5; i++ {
+		c.AddHistory(`Bob`, fmt.Sprintf(`message %d`, i))
+	}
+
+	entries := c.GetHistory()
+	assert.Len(t, entries, 3)
+	assert.Equal(t, `message 3`, entries[0].Message)
+	assert.Equal(t, `message 5`, entries[2].Message)
+
+	assert.Empty(t, GetChannel(`ooc`).GetHistory())
+}
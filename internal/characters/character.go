@@ -8,6 +8,7 @@ import (
 	"time"
 
 	"github.com/GoMudEngine/GoMud/internal/buffs"
+	"github.com/GoMudEngine/GoMud/internal/clans"
 	"github.com/GoMudEngine/GoMud/internal/configs"
 	"github.com/GoMudEngine/GoMud/internal/events"
 	"github.com/GoMudEngine/GoMud/internal/gametime"
@@ -65,11 +66,13 @@ type Character struct {
 	Bank             int                            // The gold the character has in the bank
 	Shop             Shop                           `yaml:"shop,omitempty"`          // Definition of shop services/items this character stocks (or just has at the moment)
 	SpellBook        map[string]int                 `yaml:"spellbook,omitempty"`     // The spells the character has learned
+	Recipes          []string                       `yaml:"recipes,omitempty"`       // The crafting recipes the character has learned
 	Charmed          *CharmInfo                     `yaml:"-"`                       // If they are charmed, this is the info
 	CharmedMobs      []int                          `yaml:"-"`                       // If they have charmed anyone, this is the list of mob instance ids
 	Items            []items.Item                   `yaml:"items,omitempty"`         // The items the character is holding
 	Buffs            buffs.Buffs                    `yaml:"buffs,omitempty"`         // The buffs the character has active
 	Equipment        Worn                           `yaml:"equipment,omitempty"`     // The equipment the character is wearing
+	StatMods         statmods.StatMods              `yaml:"statmods,omitempty"`      // Innate stat mods, such as a mobs elemental resistances
 	TNLScale         float32                        `yaml:"-"`                       // The experience scale of the character. Don't write to yaml since is dynamically calculated.
 	HealthMax        stats.StatInfo                 `yaml:"-"`                       // The maximum health of the character. Don't write to yaml since is dynamically calculated.
 	ManaMax          stats.StatInfo                 `yaml:"-"`                       // The maximum mana of the character. Don't write to yaml since is dynamically calculated.
@@ -441,6 +444,11 @@ func (c *Character) GrantXP(xp int) (actualXP int, xpScale int) {
 
 	xpScale = c.StatMod(string(statmods.XPScale)) + 100
 
+	// Clan members earn more in the zone their clan controls
+	if c.userId > 0 {
+		xpScale += clans.ZoneXPBonus(c.userId, c.Zone)
+	}
+
 	if xpScale == 100 {
 		actualXP = xp
 	} else {
@@ -526,7 +534,7 @@ func (c *Character) GetRandomItem() (items.Item, bool) {
 	if len(c.Items) == 0 {
 		return items.Item{}, false
 	}
-	return c.Items[util.Rand(len(c.Items))], true
+	return c.Items[util.GetRNG(util.RNGSkills).Rand(len(c.Items))], true
 }
 
 // USERNAME appears to be <BLANK>
@@ -687,6 +695,10 @@ func (c *Character) getFormattedName(viewingUserId int, uType string, renderFlag
 		f.PetName = c.Pet.DisplayName()
 	}
 
+	if uType == `username` && c.userId > 0 {
+		f.ClanTag = clans.GetClanTag(c.userId)
+	}
+
 	return f
 }
 
@@ -755,19 +767,16 @@ func (c *Character) StoreItem(i items.Item) bool {
 
 	i.Validate()
 
-	c.Items = append(c.Items, i)
+	c.Items = items.AddToStack(c.Items, i)
 
 	return true
 }
 
+// Removes the item, or as many as its quantity from a stack
 func (c *Character) RemoveItem(i items.Item) bool {
-	for j := len(c.Items) - 1; j >= 0; j-- {
-		if c.Items[j].Equals(i) {
-			c.Items = append(c.Items[:j], c.Items[j+1:]...)
-			return true
-		}
-	}
-	return false
+	var removed bool
+	c.Items, removed = items.RemoveFromStack(c.Items, i)
+	return removed
 }
 
 func (c *Character) HandsRequired(i items.Item) int {
@@ -803,7 +812,19 @@ func (c *Character) UpdateItem(originalItm items.Item, replacement items.Item) b
 		if c.Items[j].Equals(originalItm) {
 			// If the number of uses remaining has decremented from the original item
 			// The item gets destroyed from existence
-			if originalItm.Uses >= 1 && replacement.Uses < 1 {
+			destroyed := originalItm.Uses >= 1 && replacement.Uses < 1
+
+			// Only part of a stack is changing, so split it off from the rest
+			if c.Items[j].GetQuantity() > originalItm.GetQuantity() {
+				c.Items[j].SetQuantity(c.Items[j].GetQuantity() - originalItm.GetQuantity())
+				if !destroyed {
+					replacement.SetQuantity(originalItm.GetQuantity())
+					c.Items = items.AddToStack(c.Items, replacement)
+				}
+				return true
+			}
+
+			if destroyed {
 				c.Items = append(c.Items[:j], c.Items[j+1:]...)
 			} else {
 				c.Items[j] = replacement
@@ -821,6 +842,18 @@ func (c *Character) UseItem(i items.Item) int {
 			if usesLeft > 0 {
 				usesLeft--
 			}
+			// Using one from a stack splits it off from the rest
+			if c.Items[j].GetQuantity() > 1 {
+				used := c.Items[j].Single()
+				c.Items[j].SetQuantity(c.Items[j].GetQuantity() - 1)
+				if usesLeft > 0 {
+					used.Uses = usesLeft
+					used.LastUsedRound = util.GetRoundCount()
+					c.Items = items.AddToStack(c.Items, used)
+				}
+				return usesLeft
+			}
+
 			if usesLeft <= 0 {
 				c.Items = append(c.Items[:j], c.Items[j+1:]...)
 			} else {
@@ -843,6 +876,39 @@ func (c *Character) FindInBackpack(itemName string) (items.Item, bool) {
 
 	closeMatchItem, matchItem := items.FindMatchIn(itemName, c.Items...)
 
+	// Only one from a stack is returned
+	if matchItem.ItemId != 0 {
+		return matchItem.Single(), true
+	}
+
+	if closeMatchItem.ItemId != 0 {
+		return closeMatchItem.Single(), true
+	}
+
+	return items.Item{}, false
+}
+
+// How many of an item found in the backpack are in its stack
+func (c *Character) CountInBackpack(i items.Item) int {
+	return items.QuantityIn(i, c.Items...)
+}
+
+// Finds a bag, pouch, etc. in the backpack
+func (c *Character) FindContainerInBackpack(itemName string) (items.Item, bool) {
+
+	if itemName == `` {
+		return items.Item{}, false
+	}
+
+	containers := []items.Item{}
+	for _, itm := range c.Items {
+		if itm.IsContainer() {
+			containers = append(containers, itm)
+		}
+	}
+
+	closeMatchItem, matchItem := items.FindMatchIn(itemName, containers...)
+
 	if matchItem.ItemId != 0 {
 		return matchItem, true
 	}
@@ -1018,6 +1084,33 @@ func (c *Character) HasQuest(questToken string) bool {
 	return quests.IsTokenAfter(questToken, currentToken)
 }
 
+// Adds a crafting recipe to the learned recipes. Returns false if already learned.
+func (c *Character) LearnRecipe(recipeId string) bool {
+
+	recipeId = strings.ToLower(recipeId)
+
+	if c.HasLearnedRecipe(recipeId) {
+		return false
+	}
+
+	c.Recipes = append(c.Recipes, recipeId)
+
+	return true
+}
+
+func (c *Character) HasLearnedRecipe(recipeId string) bool {
+
+	recipeId = strings.ToLower(recipeId)
+
+	for _, learnedId := range c.Recipes {
+		if learnedId == recipeId {
+			return true
+		}
+	}
+
+	return false
+}
+
 func (c *Character) GetQuestProgress() map[int]string {
 
 	if c.QuestProgress == nil {
@@ -1364,11 +1457,55 @@ func (c *Character) MovementCost() int {
 	modifier := 3                                // by default they should be able to move 3 times per round.
 	modifier += int(c.Level / 15)                // Every 15 levels, get an extra movement.
 	modifier += int(c.Stats.Speed.ValueAdj / 15) // Every 15 speed, get an extra movement
-	return int(1000 / modifier)
+	if modifier < 1 {
+		modifier = 1
+	}
+	// Carrying a heavy load makes each move cost more
+	return int(1000/modifier) * (100 + c.GetEncumbrance().MovementPenalty()) / 100
 }
 
 func (c *Character) StatMod(statName string) int {
-	return c.Equipment.StatMod(statName) + c.Buffs.StatMod(statName) + c.Pet.StatMod(statName)
+	raceMod := 0
+	if raceInfo := races.GetRace(c.RaceId); raceInfo != nil {
+		raceMod = raceInfo.StatMods.Get(statName)
+	}
+	return c.Equipment.StatMod(statName) + c.Buffs.StatMod(statName) + c.Pet.StatMod(statName) + c.StatMods.Get(statName) + raceMod
+}
+
+// The % of damage from an element that is resisted.
+// Ranges from -100 (double damage) to 100 (immune)
+func (c *Character) GetResistance(element items.Element) int {
+	if element == `` {
+		return 0
+	}
+	resist := c.StatMod(element.ResistStatMod())
+	if resist > 100 {
+		return 100
+	}
+	if resist < -100 {
+		return -100
+	}
+	return resist
+}
+
+// All non-zero resistances (and vulnerabilities), keyed by element name
+func (c *Character) GetResistances() map[string]int {
+	ret := map[string]int{}
+	for _, element := range items.AllElements() {
+		if resist := c.GetResistance(element); resist != 0 {
+			ret[string(element)] = resist
+		}
+	}
+	return ret
+}
+
+// Adjusts damage of an element by how resistant the character is to it
+func (c *Character) ApplyResistance(damage int, element items.Element) int {
+	resist := c.GetResistance(element)
+	if resist == 0 || damage <= 0 {
+		return damage
+	}
+	return int(math.Round(float64(damage) * float64(100-resist) / 100))
 }
 
 // returns true if something has changed.
@@ -1405,6 +1542,8 @@ func (c *Character) RecalculateStats() {
 	// Stats are basically:
 	// level*base + training + mods
 	c.Stats.Strength.Recalculate(c.Level)
+	// Encumbrance relies on strength, so this has to come afterwards
+	c.Stats.Speed.Mods -= c.GetEncumbrance().SpeedPenalty()
 	c.Stats.Speed.Recalculate(c.Level)
 	c.Stats.Smarts.Recalculate(c.Level)
 	c.Stats.Vitality.Recalculate(c.Level)
@@ -1479,7 +1618,7 @@ func (c *Character) AutoTrain() {
 
 	for c.StatPoints > 0 {
 
-		switch util.Rand(6) {
+		switch util.GetRNG(util.RNGSkills).Rand(6) {
 		case 0:
 			c.Stats.Strength.Training++
 		case 1:
@@ -7,7 +7,9 @@ import (
 
 	"maps"
 
+	"github.com/GoMudEngine/GoMud/internal/items"
 	"github.com/GoMudEngine/GoMud/internal/skills"
+	"github.com/GoMudEngine/GoMud/internal/statmods"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -2118,3 +2120,79 @@ func TestCharacter_GetSkillLevel(t *testing.T) {
 		})
 	}
 }
+
+func TestCharacter_ApplyResistance(t *testing.T) {
+	tests := []struct {
+		name     string
+		statMods statmods.StatMods
+		element  items.Element
+		damage   int
+		expected int
+	}{
+		{
+			name:     "No element",
+			statMods: statmods.StatMods{`resist-fire`: 50},
+			element:  ``,
+			damage:   10,
+			expected: 10,
+		},
+		{
+			name:     "No resistance",
+			statMods: nil,
+			element:  items.Fire,
+			damage:   10,
+			expected: 10,
+		},
+		{
+			name:     "Resistant",
+			statMods: statmods.StatMods{`resist-fire`: 50},
+			element:  items.Fire,
+			damage:   10,
+			expected: 5,
+		},
+		{
+			name:     "Other element",
+			statMods: statmods.StatMods{`resist-fire`: 50},
+			element:  items.Ice,
+			damage:   10,
+			expected: 10,
+		},
+		{
+			name:     "Vulnerable",
+			statMods: statmods.StatMods{`resist-acid`: -50},
+			element:  items.Acid,
+			damage:   10,
+			expected: 15,
+		},
+		{
+			name:     "Immunity is capped",
+			statMods: statmods.StatMods{`resist-death`: 150},
+			element:  items.Death,
+			damage:   10,
+			expected: 0,
+		},
+		{
+			name:     "Vulnerability is capped",
+			statMods: statmods.StatMods{`resist-life`: -300},
+			element:  items.Life,
+			damage:   10,
+			expected: 20,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := New()
+			c.StatMods = tt.statMods
+			assert.Equal(t, tt.expected, c.ApplyResistance(tt.damage, tt.element))
+		})
+	}
+}
+
+func TestCharacter_GetResistances(t *testing.T) {
+	c := New()
+	assert.Empty(t, c.GetResistances())
+
+	c.StatMods = statmods.StatMods{`resist-fire`: 25, `resist-ice`: -10, `resist-water`: 0, `strength`: 5}
+	assert.Equal(t, map[string]int{`fire`: 25, `ice`: -10}, c.GetResistances())
+}
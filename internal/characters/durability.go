@@ -0,0 +1,70 @@
+package characters
+
+import (
+	"github.com/GoMudEngine/GoMud/internal/configs"
+	"github.com/GoMudEngine/GoMud/internal/items"
+	"github.com/GoMudEngine/GoMud/internal/util"
+)
+
+// Rolls for wear on the wielded weapon after it lands a hit.
+// Returns the weapon if it dropped to a worse condition.
+func (c *Character) WearWeapon() (items.Item, bool) {
+	if !rollWear() {
+		return items.Item{}, false
+	}
+	return c.wearSlot(&c.Equipment.Weapon)
+}
+
+// Rolls for wear on a random piece of worn armor after being hit.
+// Returns the armor if it dropped to a worse condition.
+func (c *Character) WearArmor() (items.Item, bool) {
+	if !rollWear() {
+		return items.Item{}, false
+	}
+
+	armor := []*items.Item{}
+	for _, slot := range []*items.Item{
+		&c.Equipment.Offhand,
+		&c.Equipment.Head,
+		&c.Equipment.Neck,
+		&c.Equipment.Body,
+		&c.Equipment.Belt,
+		&c.Equipment.Gloves,
+		&c.Equipment.Ring,
+		&c.Equipment.Legs,
+		&c.Equipment.Feet,
+	} {
+		if slot.HasCondition() && !slot.IsBroken() {
+			armor = append(armor, slot)
+		}
+	}
+
+	if len(armor) == 0 {
+		return items.Item{}, false
+	}
+
+	return c.wearSlot(armor[util.GetRNG(util.RNGCombat).Rand(len(armor))])
+}
+
+func (c *Character) wearSlot(slot *items.Item) (items.Item, bool) {
+	if !slot.HasCondition() || slot.IsBroken() {
+		return items.Item{}, false
+	}
+
+	if !slot.AddWear(1) {
+		return items.Item{}, false
+	}
+
+	// Bonuses from the item have changed
+	c.RecalculateStats()
+
+	return *slot, true
+}
+
+func rollWear() bool {
+	gp := configs.GetGamePlayConfig()
+	if !gp.Durability.Enabled {
+		return false
+	}
+	return util.GetRNG(util.RNGCombat).Rand(100) < int(gp.Durability.WearChance)
+}
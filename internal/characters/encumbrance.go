@@ -0,0 +1,115 @@
+package characters
+
+import (
+	"github.com/GoMudEngine/GoMud/internal/configs"
+	"github.com/GoMudEngine/GoMud/internal/items"
+)
+
+// How weighed down a character is by what they carry
+type Encumbrance int
+
+const (
+	Unencumbered Encumbrance = iota // Up to half of their carry capacity
+	Burdened                        // Over half of their carry capacity
+	Encumbered                      // Over 3/4 of their carry capacity
+	Overloaded                      // Over their carry capacity
+)
+
+func (e Encumbrance) String() string {
+	switch e {
+	case Burdened:
+		return `burdened`
+	case Encumbered:
+		return `encumbered`
+	case Overloaded:
+		return `overloaded`
+	}
+	return `unencumbered`
+}
+
+// How much speed is lost at this level of encumbrance
+func (e Encumbrance) SpeedPenalty() int {
+	switch e {
+	case Burdened:
+		return 5
+	case Encumbered:
+		return 10
+	case Overloaded:
+		return 25
+	}
+	return 0
+}
+
+// The % that movement costs are increased at this level of encumbrance
+func (e Encumbrance) MovementPenalty() int {
+	switch e {
+	case Burdened:
+		return 25
+	case Encumbered:
+		return 50
+	case Overloaded:
+		return 100
+	}
+	return 0
+}
+
+// Total weight of everything in the backpack and worn
+func (c *Character) CarryWeight() int {
+	total := 0
+	for _, itm := range c.Items {
+		total += itm.GetWeight()
+	}
+	for _, itm := range c.Equipment.GetAllItems() {
+		total += itm.GetWeight()
+	}
+	return total
+}
+
+// How much weight the character can carry before they are overloaded
+func (c *Character) MaxCarryWeight() int {
+	cfg := configs.GetGamePlayConfig().Encumbrance
+	return int(cfg.BaseCarryWeight) + c.Stats.Strength.ValueAdj*int(cfg.CarryWeightPerStrength)
+}
+
+func (c *Character) GetEncumbrance() Encumbrance {
+
+	if !configs.GetGamePlayConfig().Encumbrance.Enabled {
+		return Unencumbered
+	}
+
+	maxWeight := c.MaxCarryWeight()
+	weight := c.CarryWeight()
+
+	if weight > maxWeight {
+		return Overloaded
+	}
+	if weight*4 > maxWeight*3 {
+		return Encumbered
+	}
+	if weight*2 > maxWeight {
+		return Burdened
+	}
+	return Unencumbered
+}
+
+// Whether they are carrying so much that moving is a struggle.
+// If weight is disabled, this falls back to the number of objects carried.
+func (c *Character) IsOverloaded() bool {
+	if !configs.GetGamePlayConfig().Encumbrance.Enabled {
+		return len(c.Items) > c.CarryCapacity()
+	}
+	return c.CarryWeight() > c.MaxCarryWeight()
+}
+
+// Whether the character can pick up an item without being overloaded
+func (c *Character) CanCarry(itm items.Item) bool {
+	return c.CanCarryWeight(itm.GetWeight())
+}
+
+// Whether the character can take on extra weight without being overloaded
+func (c *Character) CanCarryWeight(extraWeight int) bool {
+	if !configs.GetGamePlayConfig().Encumbrance.Enabled || extraWeight <= 0 {
+		return true
+	}
+	return c.CarryWeight()+extraWeight <= c.MaxCarryWeight()
+}
@@ -0,0 +1,52 @@
+package characters
+
+import (
+	"testing"
+
+	"github.com/GoMudEngine/GoMud/internal/configs"
+	"github.com/GoMudEngine/GoMud/internal/items"
+	"github.com/stretchr/testify/assert"
+)
+
+func newWeightedItem(weight int) items.Item {
+	return items.Item{ItemId: 1, Spec: &items.ItemSpec{Type: items.Object, Weight: weight}}
+}
+
+func TestCharacter_GetEncumbrance(t *testing.T) {
+
+	configs.AddOverlayOverrides(map[string]any{
+		`GamePlay.Encumbrance.Enabled`:                true,
+		`GamePlay.Encumbrance.BaseCarryWeight`:        40,
+		`GamePlay.Encumbrance.CarryWeightPerStrength`: 0,
+	})
+
+	c := &Character{}
+	assert.Equal(t, 40, c.MaxCarryWeight())
+	assert.Equal(t, Unencumbered, c.GetEncumbrance())
+
+	c.Items = []items.Item{newWeightedItem(20)}
+	assert.Equal(t, Unencumbered, c.GetEncumbrance(), "Exactly half should not be burdened")
+
+	c.Equipment.Body = items.Item{ItemId: 2, Spec: &items.ItemSpec{Type: items.Body, Weight: 5}}
+	assert.Equal(t, 25, c.CarryWeight(), "Worn equipment should count")
+	assert.Equal(t, Burdened, c.GetEncumbrance())
+
+	c.Items = append(c.Items, newWeightedItem(6))
+	assert.Equal(t, Encumbered, c.GetEncumbrance())
+	assert.True(t, c.CanCarry(newWeightedItem(9)))
+	assert.False(t, c.CanCarry(newWeightedItem(10)))
+	assert.True(t, c.CanCarryWeight(-5), "Losing weight is always fine")
+
+	c.Items = append(c.Items, newWeightedItem(10))
+	assert.Equal(t, Overloaded, c.GetEncumbrance())
+	assert.True(t, c.IsOverloaded())
+	assert.Greater(t, c.GetEncumbrance().MovementPenalty(), Encumbered.MovementPenalty())
+
+	configs.AddOverlayOverrides(map[string]any{
+		`GamePlay.Encumbrance.Enabled`: false,
+	})
+
+	assert.Equal(t, Unencumbered, c.GetEncumbrance(), "Weight is ignored when disabled")
+	assert.True(t, c.CanCarry(newWeightedItem(100)))
+	assert.False(t, c.IsOverloaded(), "Falls back to the number of items carried")
+}
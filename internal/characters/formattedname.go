@@ -40,6 +40,7 @@ type FormattedName struct {
 	UseShortAdjectives bool   // Whether to failover to short adjectives
 	QuestAlert         bool   // Whether this mob is relevant to a current quest
 	PetName            string // Name of pet (if any)
+	ClanTag            string // Tag of the clan they belong to (if any)
 }
 
 func (f FormattedName) String() string {
@@ -73,6 +74,10 @@ func (f FormattedName) String() string {
 		output += `)</ansi>`
 	}
 
+	if f.ClanTag != `` {
+		output = `<ansi fg="clantag">[` + f.ClanTag + `]</ansi> ` + output
+	}
+
 	if f.QuestAlert {
 		output = `<ansi fg="questflag">★</ansi>` + output
 	}
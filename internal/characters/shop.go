@@ -11,6 +11,8 @@ import (
 const (
 	StockTemporary = -1
 	StockUnlimited = 0
+
+	ServiceRepair = `repair`
 )
 
 type Shop []ShopItem
@@ -20,6 +22,7 @@ type ShopItem struct {
 	ItemId      int    `yaml:"itemid,omitempty"`      // Is it an item for sale?
 	BuffId      int    `yaml:"buffid,omitempty"`      // Does this shop keeper apply a buff if purchased?
 	PetType     string `yaml:"pettype,omitempty"`     // Does this shop sell pets?
+	Service     string `yaml:"service,omitempty"`     // Does this shop offer a service? (repair)
 	Quantity    int    `yaml:"quantity,omitempty"`    // How many currently avilable
 	QuantityMax int    `yaml:"quantitymax,omitempty"` // 0 for unlimited, or a maximum that can be stocked at one time
 	Price       int    `yaml:"price,omitempty"`       // If a price is provided, use it
@@ -188,6 +191,16 @@ func (s *Shop) GetInstock() Shop {
 	return ret
 }
 
+// Finds a service this shop offers, such as ServiceRepair
+func (s *Shop) GetService(service string) (ShopItem, bool) {
+	for _, fsItem := range *s {
+		if fsItem.Service == service && fsItem.Available() {
+			return fsItem, true
+		}
+	}
+	return ShopItem{}, false
+}
+
 func (si *ShopItem) Available() bool {
 	return si.Quantity > 0 || si.QuantityMax == StockUnlimited
 }
@@ -1,9 +1,18 @@
 package clans
 
 import (
+	"errors"
+	"os"
+	"sort"
+	"strings"
 	"time"
 
+	"github.com/GoMudEngine/GoMud/internal/configs"
+	"github.com/GoMudEngine/GoMud/internal/events"
 	"github.com/GoMudEngine/GoMud/internal/items"
+	"github.com/GoMudEngine/GoMud/internal/mudlog"
+	"github.com/GoMudEngine/GoMud/internal/util"
+	"gopkg.in/yaml.v2"
 )
 
 type ClanRank string
@@ -12,6 +21,31 @@ const (
 	ClanRankMember     ClanRank = `member`     // normal members get no special privileges
 	ClanRankLieutenant ClanRank = `lieutenant` // Lieutenants can accept applications
 	ClanRankLeader     ClanRank = `leader`     // Leaders can invite, kick, accept applications and promote members
+
+	ClanFilename = `clans.yaml`
+
+	TagLengthMin  = 2
+	TagLengthMax  = 4
+	NameLengthMin = 3
+	NameLengthMax = 30
+
+	donationHistorySize = 20 // How many donations to remember per clan
+	upkeepGraceDays     = 3  // How many days in a row a clan can miss its upkeep before it disbands
+)
+
+var (
+	// key is the lowercase clan tag
+	allClans = map[string]*ClanInfo{}
+	// key is the user id, value is the lowercase clan tag
+	memberIndex = map[int]string{}
+
+	ErrInvalidTag    = errors.New(`Clan tags must be 2 to 4 letters or numbers.`)
+	ErrInvalidName   = errors.New(`Clan names must be 3 to 30 letters, numbers or spaces.`)
+	ErrClanExists    = errors.New(`A clan with that tag or name already exists.`)
+	ErrAlreadyInClan = errors.New(`That player is already in a clan.`)
+	ErrClanFull      = errors.New(`The clan has no room for more members.`)
+	ErrNotEnoughGold = errors.New(`The clan bank doesn't have enough gold.`)
+	ErrZoneClaimed   = errors.New(`That zone is controlled by another clan.`)
 )
 
 type ClanInfo struct {
@@ -22,7 +56,12 @@ type ClanInfo struct {
 	MemberUpkeep int          `json:"memberupkeep"` // Daily Gold upkeep cost per member
 	Members      []ClanMember `json:"members"`      // List of clan members
 	Applications []ClanMember `json:"applications"` // List of clan applications
-	Donations    []ClanMember `json:"donations"`    // List of clan donations
+	Invites      []ClanMember `json:"invites"`      // List of players invited to join
+	Donations    []Donation   `json:"donations"`    // Most recent clan donations
+	Gold         int          `json:"gold"`         // Gold in the clan bank
+	UnpaidDays   int          `json:"unpaiddays"`   // Days in a row the upkeep couldn't be paid
+	Items        []items.Item `json:"items"`        // Items in the clan bank
+	Created      time.Time    `json:"created"`      // When the clan was founded
 }
 
 type ClanMember struct {
@@ -33,8 +72,615 @@ type ClanMember struct {
 }
 
 type Donation struct {
-	UserId int        `json:"userid"` // User ID of the clan member
-	Gold   int        `json:"gold"`   // Amount of gold donated
-	Item   items.Item `json:"item"`   // Item donated
-	Date   time.Time  `json:"date"`   // Date and time the donation was made
+	UserId int        `json:"userid"`                     // User ID of the clan member
+	Gold   int        `json:"gold"`                       // Amount of gold donated
+	Item   items.Item `json:"item" yaml:"item,omitempty"` // Item donated
+	Date   time.Time  `json:"date"`                       // Date and time the donation was made
+}
+
+// A read only summary of a clan, used for leaderboards
+type ClanSummary struct {
+	Rank     int
+	ClanTag  string
+	ClanName string
+	Zone     string
+	Members  int
+	Gold     int
+	Created  time.Time
+}
+
+// Creates a new clan led by userId. The caller is responsible for charging any cost.
+func Create(tag string, name string, userId int, characterName string) (*ClanInfo, error) {
+
+	tag = strings.TrimSpace(tag)
+	name = strings.Join(strings.Fields(name), ` `)
+
+	if !ValidTag(tag) {
+		return nil, ErrInvalidTag
+	}
+
+	if !ValidName(name) {
+		return nil, ErrInvalidName
+	}
+
+	if _, ok := memberIndex[userId]; ok {
+		return nil, ErrAlreadyInClan
+	}
+
+	for _, c := range allClans {
+		if strings.EqualFold(c.ClanTag, tag) || strings.EqualFold(c.ClanName, name) {
+			return nil, ErrClanExists
+		}
+	}
+
+	clanConfig := configs.GetClansConfig()
+
+	c := &ClanInfo{
+		ClanTag:      tag,
+		ClanName:     name,
+		Upkeep:       int(clanConfig.Upkeep),
+		MemberUpkeep: int(clanConfig.MemberUpkeep),
+		Members:      []ClanMember{},
+		Applications: []ClanMember{},
+		Invites:      []ClanMember{},
+		Donations:    []Donation{},
+		Items:        []items.Item{},
+		Created:      time.Now(),
+	}
+
+	allClans[strings.ToLower(tag)] = c
+
+	// Drop any pending applications or invites elsewhere
+	removePending(userId)
+
+	c.Members = append(c.Members, ClanMember{
+		UserId:        userId,
+		CharacterName: characterName,
+		Joined:        time.Now(),
+		Rank:          ClanRankLeader,
+	})
+	memberIndex[userId] = strings.ToLower(tag)
+
+	save()
+
+	return c, nil
+}
+
+func ValidTag(tag string) bool {
+	if len(tag) < TagLengthMin || len(tag) > TagLengthMax {
+		return false
+	}
+	for _, r := range tag {
+		if !(r >= 'a' && r <= 'z') && !(r >= 'A' && r <= 'Z') && !(r >= '0' && r <= '9') {
+			return false
+		}
+	}
+	return true
+}
+
+func ValidName(name string) bool {
+	if len(name) < NameLengthMin || len(name) > NameLengthMax {
+		return false
+	}
+	for _, r := range name {
+		if !(r >= 'a' && r <= 'z') && !(r >= 'A' && r <= 'Z') && !(r >= '0' && r <= '9') && r != ' ' && r != '\'' && r != '-' {
+			return false
+		}
+	}
+	return true
+}
+
+// Finds a clan by its tag or full name (case insensitive)
+func Get(tagOrName string) *ClanInfo {
+
+	if c, ok := allClans[strings.ToLower(tagOrName)]; ok {
+		return c
+	}
+
+	for _, c := range allClans {
+		if strings.EqualFold(c.ClanName, tagOrName) {
+			return c
+		}
+	}
+
+	return nil
+}
+
+// Returns the clan a user is a member of, or nil
+func GetByUserId(userId int) *ClanInfo {
+	if tag, ok := memberIndex[userId]; ok {
+		return allClans[tag]
+	}
+	return nil
+}
+
+// Returns the tag of the clan a user is a member of, or an empty string
+func GetClanTag(userId int) string {
+	if c := GetByUserId(userId); c != nil {
+		return c.ClanTag
+	}
+	return ``
+}
+
+// Returns the clan that controls a zone, or nil
+func GetZoneOwner(zoneName string) *ClanInfo {
+
+	if zoneName == `` {
+		return nil
+	}
+
+	for _, c := range allClans {
+		if strings.EqualFold(c.Zone, zoneName) {
+			return c
+		}
+	}
+
+	return nil
+}
+
+// Extra experience % a user gets for being in the zone their clan controls
+func ZoneXPBonus(userId int, zoneName string) int {
+
+	if c := GetByUserId(userId); c != nil && c.Zone != `` && strings.EqualFold(c.Zone, zoneName) {
+		return int(configs.GetClansConfig().ZoneXPBonus)
+	}
+
+	return 0
+}
+
+// Returns all clans sorted by tag
+func GetAll() []*ClanInfo {
+
+	ret := make([]*ClanInfo, 0, len(allClans))
+	for _, c := range allClans {
+		ret = append(ret, c)
+	}
+
+	sort.Slice(ret, func(i, j int) bool {
+		return strings.ToLower(ret[i].ClanTag) < strings.ToLower(ret[j].ClanTag)
+	})
+
+	return ret
+}
+
+// Clans ranked by member count, then by gold in the bank
+func GetLeaderboard() []ClanSummary {
+
+	ret := make([]ClanSummary, 0, len(allClans))
+	for _, c := range allClans {
+		ret = append(ret, ClanSummary{
+			ClanTag:  c.ClanTag,
+			ClanName: c.ClanName,
+			Zone:     c.Zone,
+			Members:  len(c.Members),
+			Gold:     c.Gold,
+			Created:  c.Created,
+		})
+	}
+
+	sort.Slice(ret, func(i, j int) bool {
+		if ret[i].Members != ret[j].Members {
+			return ret[i].Members > ret[j].Members
+		}
+		if ret[i].Gold != ret[j].Gold {
+			return ret[i].Gold > ret[j].Gold
+		}
+		return ret[i].ClanTag < ret[j].ClanTag
+	})
+
+	for i := range ret {
+		ret[i].Rank = i + 1
+	}
+
+	return ret
+}
+
+// Removes a clan entirely.
+// Whatever is left in the clan bank goes to the longest serving leader.
+func Disband(c *ClanInfo) {
+
+	if leaders := c.GetLeaders(); len(leaders) > 0 && (c.Gold > 0 || len(c.Items) > 0) {
+		events.AddToQueue(events.ClanDisbanded{
+			ClanTag:  c.ClanTag,
+			ClanName: c.ClanName,
+			LeaderId: leaders[0].UserId,
+			Gold:     c.Gold,
+			Items:    append([]items.Item{}, c.Items...),
+		})
+	}
+
+	for _, m := range c.Members {
+		delete(memberIndex, m.UserId)
+	}
+
+	delete(allClans, strings.ToLower(c.ClanTag))
+
+	save()
+}
+
+// Takes the daily upkeep from every clan bank.
+// A clan that can't pay has a few days to catch up before it disbands.
+// Returns the clans that have been disbanded.
+func ChargeUpkeep() []ClanInfo {
+
+	disbanded := []ClanInfo{}
+
+	for _, c := range GetAll() {
+
+		cost := c.DailyUpkeep()
+
+		if c.Gold < cost {
+			c.UnpaidDays++
+			if c.UnpaidDays > upkeepGraceDays {
+				disbanded = append(disbanded, *c)
+				Disband(c)
+			}
+			continue
+		}
+
+		c.Gold -= cost
+		c.UnpaidDays = 0
+	}
+
+	save()
+
+	return disbanded
+}
+
+func (c *ClanInfo) DailyUpkeep() int {
+	return c.Upkeep + (c.MemberUpkeep * len(c.Members))
+}
+
+// How many more days of missed upkeep the clan can survive, or -1 if it is paid up
+func (c *ClanInfo) GraceDaysLeft() int {
+	if c.UnpaidDays == 0 {
+		return -1
+	}
+	return upkeepGraceDays - c.UnpaidDays
+}
+
+// How many days the clan bank can cover
+func (c *ClanInfo) DaysOfUpkeep() int {
+	if upkeep := c.DailyUpkeep(); upkeep > 0 {
+		return c.Gold / upkeep
+	}
+	return -1
+}
+
+func (c *ClanInfo) GetMember(userId int) (ClanMember, bool) {
+	for _, m := range c.Members {
+		if m.UserId == userId {
+			return m, true
+		}
+	}
+	return ClanMember{}, false
+}
+
+// Finds a member by character name (case insensitive)
+func (c *ClanInfo) FindMember(characterName string) (ClanMember, bool) {
+	for _, m := range c.Members {
+		if strings.EqualFold(m.CharacterName, characterName) {
+			return m, true
+		}
+	}
+	return ClanMember{}, false
+}
+
+func (c *ClanInfo) IsMember(userId int) bool {
+	_, ok := c.GetMember(userId)
+	return ok
+}
+
+func (c *ClanInfo) GetRank(userId int) ClanRank {
+	if m, ok := c.GetMember(userId); ok {
+		return m.Rank
+	}
+	return ``
+}
+
+func (c *ClanInfo) IsLeader(userId int) bool {
+	return c.GetRank(userId) == ClanRankLeader
+}
+
+// Leaders and lieutenants can accept applications
+func (c *ClanInfo) CanAccept(userId int) bool {
+	rank := c.GetRank(userId)
+	return rank == ClanRankLeader || rank == ClanRankLieutenant
+}
+
+func (c *ClanInfo) GetLeaders() []ClanMember {
+	ret := []ClanMember{}
+	for _, m := range c.Members {
+		if m.Rank == ClanRankLeader {
+			ret = append(ret, m)
+		}
+	}
+	return ret
+}
+
+func (c *ClanInfo) GetMemberIds() []int {
+	ret := make([]int, 0, len(c.Members))
+	for _, m := range c.Members {
+		ret = append(ret, m.UserId)
+	}
+	return ret
+}
+
+func (c *ClanInfo) HasApplied(userId int) bool {
+	return findPending(c.Applications, userId) > -1
+}
+
+func (c *ClanInfo) IsInvited(userId int) bool {
+	return findPending(c.Invites, userId) > -1
+}
+
+// Finds an applicant by character name (case insensitive)
+func (c *ClanInfo) FindApplication(characterName string) (ClanMember, bool) {
+	for _, m := range c.Applications {
+		if strings.EqualFold(m.CharacterName, characterName) {
+			return m, true
+		}
+	}
+	return ClanMember{}, false
+}
+
+func (c *ClanInfo) Apply(userId int, characterName string) {
+	if c.HasApplied(userId) {
+		return
+	}
+	c.Applications = append(c.Applications, ClanMember{UserId: userId, CharacterName: characterName, Joined: time.Now(), Rank: ClanRankMember})
+	save()
+}
+
+func (c *ClanInfo) Invite(userId int, characterName string) {
+	if c.IsInvited(userId) {
+		return
+	}
+	c.Invites = append(c.Invites, ClanMember{UserId: userId, CharacterName: characterName, Joined: time.Now(), Rank: ClanRankMember})
+	save()
+}
+
+// Makes a user a member, removing any applications or invites they have anywhere
+func (c *ClanInfo) AddMember(userId int, characterName string) error {
+
+	if _, ok := memberIndex[userId]; ok {
+		return ErrAlreadyInClan
+	}
+
+	if len(c.Members) >= int(configs.GetClansConfig().MaxMembers) {
+		return ErrClanFull
+	}
+
+	removePending(userId)
+
+	c.Members = append(c.Members, ClanMember{
+		UserId:        userId,
+		CharacterName: characterName,
+		Joined:        time.Now(),
+		Rank:          ClanRankMember,
+	})
+	memberIndex[userId] = strings.ToLower(c.ClanTag)
+
+	save()
+
+	return nil
+}
+
+// Removes a member. If the last leader leaves, the longest serving member takes over.
+// Returns false if they weren't a member.
+func (c *ClanInfo) RemoveMember(userId int) bool {
+
+	for i, m := range c.Members {
+		if m.UserId != userId {
+			continue
+		}
+
+		c.Members = append(c.Members[:i], c.Members[i+1:]...)
+		delete(memberIndex, userId)
+
+		if len(c.Members) > 0 && len(c.GetLeaders()) == 0 {
+			sort.SliceStable(c.Members, func(i, j int) bool {
+				return c.Members[i].Joined.Before(c.Members[j].Joined)
+			})
+			c.Members[0].Rank = ClanRankLeader
+		}
+
+		save()
+		return true
+	}
+
+	return false
+}
+
+// Moves a member up one rank. Returns their new rank.
+func (c *ClanInfo) Promote(userId int) (ClanRank, bool) {
+
+	for i, m := range c.Members {
+		if m.UserId != userId {
+			continue
+		}
+
+		switch m.Rank {
+		case ClanRankMember:
+			c.Members[i].Rank = ClanRankLieutenant
+		case ClanRankLieutenant:
+			c.Members[i].Rank = ClanRankLeader
+		default:
+			return m.Rank, false
+		}
+
+		save()
+		return c.Members[i].Rank, true
+	}
+
+	return ``, false
+}
+
+// Adds gold and/or an item to the clan bank
+func (c *ClanInfo) Donate(userId int, gold int, item items.Item) {
+
+	if gold > 0 {
+		c.Gold += gold
+	}
+
+	if item.ItemId != 0 {
+		c.Items = append(c.Items, item)
+	}
+
+	c.Donations = append(c.Donations, Donation{
+		UserId: userId,
+		Gold:   gold,
+		Item:   item,
+		Date:   time.Now(),
+	})
+
+	if len(c.Donations) > donationHistorySize {
+		c.Donations = c.Donations[len(c.Donations)-donationHistorySize:]
+	}
+
+	save()
+}
+
+func (c *ClanInfo) WithdrawGold(amount int) error {
+
+	if amount > c.Gold {
+		return ErrNotEnoughGold
+	}
+
+	c.Gold -= amount
+	save()
+
+	return nil
+}
+
+// Removes an item from the clan bank by name
+func (c *ClanInfo) WithdrawItem(itemName string) (items.Item, bool) {
+
+	item, found := c.FindItem(itemName)
+	if !found {
+		return items.Item{}, false
+	}
+
+	for i, itm := range c.Items {
+		if itm.Equals(item) {
+			c.Items = append(c.Items[:i], c.Items[i+1:]...)
+			break
+		}
+	}
+
+	save()
+
+	return item, true
+}
+
+func (c *ClanInfo) FindItem(itemName string) (items.Item, bool) {
+
+	match, closeMatch := items.FindMatchIn(itemName, c.Items...)
+	if match.ItemId == 0 {
+		match = closeMatch
+	}
+
+	return match, match.ItemId != 0
+}
+
+// Takes control of a zone, paid for from the clan bank.
+// Claiming a new zone gives up the old one.
+func (c *ClanInfo) ClaimZone(zoneName string, cost int) error {
+
+	if owner := GetZoneOwner(zoneName); owner != nil {
+		if owner == c {
+			return nil
+		}
+		return ErrZoneClaimed
+	}
+
+	if cost > c.Gold {
+		return ErrNotEnoughGold
+	}
+
+	c.Gold -= cost
+	c.Zone = zoneName
+
+	save()
+
+	return nil
+}
+
+// Returns the position of userId in a list of applications/invites, or -1
+func findPending(list []ClanMember, userId int) int {
+	for i, m := range list {
+		if m.UserId == userId {
+			return i
+		}
+	}
+	return -1
+}
+
+// Clears any applications or invites a user has with any clan
+func removePending(userId int) {
+	for _, c := range allClans {
+		if idx := findPending(c.Applications, userId); idx > -1 {
+			c.Applications = append(c.Applications[:idx], c.Applications[idx+1:]...)
+		}
+		if idx := findPending(c.Invites, userId); idx > -1 {
+			c.Invites = append(c.Invites[:idx], c.Invites[idx+1:]...)
+		}
+	}
+}
+
+func clanFilePath() string {
+	return util.FilePath(configs.GetFilePathsConfig().DataFiles.String(), `/`, ClanFilename)
+}
+
+// Loads all clans from the data files folder
+func LoadClans() {
+
+	clear(allClans)
+	clear(memberIndex)
+
+	bytes, err := os.ReadFile(clanFilePath())
+	if err != nil {
+		if !os.IsNotExist(err) {
+			mudlog.Error("LoadClans()", "error", err)
+		}
+		return
+	}
+
+	loaded := []*ClanInfo{}
+	if err := yaml.Unmarshal(bytes, &loaded); err != nil {
+		mudlog.Error("LoadClans()", "error", err)
+		return
+	}
+
+	for _, c := range loaded {
+
+		if !ValidTag(c.ClanTag) {
+			mudlog.Error("LoadClans()", "error", "invalid clan tag", "clantag", c.ClanTag)
+			continue
+		}
+
+		for i := range c.Items {
+			c.Items[i].Validate()
+		}
+
+		allClans[strings.ToLower(c.ClanTag)] = c
+		for _, m := range c.Members {
+			memberIndex[m.UserId] = strings.ToLower(c.ClanTag)
+		}
+	}
+
+	mudlog.Info("LoadClans()", "loadedCount", len(allClans))
+}
+
+func save() {
+
+	bytes, err := yaml.Marshal(GetAll())
+	if err != nil {
+		mudlog.Error("SaveClans()", "error", err)
+		return
+	}
+
+	if err := util.Save(clanFilePath(), bytes, bool(configs.GetFilePathsConfig().CarefulSaveFiles)); err != nil {
+		mudlog.Error("SaveClans()", "error", err)
+	}
 }
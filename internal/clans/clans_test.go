@@ -0,0 +1,172 @@
+package clans
+
+import (
+	"testing"
+
+	"github.com/GoMudEngine/GoMud/internal/configs"
+	"github.com/stretchr/testify/assert"
+)
+
+// Points saves at a temp folder and starts with no clans
+func setupClans(t *testing.T) {
+	t.Helper()
+
+	configs.AddOverlayOverrides(map[string]any{
+		`FilePaths.DataFiles`: t.TempDir(),
+		`Clans.MaxMembers`:    3,
+		`Clans.Upkeep`:        100,
+		`Clans.MemberUpkeep`:  10,
+		`Clans.ZoneXPBonus`:   10,
+	})
+
+	clear(allClans)
+	clear(memberIndex)
+}
+
+func TestCreate_Validation(t *testing.T) {
+	setupClans(t)
+
+	_, err := Create(`X`, `Questing Cajuns`, 1, `Bob`)
+	assert.Equal(t, ErrInvalidTag, err)
+
+	_, err = Create(`QC!`, `Questing Cajuns`, 1, `Bob`)
+	assert.Equal(t, ErrInvalidTag, err)
+
+	_, err = Create(`QC`, `Q`, 1, `Bob`)
+	assert.Equal(t, ErrInvalidName, err)
+
+	c, err := Create(`QC`, `Questing   Cajuns`, 1, `Bob`)
+	assert.NoError(t, err)
+	assert.Equal(t, `Questing Cajuns`, c.ClanName)
+	assert.Equal(t, ClanRankLeader, c.GetRank(1))
+	assert.Equal(t, `QC`, GetClanTag(1))
+
+	_, err = Create(`qc`, `Other Name`, 2, `Sue`)
+	assert.Equal(t, ErrClanExists, err, "Tags are case insensitive")
+
+	_, err = Create(`ZZ`, `questing cajuns`, 2, `Sue`)
+	assert.Equal(t, ErrClanExists, err, "Names are case insensitive")
+
+	_, err = Create(`ZZ`, `Zany Zebras`, 1, `Bob`)
+	assert.Equal(t, ErrAlreadyInClan, err)
+
+	assert.Equal(t, c, Get(`qc`))
+	assert.Equal(t, c, Get(`QUESTING CAJUNS`))
+}
+
+func TestMembership(t *testing.T) {
+	setupClans(t)
+
+	c, _ := Create(`QC`, `Questing Cajuns`, 1, `Bob`)
+	other, _ := Create(`ZZ`, `Zany Zebras`, 5, `Zed`)
+
+	c.Apply(2, `Sue`)
+	other.Invite(2, `Sue`)
+	assert.True(t, c.HasApplied(2))
+	assert.True(t, other.IsInvited(2))
+
+	assert.NoError(t, c.AddMember(2, `Sue`))
+	assert.False(t, c.HasApplied(2), "Joining should clear the application")
+	assert.False(t, other.IsInvited(2), "Joining should clear invites from other clans")
+	assert.Equal(t, ErrAlreadyInClan, other.AddMember(2, `Sue`))
+
+	assert.NoError(t, c.AddMember(3, `Tim`))
+	assert.Equal(t, ErrClanFull, c.AddMember(4, `Ann`))
+
+	rank, ok := c.Promote(2)
+	assert.True(t, ok)
+	assert.Equal(t, ClanRankLieutenant, rank)
+	assert.True(t, c.CanAccept(2))
+	assert.False(t, c.IsLeader(2))
+
+	// When the only leader leaves, the longest serving member takes over
+	assert.True(t, c.RemoveMember(1))
+	assert.Equal(t, ClanRankLeader, c.GetRank(2))
+	assert.Nil(t, GetByUserId(1))
+}
+
+func TestChargeUpkeep(t *testing.T) {
+	setupClans(t)
+
+	rich, _ := Create(`RICH`, `Rich Clan`, 1, `Bob`)
+	poor, _ := Create(`POOR`, `Poor Clan`, 2, `Sue`)
+
+	rich.Gold = 1000
+	poor.Gold = 50
+
+	assert.Equal(t, 110, rich.DailyUpkeep())
+	assert.Equal(t, 9, rich.DaysOfUpkeep())
+
+	// Clans that can't pay get a few days to catch up
+	for day := 1; day <= upkeepGraceDays; day++ {
+		assert.Empty(t, ChargeUpkeep())
+		assert.Equal(t, day, poor.UnpaidDays)
+		assert.Equal(t, upkeepGraceDays-day, poor.GraceDaysLeft())
+		assert.Equal(t, 50, poor.Gold, "Nothing is taken from a clan that can't pay")
+	}
+
+	disbanded := ChargeUpkeep()
+
+	assert.Len(t, disbanded, 1)
+	assert.Equal(t, `POOR`, disbanded[0].ClanTag)
+	assert.Nil(t, Get(`POOR`))
+	assert.Nil(t, GetByUserId(poor.Members[0].UserId))
+
+	assert.Equal(t, 1000-110*(upkeepGraceDays+1), rich.Gold)
+	assert.Equal(t, -1, rich.GraceDaysLeft())
+}
+
+func TestChargeUpkeep_CatchUp(t *testing.T) {
+	setupClans(t)
+
+	c, _ := Create(`NEW`, `New Clan`, 1, `Bob`)
+
+	ChargeUpkeep()
+	assert.Equal(t, 1, c.UnpaidDays, "A new clan with an empty bank isn't disbanded right away")
+
+	c.Gold = 500
+	ChargeUpkeep()
+	assert.Equal(t, 0, c.UnpaidDays)
+	assert.Equal(t, 390, c.Gold)
+	assert.Equal(t, c, Get(`NEW`))
+}
+
+func TestClaimZone(t *testing.T) {
+	setupClans(t)
+
+	c, _ := Create(`QC`, `Questing Cajuns`, 1, `Bob`)
+	other, _ := Create(`ZZ`, `Zany Zebras`, 2, `Zed`)
+
+	assert.Equal(t, ErrNotEnoughGold, c.ClaimZone(`Frostfang`, 500))
+
+	c.Gold = 600
+	assert.NoError(t, c.ClaimZone(`Frostfang`, 500))
+	assert.Equal(t, 100, c.Gold)
+	assert.Equal(t, c, GetZoneOwner(`frostfang`))
+
+	other.Gold = 1000
+	assert.Equal(t, ErrZoneClaimed, other.ClaimZone(`Frostfang`, 500))
+
+	assert.Equal(t, 10, ZoneXPBonus(1, `Frostfang`))
+	assert.Equal(t, 0, ZoneXPBonus(1, `Mystarion`))
+	assert.Equal(t, 0, ZoneXPBonus(2, `Frostfang`))
+}
+
+func TestGetLeaderboard(t *testing.T) {
+	setupClans(t)
+
+	a, _ := Create(`AA`, `Alpha`, 1, `A`)
+	b, _ := Create(`BB`, `Bravo`, 2, `B`)
+	c, _ := Create(`CC`, `Charlie`, 3, `C`)
+
+	c.AddMember(4, `D`)
+	a.Gold = 10
+	b.Gold = 20
+
+	lb := GetLeaderboard()
+	assert.Len(t, lb, 3)
+	assert.Equal(t, `CC`, lb[0].ClanTag, "Most members first")
+	assert.Equal(t, `BB`, lb[1].ClanTag, "Then the most gold")
+	assert.Equal(t, `AA`, lb[2].ClanTag)
+	assert.Equal(t, 3, lb[2].Rank)
+}
@@ -265,7 +265,7 @@ func calculateCombat(sourceChar characters.Character, targetChar characters.Char
 
 			if dualWieldLevel == 2 {
 
-				roll := util.Rand(100)
+				roll := util.GetRNG(util.RNGCombat).Rand(100)
 
 				util.LogRoll(`Both Weapons`, roll, 50)
 
@@ -285,7 +285,7 @@ func calculateCombat(sourceChar characters.Character, targetChar characters.Char
 
 			for len(attackWeapons) > maxWeapons {
 				// Remove a random position
-				rnd := util.Rand(len(attackWeapons))
+				rnd := util.GetRNG(util.RNGCombat).Rand(len(attackWeapons))
 				attackWeapons = append(attackWeapons[:rnd], attackWeapons[rnd+1:]...)
 			}
 
@@ -315,6 +315,7 @@ func calculateCombat(sourceChar characters.Character, targetChar characters.Char
 			raceInfo := races.GetRace(sourceChar.RaceId)
 			weaponName := raceInfo.UnarmedName
 			weaponSubType := items.Generic
+			weaponElement := raceInfo.UnarmedElement
 
 			// Get default racial dice rolls
 			attacks, dCount, dSides, dBonus, critBuffs := sourceChar.GetDefaultDiceRoll()
@@ -329,6 +330,7 @@ func calculateCombat(sourceChar characters.Character, targetChar characters.Char
 				weaponName = weapon.DisplayName()
 
 				weaponSubType = itemSpec.Subtype
+				weaponElement = itemSpec.Element
 				attacks, dCount, dSides, dBonus, critBuffs = weapon.GetDiceRoll()
 
 				// If there is a bonus vs. a specific race, apply it
@@ -354,7 +356,7 @@ func calculateCombat(sourceChar characters.Character, targetChar characters.Char
 
 				if Hits(sourceChar.Stats.Speed.ValueAdj, targetChar.Stats.Speed.ValueAdj, penalty) {
 					attackResult.Hit = true
-					attackTargetDamage = util.RollDice(dCount, dSides) + dBonus
+					attackTargetDamage = util.RollDiceWith(util.GetRNG(util.RNGCombat), dCount, dSides) + dBonus
 
 					if attackResult.Crit || Crits(sourceChar, targetChar) {
 						attackResult.Crit = true
@@ -363,22 +365,30 @@ func calculateCombat(sourceChar characters.Character, targetChar characters.Char
 					}
 				}
 
-				defenseAmt := util.Rand(targetChar.GetDefense())
+				defenseAmt := util.GetRNG(util.RNGCombat).Rand(targetChar.GetDefense())
 				if defenseAmt > 0 {
 					attackTargetReduction = int(math.Round((float64(defenseAmt) / 100) * float64(attackTargetDamage)))
 					attackTargetDamage -= attackTargetReduction
 				}
 
-				defenseAmt = util.Rand(sourceChar.GetDefense())
+				defenseAmt = util.GetRNG(util.RNGCombat).Rand(sourceChar.GetDefense())
 				if defenseAmt > 0 {
 					attackSourceReduction = int(math.Round((float64(defenseAmt) / 100) * float64(attackSourceDamage)))
 					attackSourceDamage -= attackSourceReduction
 				}
 
+				// Elemental damage is adjusted by the targets resistance (or vulnerability) to it
+				attackTargetResisted := 0
+				if weaponElement != `` {
+					elementDamage := targetChar.ApplyResistance(attackTargetDamage, weaponElement)
+					attackTargetResisted = attackTargetDamage - elementDamage
+					attackTargetDamage = elementDamage
+				}
+
 				// Calculate actual damage vs. possible damage pct
 				pctDamage := math.Ceil(float64(attackTargetDamage) / float64(dCount*dSides+dBonus) * 100)
 
-				msgs := items.GetAttackMessage(weaponSubType, int(pctDamage))
+				msgs := items.GetAttackMessage(weaponSubType, int(pctDamage), weaponElement)
 
 				var toAttackerMsg, toDefenderMsg, toAttackerRoomMsg, toDefenderRoomMsg items.ItemMessage
 
@@ -473,6 +483,8 @@ func calculateCombat(sourceChar characters.Character, targetChar characters.Char
 					attackerMsg += fmt.Sprintf(` <ansi fg="white">[%d was blocked]</ansi>`, attackSourceReduction)
 				}
 
+				attackerMsg += resistanceText(attackTargetResisted, weaponElement)
+
 				attackResult.SendToSource(
 					string(attackerMsg),
 				)
@@ -482,6 +494,7 @@ func calculateCombat(sourceChar characters.Character, targetChar characters.Char
 				if attackTargetDamage > 0 && attackTargetReduction > 0 {
 					defenderMsg += fmt.Sprintf(` <ansi fg="red">[you blocked %d]</ansi>`, attackTargetReduction)
 				}
+				defenderMsg += resistanceText(attackTargetResisted, weaponElement)
 
 				attackResult.SendToTarget(
 					string(defenderMsg),
@@ -507,7 +520,7 @@ func calculateCombat(sourceChar characters.Character, targetChar characters.Char
 				attackResult.DamageToSourceReduction += attackSourceReduction
 			}
 
-			if util.RollDice(1, 5) == 1 { // 20% chance to join
+			if util.RollDiceWith(util.GetRNG(util.RNGCombat), 1, 5) == 1 { // 20% chance to join
 				if sourceChar.RoomId == targetChar.RoomId {
 					if sourceChar.Pet.Exists() && sourceChar.Pet.Damage.DiceRoll != `` {
 
@@ -515,7 +528,7 @@ func calculateCombat(sourceChar characters.Character, targetChar characters.Char
 
 						for i := 0; i < attacks; i++ {
 
-							attackTargetDamage := util.RollDice(dCount, dSides) + dBonus
+							attackTargetDamage := util.RollDiceWith(util.GetRNG(util.RNGCombat), dCount, dSides) + dBonus
 
 							attackResult.DamageToTarget += attackTargetDamage
 
@@ -540,6 +553,17 @@ func calculateCombat(sourceChar characters.Character, targetChar characters.Char
 
 }
 
+// Describes how much elemental damage was resisted, or added due to a vulnerability
+func resistanceText(resisted int, element items.Element) string {
+	if resisted > 0 {
+		return fmt.Sprintf(` <ansi fg="element-%s">[%d %s resisted]</ansi>`, element, resisted, element)
+	}
+	if resisted < 0 {
+		return fmt.Sprintf(` <ansi fg="element-%s">[+%d %s vulnerability]</ansi>`, element, resisted*-1, element)
+	}
+	return ``
+}
+
 // hit chance will be between 30 and 100
 func hitChance(attackSpd, defendSpd int) int {
 	atkPlusDef := float64(attackSpd + defendSpd)
@@ -566,7 +590,7 @@ func Hits(attackSpd, defendSpd, hitModifier int) bool {
 	if toHit > 95 {
 		toHit = 95
 	}
-	hitRoll := util.Rand(100)
+	hitRoll := util.GetRNG(util.RNGCombat).Rand(100)
 
 	util.LogRoll(`Hits`, hitRoll, toHit)
 
@@ -595,7 +619,7 @@ func Crits(sourceChar characters.Character, targetChar characters.Character) boo
 		critChance = 5
 	}
 
-	critRoll := util.Rand(100)
+	critRoll := util.GetRNG(util.RNGCombat).Rand(100)
 
 	util.LogRoll(`Crits`, critRoll, critChance)
 
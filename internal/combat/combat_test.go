@@ -0,0 +1,38 @@
+package combat
+
+import (
+	"testing"
+
+	"github.com/GoMudEngine/GoMud/internal/characters"
+	"github.com/GoMudEngine/GoMud/internal/mudlog"
+	"github.com/GoMudEngine/GoMud/internal/util"
+)
+
+// A reported fight can be replayed from the seed logged for its round
+func TestReplayFromSeed(t *testing.T) {
+
+	mudlog.SetupLogger(nil, `ERROR`, ``, false)
+
+	attacker := characters.Character{Name: `attacker`, Level: 5}
+	defender := characters.Character{Name: `defender`, Level: 3}
+
+	roll := func() []bool {
+		results := []bool{}
+		for i := 0; i < 20; i++ {
+			results = append(results, Hits(10, 15, 0), Crits(attacker, defender))
+		}
+		return results
+	}
+
+	seed := util.SeedRoundRand(42)
+	want := roll()
+
+	util.SeedRand(seed)
+	got := roll()
+
+	for i := range want {
+		if got[i] != want[i] {
+			t.Fatalf("roll %d = %t; want %t", i, got[i], want[i])
+		}
+	}
+}
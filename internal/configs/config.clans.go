@@ -0,0 +1,56 @@
+package configs
+
+type Clans struct {
+	Enabled        ConfigBool `yaml:"Enabled"`        // Whether players can create and join clans
+	CreateCost     ConfigInt  `yaml:"CreateCost"`     // Gold it costs to found a clan
+	CreateMinLevel ConfigInt  `yaml:"CreateMinLevel"` // Minimum level to found a clan
+	MaxMembers     ConfigInt  `yaml:"MaxMembers"`     // Most members a clan can have
+	Upkeep         ConfigInt  `yaml:"Upkeep"`         // Gold taken from the clan bank each game day
+	MemberUpkeep   ConfigInt  `yaml:"MemberUpkeep"`   // Extra gold taken each game day for every member
+	ClaimCost      ConfigInt  `yaml:"ClaimCost"`      // Gold taken from the clan bank to take control of a zone
+	ZoneXPBonus    ConfigInt  `yaml:"ZoneXPBonus"`    // Extra experience % members get in the zone their clan controls
+}
+
+func (c *Clans) Validate() {
+
+	// Ignore Enabled
+
+	if c.CreateCost < 0 {
+		c.CreateCost = 0
+	}
+
+	if c.CreateMinLevel < 1 {
+		c.CreateMinLevel = 1
+	}
+
+	if c.MaxMembers < 2 {
+		c.MaxMembers = 25 // default
+	}
+
+	if c.Upkeep < 0 {
+		c.Upkeep = 0
+	}
+
+	if c.MemberUpkeep < 0 {
+		c.MemberUpkeep = 0
+	}
+
+	if c.ClaimCost < 0 {
+		c.ClaimCost = 0
+	}
+
+	if c.ZoneXPBonus < 0 {
+		c.ZoneXPBonus = 0
+	}
+
+}
+
+func GetClansConfig() Clans {
+	configDataLock.RLock()
+	defer configDataLock.RUnlock()
+
+	if !configData.validated {
+		configData.Validate()
+	}
+	return configData.Clans
+}
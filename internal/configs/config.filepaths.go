@@ -9,6 +9,7 @@ type FilePaths struct {
 	HttpsCertFile    ConfigString `yaml:"HttpsCertFile"`
 	HttpsKeyFile     ConfigString `yaml:"HttpsKeyFile"`
 	CarefulSaveFiles ConfigBool   `yaml:"CarefulSaveFiles"`
+	AuditLogFile     ConfigString `yaml:"AuditLogFile"`
 }
 
 func (f *FilePaths) Validate() {
@@ -18,6 +19,7 @@ func (f *FilePaths) Validate() {
 	// Ignore PublicHtml
 	// Ignore AdminHtml
 	// Ignore CarefulSaveFiles
+	// Ignore AuditLogFile
 
 	if f.DataFiles == `` {
 		f.DataFiles = `_datafiles/world/default` // default
@@ -17,6 +17,10 @@ type GamePlay struct {
 	// Shops/Conatiners
 	ShopRestockRate  ConfigString `yaml:"ShopRestockRate"`  // Default time it takes to restock 1 quantity in shops
 	ContainerSizeMax ConfigInt    `yaml:"ContainerSizeMax"` // How many objects containers can hold before overflowing
+	// Weight/Encumbrance
+	Encumbrance GameplayEncumbrance `yaml:"Encumbrance"`
+	// Weapon/Armor condition
+	Durability GameplayDurability `yaml:"Durability"`
 	// Alt chars
 	MaxAltCharacters ConfigInt `yaml:"MaxAltCharacters"` // How many characters beyond the default character can they create?
 	// Combat
@@ -40,6 +44,17 @@ type GameplayDeath struct {
 	CorpseDecayTime     ConfigString `yaml:"CorpseDecayTime"`     // How long until corpses decay to dust (go away)
 }
 
+type GameplayEncumbrance struct {
+	Enabled                ConfigBool `yaml:"Enabled"`                // If false, only the number of items carried matters (the old behavior)
+	BaseCarryWeight        ConfigInt  `yaml:"BaseCarryWeight"`        // How much weight anyone can carry before strength is considered
+	CarryWeightPerStrength ConfigInt  `yaml:"CarryWeightPerStrength"` // Extra weight that can be carried for each point of strength
+}
+
+type GameplayDurability struct {
+	Enabled    ConfigBool `yaml:"Enabled"`    // If false, equipment never wears down
+	WearChance ConfigInt  `yaml:"WearChance"` // Chance 1-100 that a weapon or piece of armor loses a point of condition when used in combat
+}
+
 func (g *GamePlay) Validate() {
 
 	// Ignore AllowItemBuffRemoval
@@ -94,6 +109,20 @@ func (g *GamePlay) Validate() {
 		g.ContainerSizeMax = 1
 	}
 
+	if g.Encumbrance.BaseCarryWeight < 1 {
+		g.Encumbrance.BaseCarryWeight = 50 // default
+	}
+
+	if g.Encumbrance.CarryWeightPerStrength < 0 {
+		g.Encumbrance.CarryWeightPerStrength = 0
+	}
+
+	if g.Durability.WearChance < 0 {
+		g.Durability.WearChance = 0
+	} else if g.Durability.WearChance > 100 {
+		g.Durability.WearChance = 100
+	}
+
 	if g.MaxAltCharacters < 0 {
 		g.MaxAltCharacters = 0
 	}
@@ -1,17 +1,26 @@
 package configs
 
 type Network struct {
-	MaxTelnetConnections ConfigInt         `yaml:"MaxTelnetConnections"` // Maximum number of telnet connections to accept
-	TelnetPort           ConfigSliceString `yaml:"TelnetPort"`           // One or more Ports used to accept telnet connections
-	LocalPort            ConfigInt         `yaml:"LocalPort"`            // Port used for admin connections, localhost only
-	HttpPort             ConfigInt         `yaml:"HttpPort"`             // Port used for web requests
-	HttpsPort            ConfigInt         `yaml:"HttpsPort"`            // Port used for web https requests
-	HttpsRedirect        ConfigBool        `yaml:"HttpsRedirect"`        // If true, http traffic will be redirected to https
-	AfkSeconds           ConfigInt         `yaml:"AfkSeconds"`           // How long until a player is marked as afk?
-	MaxIdleSeconds       ConfigInt         `yaml:"MaxIdleSeconds"`       // How many seconds a player can go without a command in game before being kicked.
-	TimeoutMods          ConfigBool        `yaml:"TimeoutMods"`          // Whether to kick admin/mods when idle too long.
-	ZombieSeconds        ConfigInt         `yaml:"ZombieSeconds"`        // How many seconds a player will be a zombie allowing them to reconnect.
-	LogoutRounds         ConfigInt         `yaml:"LogoutRounds"`         // How many rounds of uninterrupted meditation must be completed to log out.
+	MaxTelnetConnections ConfigInt               `yaml:"MaxTelnetConnections"` // Maximum number of telnet connections to accept
+	TelnetPort           ConfigSliceString       `yaml:"TelnetPort"`           // One or more Ports used to accept telnet connections
+	TelnetTLSPort        ConfigInt               `yaml:"TelnetTLSPort"`        // Port used to accept telnet over TLS connections (uses the https cert/key files)
+	LocalPort            ConfigInt               `yaml:"LocalPort"`            // Port used for admin connections, localhost only
+	HttpPort             ConfigInt               `yaml:"HttpPort"`             // Port used for web requests
+	HttpsPort            ConfigInt               `yaml:"HttpsPort"`            // Port used for web https requests
+	HttpsRedirect        ConfigBool              `yaml:"HttpsRedirect"`        // If true, http traffic will be redirected to https
+	MetricsEnabled       ConfigBool              `yaml:"MetricsEnabled"`       // If true, serves Prometheus metrics at /metrics on the web server
+	AfkSeconds           ConfigInt               `yaml:"AfkSeconds"`           // How long until a player is marked as afk?
+	MaxIdleSeconds       ConfigInt               `yaml:"MaxIdleSeconds"`       // How many seconds a player can go without a command in game before being kicked.
+	TimeoutMods          ConfigBool              `yaml:"TimeoutMods"`          // Whether to kick admin/mods when idle too long.
+	ZombieSeconds        ConfigInt               `yaml:"ZombieSeconds"`        // How many seconds a player will be a zombie allowing them to reconnect.
+	LogoutRounds         ConfigInt               `yaml:"LogoutRounds"`         // How many rounds of uninterrupted meditation must be completed to log out.
+	MCCP2Enabled         ConfigBool              `yaml:"MCCP2Enabled"`         // Whether to offer server to client compression to telnet clients
+	MCCP3Enabled         ConfigBool              `yaml:"MCCP3Enabled"`         // Whether to offer client to server compression to telnet clients
+	MSSP                 map[string]ConfigString `yaml:"MSSP"`                 // Extra fields reported to MUD listing crawlers (MSSP)
+	SecureRoles          ConfigSliceString       `yaml:"SecureRoles"`          // Roles that may only log in over a secure (TLS) connection
+	LoginFailureLimit    ConfigInt               `yaml:"LoginFailureLimit"`    // How many failed logins (per ip or account) before logins are locked out
+	LoginLockoutSeconds  ConfigInt               `yaml:"LoginLockoutSeconds"`  // How long the first lockout lasts. Doubles with each failure after that.
+	LoginLockoutMaxSecs  ConfigInt               `yaml:"LoginLockoutMaxSecs"`  // The longest a lockout can last
 }
 
 func (n *Network) Validate() {
@@ -19,6 +28,11 @@ func (n *Network) Validate() {
 	// Ignore TelnetPort
 	// Ignore LocalPort
 	// Ignore TimeoutMods
+	// Ignore MCCP2Enabled
+	// Ignore MCCP3Enabled
+	// Ignore MSSP
+	// Ignore SecureRoles
+	// Ignore MetricsEnabled
 
 	if n.MaxTelnetConnections < 1 {
 		n.MaxTelnetConnections = 50 // default
@@ -32,6 +46,10 @@ func (n *Network) Validate() {
 		n.HttpsPort = 0 // default
 	}
 
+	if n.TelnetTLSPort < 0 {
+		n.TelnetTLSPort = 0 // default
+	}
+
 	if n.AfkSeconds < 0 {
 		n.AfkSeconds = 0
 	}
@@ -48,6 +66,21 @@ func (n *Network) Validate() {
 		n.LogoutRounds = 0 // default
 	}
 
+	if n.LoginFailureLimit < 1 {
+		n.LoginFailureLimit = 5 // default
+	}
+
+	if n.LoginLockoutSeconds < 1 {
+		n.LoginLockoutSeconds = 30 // default
+	}
+
+	if n.LoginLockoutMaxSecs < n.LoginLockoutSeconds {
+		n.LoginLockoutMaxSecs = 3600 // default
+		if n.LoginLockoutMaxSecs < n.LoginLockoutSeconds {
+			n.LoginLockoutMaxSecs = n.LoginLockoutSeconds
+		}
+	}
+
 }
 
 func GetNetworkConfig() Network {
@@ -4,6 +4,8 @@ type Server struct {
 	MudName         ConfigString      `yaml:"MudName"`         // Name of the MUD
 	CurrentVersion  ConfigString      `yaml:"CurrentVersion"`  // Current version this mud has been updated to
 	Seed            ConfigSecret      `yaml:"Seed"`            // Seed that may be used for generating content
+	RandomSeed      ConfigInt         `yaml:"RandomSeed"`      // Seed for dice rolls and other random chances. 0 picks a new one each startup.
+	RandomDebug     ConfigBool        `yaml:"RandomDebug"`     // Reseed and log the random seed every round so fights can be replayed
 	MaxCPUCores     ConfigInt         `yaml:"MaxCPUCores"`     // How many cores to allow for multi-core operations
 	OnLoginCommands ConfigSliceString `yaml:"OnLoginCommands"` // Commands to run when a user logs in
 	Motd            ConfigString      `yaml:"Motd"`            // Message of the day to display when a user logs in
@@ -18,6 +20,8 @@ func (s *Server) Validate() {
 	// Ignore Motd
 	// Ignore NextRoomId
 	// Ignore Locked
+	// Ignore RandomSeed
+	// Ignore RandomDebug
 
 	if s.Seed == `` {
 		s.Seed = `Mud` // default
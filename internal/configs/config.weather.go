@@ -0,0 +1,36 @@
+package configs
+
+type Weather struct {
+	Enabled      ConfigBool `yaml:"Enabled"`      // Whether zones have weather at all
+	ChangeRounds ConfigInt  `yaml:"ChangeRounds"` // Roughly how many rounds between weather changes in a zone
+	ColdBuffId   ConfigInt  `yaml:"ColdBuffId"`   // Buff applied to exposed players in cold weather, unless they are warmed. 0 for none.
+	HeatBuffId   ConfigInt  `yaml:"HeatBuffId"`   // Buff applied to exposed players in hot weather, unless they are hydrated. 0 for none.
+}
+
+func (w *Weather) Validate() {
+
+	// Ignore Enabled
+
+	if w.ChangeRounds < 10 {
+		w.ChangeRounds = 150 // default
+	}
+
+	if w.ColdBuffId < 0 {
+		w.ColdBuffId = 0
+	}
+
+	if w.HeatBuffId < 0 {
+		w.HeatBuffId = 0
+	}
+
+}
+
+func GetWeatherConfig() Weather {
+	configDataLock.RLock()
+	defer configDataLock.RUnlock()
+
+	if !configData.validated {
+		configData.Validate()
+	}
+	return configData.Weather
+}
@@ -47,6 +47,8 @@ type Config struct {
 	Scripting    Scripting    `yaml:"Scripting"`
 	SpecialRooms SpecialRooms `yaml:"SpecialRooms"`
 	Validation   Validation   `yaml:"Validation"`
+	Weather      Weather      `yaml:"Weather"`
+	Clans        Clans        `yaml:"Clans"`
 	Roles        Roles        `yaml:"Roles"`
 	// Plugins is a special case
 	Modules Modules `yaml:"Modules"`
@@ -197,6 +199,8 @@ func (c *Config) Validate() {
 	c.Scripting.Validate()
 	c.SpecialRooms.Validate()
 	c.Validation.Validate()
+	c.Weather.Validate()
+	c.Clans.Validate()
 	c.Modules.Validate()
 	c.Roles.Validate()
 
@@ -0,0 +1,311 @@
+package connections
+
+import (
+	"bufio"
+	"bytes"
+	"compress/zlib"
+	"errors"
+	"io"
+	"sync"
+	"sync/atomic"
+
+	"github.com/GoMudEngine/GoMud/internal/mudlog"
+	"github.com/GoMudEngine/GoMud/internal/term"
+)
+
+var (
+	ErrCompressionNotSupported = errors.New("compression is not supported on this connection")
+)
+
+// Tracks MCCP2 (outbound) and MCCP3 (inbound) compression for a telnet connection
+type compression struct {
+	lock sync.Mutex
+
+	// MCCP2
+	writer        *zlib.Writer
+	rawOut        atomic.Uint64 // bytes handed to Write()
+	compressedOut atomic.Uint64 // bytes actually written to the socket while compressing
+
+	// MCCP3
+	mccp3Offered  bool          // Server sent IAC WILL MCCP3, so watch the input for a start sequence
+	mccp3Starting bool          // Start sequence was received, the next read begins decompressing
+	mccp3Partial  []byte        // End of the last read that may be the first part of a start sequence, held back until the next read
+	reader        io.ReadCloser // zlib reader, nil when the client isn't compressing
+	inBuffer      *bufio.Reader // Once MCCP3 has started, all reads go through this buffer
+	rawIn         atomic.Uint64 // bytes after decompression
+	compressedIn  atomic.Uint64 // bytes read from the socket while decompressing
+}
+
+type CompressionStats struct {
+	ConnectionId  ConnectionId
+	MCCP2         bool
+	MCCP3         bool
+	RawOut        uint64
+	CompressedOut uint64
+	RawIn         uint64
+	CompressedIn  uint64
+}
+
+// Returns compressed size as a percentage of the uncompressed size
+func (s CompressionStats) OutRatio() float64 {
+	if s.RawOut == 0 {
+		return 0
+	}
+	return float64(s.CompressedOut) / float64(s.RawOut) * 100
+}
+
+// Returns compressed size as a percentage of the uncompressed size
+func (s CompressionStats) InRatio() float64 {
+	if s.RawIn == 0 {
+		return 0
+	}
+	return float64(s.CompressedIn) / float64(s.RawIn) * 100
+}
+
+type countingWriter struct {
+	w     io.Writer
+	count *atomic.Uint64
+}
+
+func (cw countingWriter) Write(p []byte) (int, error) {
+	n, err := cw.w.Write(p)
+	cw.count.Add(uint64(n))
+	return n, err
+}
+
+type countingReader struct {
+	r     io.Reader
+	count *atomic.Uint64
+}
+
+func (cr countingReader) Read(p []byte) (int, error) {
+	n, err := cr.r.Read(p)
+	cr.count.Add(uint64(n))
+	return n, err
+}
+
+// Sends the MCCP2 start sequence and compresses all further output
+func (cd *ConnectionDetails) StartCompression() error {
+
+	if cd.wsConn != nil {
+		return ErrCompressionNotSupported
+	}
+
+	cd.compression.lock.Lock()
+	defer cd.compression.lock.Unlock()
+
+	if cd.compression.writer != nil {
+		return nil
+	}
+
+	if _, err := cd.conn.Write(term.Mccp2Start.BytesWithPayload(nil)); err != nil {
+		return err
+	}
+
+	cd.compression.writer = zlib.NewWriter(countingWriter{w: cd.conn, count: &cd.compression.compressedOut})
+
+	mudlog.Debug("MCCP2", "connectionId", cd.ConnectionId(), "status", "started")
+
+	return nil
+}
+
+// Ends the MCCP2 stream. The client will resume reading uncompressed data.
+func (cd *ConnectionDetails) StopCompression() error {
+
+	cd.compression.lock.Lock()
+	defer cd.compression.lock.Unlock()
+
+	if cd.compression.writer == nil {
+		return nil
+	}
+
+	err := cd.compression.writer.Close()
+	cd.compression.writer = nil
+
+	mudlog.Debug("MCCP2", "connectionId", cd.ConnectionId(), "status", "stopped")
+
+	return err
+}
+
+// Lets the connection watch for the client starting MCCP3
+// Should be called when the server sends IAC WILL MCCP3
+func (cd *ConnectionDetails) AllowInputCompression() {
+	cd.compression.lock.Lock()
+	defer cd.compression.lock.Unlock()
+
+	cd.compression.mccp3Offered = true
+}
+
+// Stops watching for the client starting MCCP3
+// Should be called when the client sends IAC DONT MCCP3
+func (cd *ConnectionDetails) DisallowInputCompression() {
+	cd.compression.lock.Lock()
+	defer cd.compression.lock.Unlock()
+
+	cd.compression.mccp3Offered = false
+}
+
+func (cd *ConnectionDetails) IsCompressed() bool {
+	cd.compression.lock.Lock()
+	defer cd.compression.lock.Unlock()
+
+	return cd.compression.writer != nil
+}
+
+func (cd *ConnectionDetails) CompressionStats() CompressionStats {
+	cd.compression.lock.Lock()
+	defer cd.compression.lock.Unlock()
+
+	return CompressionStats{
+		ConnectionId:  cd.ConnectionId(),
+		MCCP2:         cd.compression.writer != nil,
+		MCCP3:         cd.compression.reader != nil,
+		RawOut:        cd.compression.rawOut.Load(),
+		CompressedOut: cd.compression.compressedOut.Load(),
+		RawIn:         cd.compression.rawIn.Load(),
+		CompressedIn:  cd.compression.compressedIn.Load(),
+	}
+}
+
+func (cd *ConnectionDetails) writeTelnet(p []byte) (int, error) {
+
+	cd.compression.lock.Lock()
+	defer cd.compression.lock.Unlock()
+
+	if cd.compression.writer == nil {
+		return cd.conn.Write(p)
+	}
+
+	if _, err := cd.compression.writer.Write(p); err != nil {
+		return 0, err
+	}
+
+	// Sync flush so the client receives this output immediately
+	if err := cd.compression.writer.Flush(); err != nil {
+		return 0, err
+	}
+
+	cd.compression.rawOut.Add(uint64(len(p)))
+
+	return len(p), nil
+}
+
+// Only ever called from the goroutine reading the connection
+func (cd *ConnectionDetails) readTelnet(p []byte) (int, error) {
+
+	if cd.compression.inBuffer == nil {
+
+		cd.compression.lock.Lock()
+		offered := cd.compression.mccp3Offered
+		cd.compression.lock.Unlock()
+
+		// Whatever was held back from the last read goes first
+		n := copy(p, cd.compression.mccp3Partial)
+		cd.compression.mccp3Partial = nil
+
+		if !offered {
+			if n > 0 {
+				return n, nil
+			}
+			return cd.conn.Read(p)
+		}
+
+		startSeq := term.Mccp3Start.BytesWithPayload(nil)
+
+		idx := -1
+		for {
+
+			readCt, err := cd.conn.Read(p[n:])
+			n += readCt
+			if err != nil {
+				return n, err
+			}
+
+			if idx = bytes.Index(p[:n], startSeq); idx != -1 {
+				break
+			}
+
+			// The start sequence may have been split across reads.
+			// Hold back anything that could be the beginning of it.
+			partialLen := startSequencePrefixLen(p[:n], startSeq)
+			if partialLen == 0 {
+				return n, nil
+			}
+
+			if partialLen < n {
+				cd.compression.mccp3Partial = append([]byte{}, p[n-partialLen:n]...)
+				return n - partialLen, nil
+			}
+
+			// Nothing but the beginning of a start sequence so far, wait for the rest
+		}
+
+		// Everything after the start sequence is compressed.
+		// Hand back the bytes up to and including the start sequence uncompressed
+		// and queue up the rest to be read through the decompressor.
+		end := idx + len(startSeq)
+
+		pending := make([]byte, n-end)
+		copy(pending, p[end:n])
+
+		cd.compression.inBuffer = bufio.NewReader(
+			io.MultiReader(
+				bytes.NewReader(pending),
+				countingReader{r: cd.conn, count: &cd.compression.compressedIn},
+			),
+		)
+		cd.compression.compressedIn.Add(uint64(len(pending)))
+		cd.compression.mccp3Starting = true
+
+		return end, nil
+	}
+
+	if cd.compression.reader == nil {
+
+		// Compression was stopped by the client, just read plain data
+		if !cd.compression.mccp3Starting {
+			return cd.compression.inBuffer.Read(p)
+		}
+
+		cd.compression.mccp3Starting = false
+
+		// This blocks until the zlib header arrives
+		zr, err := zlib.NewReader(cd.compression.inBuffer)
+		if err != nil {
+			return 0, err
+		}
+
+		cd.compression.lock.Lock()
+		cd.compression.reader = zr
+		cd.compression.lock.Unlock()
+
+		mudlog.Debug("MCCP3", "connectionId", cd.ConnectionId(), "status", "started")
+	}
+
+	n, err := cd.compression.reader.Read(p)
+	cd.compression.rawIn.Add(uint64(n))
+
+	if err == io.EOF {
+		// The client ended the compressed stream
+		cd.compression.lock.Lock()
+		cd.compression.reader.Close()
+		cd.compression.reader = nil
+		cd.compression.lock.Unlock()
+
+		mudlog.Debug("MCCP3", "connectionId", cd.ConnectionId(), "status", "stopped")
+
+		return n, nil
+	}
+
+	return n, err
+}
+
+// Returns how many bytes at the end of p match the beginning of startSeq
+func startSequencePrefixLen(p []byte, startSeq []byte) int {
+	for l := min(len(p), len(startSeq)-1); l > 0; l-- {
+		if bytes.HasSuffix(p, startSeq[:l]) {
+			return l
+		}
+	}
+	return 0
+}
@@ -0,0 +1,164 @@
+package connections
+
+import (
+	"bytes"
+	"compress/zlib"
+	"io"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/GoMudEngine/GoMud/internal/mudlog"
+	"github.com/GoMudEngine/GoMud/internal/term"
+	"github.com/stretchr/testify/assert"
+)
+
+func newTestConnection(t *testing.T) (*ConnectionDetails, net.Conn) {
+	t.Helper()
+
+	mudlog.SetupLogger(nil, `ERROR`, ``, false)
+
+	server, client := net.Pipe()
+
+	// Fail instead of hanging if a read never gets what it is waiting for
+	server.SetDeadline(time.Now().Add(5 * time.Second))
+
+	t.Cleanup(func() {
+		server.Close()
+		client.Close()
+	})
+
+	return NewConnectionDetails(1, server, nil, nil), client
+}
+
+// Reads from the connection until want bytes have come back
+func readAtLeast(t *testing.T, cd *ConnectionDetails, want int) []byte {
+	t.Helper()
+
+	out := []byte{}
+	buf := make([]byte, 1024)
+	for len(out) < want {
+		n, err := cd.Read(buf)
+		if !assert.NoError(t, err) {
+			break
+		}
+		out = append(out, buf[:n]...)
+	}
+	return out
+}
+
+func compressBytes(t *testing.T, data []byte) []byte {
+	t.Helper()
+
+	var b bytes.Buffer
+	zw := zlib.NewWriter(&b)
+	zw.Write(data)
+	assert.NoError(t, zw.Flush())
+	return b.Bytes()
+}
+
+func TestCompression_Output(t *testing.T) {
+
+	cd, client := newTestConnection(t)
+
+	go func() {
+		cd.StartCompression()
+		cd.Write([]byte(`Hello there`))
+	}()
+
+	startSeq := make([]byte, len(term.Mccp2Start.BytesWithPayload(nil)))
+	_, err := io.ReadFull(client, startSeq)
+	assert.NoError(t, err)
+	assert.Equal(t, term.Mccp2Start.BytesWithPayload(nil), startSeq)
+
+	zr, err := zlib.NewReader(client)
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	out := make([]byte, len(`Hello there`))
+	_, err = io.ReadFull(zr, out)
+	assert.NoError(t, err)
+	assert.Equal(t, `Hello there`, string(out))
+
+	stats := cd.CompressionStats()
+	assert.True(t, stats.MCCP2)
+	assert.Equal(t, uint64(len(`Hello there`)), stats.RawOut)
+	assert.Greater(t, stats.CompressedOut, uint64(0))
+}
+
+func TestCompression_Input(t *testing.T) {
+
+	cd, client := newTestConnection(t)
+	cd.AllowInputCompression()
+
+	startSeq := term.Mccp3Start.BytesWithPayload(nil)
+
+	go func() {
+		client.Write([]byte(`look`))
+		client.Write(startSeq)
+		client.Write(compressBytes(t, []byte("say hi\n")))
+	}()
+
+	assert.Equal(t, append([]byte(`look`), startSeq...), readAtLeast(t, cd, 4+len(startSeq)))
+	assert.Equal(t, "say hi\n", string(readAtLeast(t, cd, 7)))
+	assert.True(t, cd.CompressionStats().MCCP3)
+}
+
+func TestCompression_InputSplitStart(t *testing.T) {
+
+	startSeq := term.Mccp3Start.BytesWithPayload(nil)
+
+	// Split the start sequence at every possible point
+	for split := 1; split < len(startSeq); split++ {
+
+		cd, client := newTestConnection(t)
+		cd.AllowInputCompression()
+
+		go func() {
+			client.Write(append([]byte(`look`), startSeq[:split]...))
+			client.Write(append(append([]byte{}, startSeq[split:]...), compressBytes(t, []byte("say hi\n"))...))
+		}()
+
+		assert.Equal(t, `look`, string(readAtLeast(t, cd, 4)), "Split at %d", split)
+		assert.Equal(t, startSeq, readAtLeast(t, cd, len(startSeq)), "Split at %d", split)
+		assert.Equal(t, "say hi\n", string(readAtLeast(t, cd, 7)), "Split at %d", split)
+	}
+}
+
+func TestCompression_InputRefused(t *testing.T) {
+
+	cd, client := newTestConnection(t)
+	cd.AllowInputCompression()
+	cd.DisallowInputCompression()
+
+	startSeq := term.Mccp3Start.BytesWithPayload(nil)
+
+	go func() {
+		client.Write(append([]byte(`look`), startSeq[:2]...))
+	}()
+
+	// Nothing is held back once the client has refused
+	assert.Equal(t, append([]byte(`look`), startSeq[:2]...), readAtLeast(t, cd, 1))
+}
+
+func TestStartSequencePrefixLen(t *testing.T) {
+
+	startSeq := term.Mccp3Start.BytesWithPayload(nil)
+
+	tests := []struct {
+		input []byte
+		want  int
+	}{
+		{[]byte(`look`), 0},
+		{[]byte{}, 0},
+		{append([]byte(`look`), term.TELNET_IAC), 1},
+		{append([]byte(`look`), startSeq[:4]...), 4},
+		{startSeq[:3], 3},
+		{[]byte{term.TELNET_IAC, term.TELNET_SB, 24}, 0},
+	}
+
+	for _, tt := range tests {
+		assert.Equal(t, tt.want, startSequencePrefixLen(tt.input, startSeq), "input %v", tt.input)
+	}
+}
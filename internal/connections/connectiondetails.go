@@ -1,6 +1,7 @@
 package connections
 
 import (
+	"crypto/tls"
 	"errors"
 	"net"
 	"strings"
@@ -124,6 +125,7 @@ type ConnectionDetails struct {
 	inputDisabled     bool
 	clientSettings    ClientSettings
 	heartbeat         *heartbeatManager
+	compression       compression
 }
 
 func (cd *ConnectionDetails) IsLocal() bool {
@@ -153,6 +155,19 @@ func (cd *ConnectionDetails) IsLocal() bool {
 	return ip.IsLoopback()
 }
 
+// Returns true if the connection is encrypted (TLS telnet or websocket over https)
+// Local connections are not, since a proxy on the same machine may be relaying them from anywhere.
+func (cd *ConnectionDetails) IsSecure() bool {
+
+	netConn := cd.conn
+	if cd.wsConn != nil {
+		netConn = cd.wsConn.NetConn()
+	}
+
+	_, ok := netConn.(*tls.Conn)
+	return ok
+}
+
 func (cd *ConnectionDetails) IsWebSocket() bool {
 	return cd.wsConn != nil
 }
@@ -241,7 +256,7 @@ func (cd *ConnectionDetails) Write(p []byte) (n int, err error) {
 		return len(p), nil
 	}
 
-	return cd.conn.Write(p)
+	return cd.writeTelnet(p)
 }
 
 func (cd *ConnectionDetails) Read(p []byte) (n int, err error) {
@@ -256,7 +271,7 @@ func (cd *ConnectionDetails) Read(p []byte) (n int, err error) {
 		return len(message), nil
 	}
 
-	return cd.conn.Read(p)
+	return cd.readTelnet(p)
 }
 
 func (cd *ConnectionDetails) Close() {
@@ -268,6 +283,10 @@ func (cd *ConnectionDetails) Close() {
 		cd.wsConn.Close()
 		return
 	}
+
+	// Properly terminate the compressed stream before closing
+	cd.StopCompression()
+
 	cd.conn.Close()
 }
 
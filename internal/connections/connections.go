@@ -80,12 +80,23 @@ func IsWebsocket(id ConnectionId) bool {
 	return false
 }
 
+func IsSecure(id ConnectionId) bool {
+	lock.Lock()
+	defer lock.Unlock()
+
+	if cd, ok := netConnections[id]; ok {
+		return cd.IsSecure()
+	}
+
+	return false
+}
+
 func GetAllConnectionIds() []ConnectionId {
 
 	lock.Lock()
 	defer lock.Unlock()
 
-	ids := make([]ConnectionId, len(netConnections))
+	ids := make([]ConnectionId, 0, len(netConnections))
 
 	for id := range netConnections {
 		ids = append(ids, id)
@@ -253,6 +264,24 @@ func Stats() (connections uint64, disconnections uint64) {
 	return connectCounter, disconnectCounter
 }
 
+// Returns compression stats for every telnet connection that has compression enabled
+func GetCompressionStats() []CompressionStats {
+	lock.RLock()
+	defer lock.RUnlock()
+
+	allStats := []CompressionStats{}
+	for _, cd := range netConnections {
+		if cd.IsWebSocket() {
+			continue
+		}
+		if stats := cd.CompressionStats(); stats.MCCP2 || stats.MCCP3 {
+			allStats = append(allStats, stats)
+		}
+	}
+
+	return allStats
+}
+
 func GetClientSettings(id ConnectionId) ClientSettings {
 	lock.Lock()
 	defer lock.Unlock()
@@ -0,0 +1,59 @@
+package connections
+
+import (
+	"errors"
+	"net"
+	"os"
+)
+
+var (
+	ErrHandoffNotSupported = errors.New("connection can't be handed off to another process")
+)
+
+// Returns a duplicate of the socket so it can be passed to a new process during a copyover.
+// Only plain telnet connections can be handed off. TLS and websocket sessions carry
+// state that can't be moved, and neither can a client compressing its input (MCCP3).
+// Outbound compression must be stopped right before the exec, since the new process can't continue the stream.
+func (cd *ConnectionDetails) File() (*os.File, error) {
+
+	if cd.wsConn != nil {
+		return nil, ErrHandoffNotSupported
+	}
+
+	tcpConn, ok := cd.conn.(*net.TCPConn)
+	if !ok {
+		return nil, ErrHandoffNotSupported
+	}
+
+	cd.compression.lock.Lock()
+	inputCompressed := cd.compression.reader != nil
+	cd.compression.lock.Unlock()
+
+	if inputCompressed {
+		return nil, ErrHandoffNotSupported
+	}
+
+	return tcpConn.File()
+}
+
+// Adds a connection that was handed off by a previous process, keeping its old id.
+func Restore(id ConnectionId, conn net.Conn) *ConnectionDetails {
+
+	lock.Lock()
+	defer lock.Unlock()
+
+	if id > connectCounter {
+		connectCounter = id
+	}
+
+	connDetails := NewConnectionDetails(
+		id,
+		conn,
+		nil,
+		nil,
+	)
+
+	netConnections[id] = connDetails
+
+	return connDetails
+}
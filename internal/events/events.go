@@ -220,7 +220,9 @@ func ProcessEvents() {
 
 		qLock.Unlock()
 
+		evtStart := time.Now()
 		evtResult = DoListeners(pe.event)
+		observeEvent(pe.event, evtStart)
 		if evtResult == CancelAndRequeue {
 			addToRequeue(pe.event, pe.priority)
 		}
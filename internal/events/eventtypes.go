@@ -42,6 +42,43 @@ type Quest struct {
 
 func (q Quest) Type() string { return `Quest` }
 
+// Fired when mail is delivered to an online player
+type MailReceived struct {
+	UserId     int
+	FromUserId int
+	FromName   string
+}
+
+func (m MailReceived) Type() string { return `MailReceived` }
+
+// Fired when a clan disbands with gold or items still in its bank
+type ClanDisbanded struct {
+	ClanTag  string
+	ClanName string
+	LeaderId int
+	Gold     int
+	Items    []items.Item
+}
+
+func (c ClanDisbanded) Type() string { return `ClanDisbanded` }
+
+// Fired when a player learns a crafting recipe
+type RecipeLearned struct {
+	UserId   int
+	RecipeId string
+}
+
+func (r RecipeLearned) Type() string { return `RecipeLearned` }
+
+// Fired when a player crafts something from a recipe
+type ItemCrafted struct {
+	UserId   int
+	RecipeId string
+	Item     items.Item
+}
+
+func (i ItemCrafted) Type() string { return `ItemCrafted` }
+
 // For special room-targetting actions
 type RoomAction struct {
 	RoomId       int
@@ -91,7 +128,8 @@ type Communication struct {
 	SourceUserId        int    // User that sent the message
 	SourceMobInstanceId int    // Mob that sent the message
 	TargetUserId        int    // Sent to only 1 person
-	CommType            string // say, party, broadcast, whisper, shout
+	CommType            string // say, party, broadcast, whisper, shout, channel
+	ChannelId           string // Which channel, when CommType is "channel"
 	Name                string
 	Message             string
 }
@@ -178,6 +216,7 @@ type ItemOwnership struct {
 	MobInstanceId int
 	Item          items.Item
 	Gained        bool
+	InContainer   bool // The item only moved in or out of a bag, quiver, etc. they carry
 }
 
 func (i ItemOwnership) Type() string { return `ItemOwnership` }
@@ -283,6 +322,24 @@ type DayNightCycle struct {
 
 func (l DayNightCycle) Type() string { return `DayNightCycle` }
 
+// Fired when the game date rolls over to a new day
+type NewDay struct {
+	Day   int
+	Month int
+	Year  int
+}
+
+func (n NewDay) Type() string { return `NewDay` }
+
+// Fired when the weather in a zone changes
+type WeatherChange struct {
+	Zone            string
+	Weather         string // clear, rain, snow, storm, fog or heat. Empty if the zone has no weather.
+	PreviousWeather string
+}
+
+func (w WeatherChange) Type() string { return `WeatherChange` }
+
 type Looking struct {
 	UserId int
 	RoomId int
@@ -0,0 +1,40 @@
+package events
+
+import (
+	"time"
+
+	"github.com/GoMudEngine/GoMud/internal/metrics"
+)
+
+var (
+	turnSeconds  = metrics.NewHistogram(`gomud_turn_duration_seconds`, `Time spent by all listeners handling a NewTurn event.`, nil)
+	roundSeconds = metrics.NewHistogram(`gomud_round_duration_seconds`, `Time spent by all listeners handling a NewRound event.`, nil)
+)
+
+// Records how long the listeners took, for the events worth graphing
+func observeEvent(e Event, start time.Time) {
+	switch e.(type) {
+	case NewTurn:
+		turnSeconds.ObserveSince(start)
+	case NewRound:
+		roundSeconds.ObserveSince(start)
+	}
+}
+
+// Returns how many events are waiting in the queue, by event type
+func QueueDepths() map[string]int {
+	qLock.Lock()
+	defer qLock.Unlock()
+
+	depths := map[string]int{}
+
+	for _, pe := range globalQueue {
+		depths[pe.event.Type()]++
+	}
+
+	for _, itm := range requeues {
+		depths[itm.evt.Type()]++
+	}
+
+	return depths
+}
@@ -344,6 +344,25 @@ func SaveAllFlatFiles[K comparable, T Loadable[K]](basePath string, data map[K]T
 	return int(saveCt), nil
 }
 
+// Removes the file a data unit would be saved to
+func DeleteFlatFile[T LoadableSimple](basePath string, dataUnit T) error {
+
+	// Normalize slashes
+	basePath = filepath.FromSlash(basePath)
+
+	path := filepath.Join(basePath, dataUnit.Filepath())
+
+	if filepath.Ext(path) != `.yaml` {
+		return errors.New(fmt.Sprint(`DeleteFlatFile`, `basePath`, basePath, `type`, fmt.Sprintf(`%T`, *new(T)), `path`, path, `err`, `unsupported file type`))
+	}
+
+	if err := os.Remove(path); err != nil && !os.IsNotExist(err) {
+		return errors.New(fmt.Sprint(`DeleteFlatFile`, `basePath`, basePath, `type`, fmt.Sprintf(`%T`, *new(T)), `path`, path, `err`, err))
+	}
+
+	return nil
+}
+
 func CopyFileContents(src, dst string) (err error) {
 	in, err := os.Open(src)
 	if err != nil {
@@ -0,0 +1,38 @@
+package gametime
+
+const (
+	SeasonWinter = `winter`
+	SeasonSpring = `spring`
+	SeasonSummer = `summer`
+	SeasonAutumn = `autumn`
+)
+
+var (
+	// Indexed by month - 1
+	monthSeasons = []string{
+		SeasonWinter, // Arvalon
+		SeasonWinter, // Beldris
+		SeasonSpring, // Celmara
+		SeasonSpring, // Durelin
+		SeasonSpring, // Esmira
+		SeasonSummer, // Ferulan
+		SeasonSummer, // Glimar
+		SeasonSummer, // Hestara
+		SeasonAutumn, // Irinel
+		SeasonAutumn, // Jorenth
+		SeasonAutumn, // Keldris
+		SeasonWinter, // Luneth
+	}
+)
+
+func SeasonName(month int) string {
+	month--
+	if month < 0 {
+		month = 0
+	}
+	return monthSeasons[month%len(monthSeasons)]
+}
+
+func (gd GameDate) Season() string {
+	return SeasonName(gd.Month)
+}
@@ -0,0 +1,47 @@
+package hooks
+
+import (
+	"fmt"
+
+	"github.com/GoMudEngine/GoMud/internal/events"
+	"github.com/GoMudEngine/GoMud/internal/mudlog"
+	"github.com/GoMudEngine/GoMud/internal/users"
+)
+
+//
+// Mails whatever was left in a disbanded clan's bank to its leader.
+// Each message can only carry one item, so the gold rides along with the first.
+//
+
+func ReturnClanBank(e events.Event) events.ListenerReturn {
+	evt, typeOk := e.(events.ClanDisbanded)
+	if !typeOk {
+		return events.Cancel
+	}
+
+	msgText := fmt.Sprintf(`The clan <ansi fg="clantag">[%s]</ansi> %s has disbanded. This was left in the clan bank.`, evt.ClanTag, evt.ClanName)
+
+	gold := evt.Gold
+
+	for i := 0; i == 0 || i < len(evt.Items); i++ {
+
+		msg := users.Message{
+			FromName: `Clan Bank`,
+			Message:  msgText,
+			Gold:     gold,
+		}
+
+		if i < len(evt.Items) {
+			item := evt.Items[i]
+			msg.Item = &item
+		}
+
+		if err := users.DeliverMail(evt.LeaderId, msg); err != nil {
+			mudlog.Error("Clans", "action", "return bank", "clantag", evt.ClanTag, "userId", evt.LeaderId, "gold", msg.Gold, "item", msg.Item, "error", err)
+		}
+
+		gold = 0
+	}
+
+	return events.Continue
+}
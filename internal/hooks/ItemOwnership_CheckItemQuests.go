@@ -19,7 +19,8 @@ func CheckItemQuests(e events.Event) events.ListenerReturn {
 	}
 
 	// Only care about users for this stuff
-	if evt.UserId == 0 {
+	// Moving items in/out of their own bags doesn't change who owns them
+	if evt.UserId == 0 || evt.InContainer {
 		return events.Continue
 	}
 
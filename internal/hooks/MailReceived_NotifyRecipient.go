@@ -0,0 +1,30 @@
+package hooks
+
+import (
+	"fmt"
+
+	"github.com/GoMudEngine/GoMud/internal/events"
+	"github.com/GoMudEngine/GoMud/internal/users"
+)
+
+//
+// Lets online players know they have new mail
+//
+
+func NotifyRecipient(e events.Event) events.ListenerReturn {
+	evt, typeOk := e.(events.MailReceived)
+	if !typeOk {
+		return events.Cancel
+	}
+
+	user := users.GetByUserId(evt.UserId)
+	if user == nil {
+		return events.Continue
+	}
+
+	user.SendText(
+		fmt.Sprintf(`<ansi fg="alert-5">You have new mail from <ansi fg="username">%s</ansi>!</ansi> Type <ansi fg="command">mail</ansi> to see your inbox.`, evt.FromName),
+	)
+
+	return events.Continue
+}
@@ -0,0 +1,44 @@
+package hooks
+
+import (
+	"sort"
+
+	"github.com/GoMudEngine/GoMud/internal/events"
+	"github.com/GoMudEngine/GoMud/internal/mudlog"
+	"github.com/GoMudEngine/GoMud/internal/rooms"
+	"github.com/GoMudEngine/GoMud/internal/scripting"
+)
+
+//
+// Lets the zone script know when a mob dies in the zone
+//
+
+func ZoneMobDeath(e events.Event) events.ListenerReturn {
+
+	evt, typeOk := e.(events.MobDeath)
+	if !typeOk {
+		mudlog.Error("Event", "Expected Type", "MobDeath", "Actual Type", e.Type())
+		return events.Cancel
+	}
+
+	room := rooms.LoadRoom(evt.RoomId)
+	if room == nil {
+		return events.Continue
+	}
+
+	userIds := []int{}
+	for userId := range evt.PlayerDamage {
+		userIds = append(userIds, userId)
+	}
+	sort.Ints(userIds)
+
+	scripting.TryZoneMobDeathEvent(room.Zone, room.RoomId, map[string]any{
+		`mobId`:      evt.MobId,
+		`instanceId`: evt.InstanceId,
+		`name`:       evt.CharacterName,
+		`level`:      evt.Level,
+		`userIds`:    userIds,
+	})
+
+	return events.Continue
+}
@@ -35,7 +35,7 @@ func HandleIdleMobs(e events.Event) events.ListenerReturn {
 		}
 	}
 
-	if conversations.HasConverseFile(int(mob.MobId), mob.Character.Zone) && util.Rand(100) < int(configs.GetGamePlayConfig().MobConverseChance) {
+	if conversations.HasConverseFile(int(mob.MobId), mob.Character.Zone) && util.GetRNG(util.RNGIdle).Rand(100) < int(configs.GetGamePlayConfig().MobConverseChance) {
 		if mobRoom := rooms.LoadRoom(mob.Character.RoomId); mobRoom != nil {
 			mobcommands.Converse(``, mob, mobRoom) // Execute this directly so that target mob doesn't leave the room before this command executes
 		}
@@ -66,7 +66,7 @@ func HandleIdleMobs(e events.Event) events.ListenerReturn {
 		// Look for trouble
 		//
 		idleCmd := `lookfortrouble`
-		if util.Rand(100) < mob.ActivityLevel {
+		if util.GetRNG(util.RNGIdle).Rand(100) < mob.ActivityLevel {
 			idleCmd = mob.GetIdleCommand()
 			if idleCmd == `` {
 				idleCmd = `lookfortrouble`
@@ -0,0 +1,59 @@
+package hooks
+
+import (
+	"fmt"
+
+	"github.com/GoMudEngine/GoMud/internal/clans"
+	"github.com/GoMudEngine/GoMud/internal/events"
+	"github.com/GoMudEngine/GoMud/internal/mudlog"
+	"github.com/GoMudEngine/GoMud/internal/users"
+)
+
+//
+// Takes the daily upkeep from every clan bank.
+// Clans that can't pay for too many days in a row are disbanded.
+//
+
+func ChargeClanUpkeep(e events.Event) events.ListenerReturn {
+
+	if _, typeOk := e.(events.NewDay); !typeOk {
+		return events.Cancel
+	}
+
+	for _, c := range clans.ChargeUpkeep() {
+
+		mudlog.Info("Clans", "action", "disbanded", "clantag", c.ClanTag, "reason", "upkeep", "gold", c.Gold, "upkeep", c.DailyUpkeep())
+
+		for _, uid := range c.GetMemberIds() {
+			if u := users.GetByUserId(uid); u != nil {
+				u.SendText(fmt.Sprintf(`<ansi fg="clantag">[%s]</ansi> Your clan couldn't pay its upkeep of <ansi fg="gold">%d gold</ansi> for too long and has <ansi fg="red-bold">disbanded</ansi>.`, c.ClanTag, c.DailyUpkeep()))
+			}
+		}
+	}
+
+	// Warn clans that are behind or running low
+	for _, c := range clans.GetAll() {
+
+		if graceDays := c.GraceDaysLeft(); graceDays >= 0 {
+			for _, uid := range c.GetMemberIds() {
+				if u := users.GetByUserId(uid); u != nil {
+					u.SendText(fmt.Sprintf(`<ansi fg="clantag">[%s]</ansi> The clan bank couldn't pay today's upkeep of <ansi fg="gold">%d gold</ansi>. If it still can't pay in %d more day(s), the clan will <ansi fg="red-bold">disband</ansi>. Use <ansi fg="command">clan donate</ansi> to keep the clan going.`, c.ClanTag, c.DailyUpkeep(), graceDays+1))
+				}
+			}
+			continue
+		}
+
+		days := c.DaysOfUpkeep()
+		if days < 0 || days > 2 {
+			continue
+		}
+
+		for _, uid := range c.GetMemberIds() {
+			if u := users.GetByUserId(uid); u != nil {
+				u.SendText(fmt.Sprintf(`<ansi fg="clantag">[%s]</ansi> The clan bank only has <ansi fg="gold">%d gold</ansi> left, enough for %d more day(s) of upkeep. Use <ansi fg="command">clan donate</ansi> to keep the clan going.`, c.ClanTag, c.Gold, days))
+			}
+		}
+	}
+
+	return events.Continue
+}
@@ -0,0 +1,34 @@
+package hooks
+
+import (
+	"sort"
+
+	"github.com/GoMudEngine/GoMud/internal/events"
+	"github.com/GoMudEngine/GoMud/internal/rooms"
+	"github.com/GoMudEngine/GoMud/internal/scripting"
+)
+
+//
+// Runs onNewDay in every zone script
+//
+
+func ZoneNewDay(e events.Event) events.ListenerReturn {
+
+	evt, typeOk := e.(events.NewDay)
+	if !typeOk {
+		return events.Cancel
+	}
+
+	zoneNames := rooms.GetAllZoneNames()
+	sort.Strings(zoneNames)
+
+	for _, zoneName := range zoneNames {
+		scripting.TryZoneNewDayEvent(zoneName, map[string]any{
+			`day`:   evt.Day,
+			`month`: evt.Month,
+			`year`:  evt.Year,
+		})
+	}
+
+	return events.Continue
+}
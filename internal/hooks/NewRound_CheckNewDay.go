@@ -30,5 +30,15 @@ func CheckNewDay(e events.Event) events.ListenerReturn {
 
 	}
 
+	if gdBefore.Day != gdNow.Day {
+
+		events.AddToQueue(events.NewDay{
+			Day:   gdNow.Day,
+			Month: gdNow.Month,
+			Year:  gdNow.Year,
+		})
+
+	}
+
 	return events.Continue
 }
@@ -83,7 +83,7 @@ func handlePlayerCombat(evt events.NewRound) (affectedPlayerIds []int, affectedM
 					chanceIn100 := int(float64(user.Character.Stats.Speed.ValueAdj) / (float64(user.Character.Stats.Speed.ValueAdj) + float64(mob.Character.Stats.Speed.ValueAdj)) * 70)
 					chanceIn100 += 30
 
-					roll := util.Rand(100)
+					roll := util.GetRNG(util.RNGCombat).Rand(100)
 
 					util.LogRoll(`Flee`, roll, chanceIn100)
 
@@ -106,7 +106,7 @@ func handlePlayerCombat(evt events.NewRound) (affectedPlayerIds []int, affectedM
 					chanceIn100 := int(float64(user.Character.Stats.Speed.ValueAdj) / (float64(user.Character.Stats.Speed.ValueAdj) + float64(u.Character.Stats.Speed.ValueAdj)) * 70)
 					chanceIn100 += 30
 
-					roll := util.Rand(100)
+					roll := util.GetRNG(util.RNGCombat).Rand(100)
 
 					util.LogRoll(`Flee`, roll, chanceIn100)
 
@@ -184,7 +184,7 @@ func handlePlayerCombat(evt events.NewRound) (affectedPlayerIds []int, affectedM
 				continue
 			}
 
-			roll := util.RollDice(1, 100)
+			roll := util.RollDiceWith(util.GetRNG(util.RNGCombat), 1, 100)
 			successChance := user.Character.GetBaseCastSuccessChance(user.Character.Aggro.SpellInfo.SpellId)
 			if roll >= successChance {
 
@@ -407,6 +407,9 @@ func handlePlayerCombat(evt events.NewRound) (affectedPlayerIds []int, affectedM
 
 				defUser.Character.TrackPlayerDamage(user.UserId, roundResult.DamageToTarget)
 
+				wearWeapon(user)
+				wearArmor(defUser)
+
 				// For now, only focus on offhand items.
 				if defUser.Character.Equipment.Offhand.ItemId > 0 {
 
@@ -560,6 +563,8 @@ func handlePlayerCombat(evt events.NewRound) (affectedPlayerIds []int, affectedM
 			// Handle any scripted behavior now.
 			if roundResult.Hit {
 				scripting.TryMobScriptEvent(`onHurt`, defMob.InstanceId, user.UserId, `user`, map[string]any{`damage`: roundResult.DamageToTarget, `crit`: roundResult.Crit})
+
+				wearWeapon(user)
 			}
 
 			//
@@ -660,7 +665,7 @@ func handleMobCombat(evt events.NewRound) (affectedPlayerIds []int, affectedMobI
 			}
 
 			successChance := mob.Character.GetBaseCastSuccessChance(mob.Character.Aggro.SpellInfo.SpellId)
-			if util.RollDice(1, 100) >= successChance {
+			if util.RollDiceWith(util.GetRNG(util.RNGCombat), 1, 100) >= successChance {
 
 				// fail
 				mobRoom.SendText(fmt.Sprintf(`<ansi fg="mobnamme">%s</ansi> tries to cast a spell but it <ansi fg="magenta">fizzles</ansi>!`, mob.Character.Name))
@@ -731,9 +736,9 @@ func handleMobCombat(evt events.NewRound) (affectedPlayerIds []int, affectedMobI
 			if cmdCt > 0 {
 
 				// Each mob has a 10% chance of doing an idle action.
-				if util.Rand(100) < mob.ActivityLevel {
+				if util.GetRNG(util.RNGCombat).Rand(100) < mob.ActivityLevel {
 
-					combatAction := mob.CombatCommands[util.Rand(cmdCt)]
+					combatAction := mob.CombatCommands[util.GetRNG(util.RNGCombat).Rand(cmdCt)]
 
 					if combatAction == `` { // blank is a no-op
 						continue
@@ -791,7 +796,7 @@ func handleMobCombat(evt events.NewRound) (affectedPlayerIds []int, affectedMobI
 			// Especially useful for when they get disarmed
 			if mob.Character.Equipment.Weapon.ItemId == 0 && len(mob.Character.Items) > 0 {
 
-				roll := util.Rand(100)
+				roll := util.GetRNG(util.RNGCombat).Rand(100)
 
 				util.LogRoll(`Look for weapon`, roll, mob.Character.Stats.Perception.ValueAdj)
 
@@ -805,7 +810,7 @@ func handleMobCombat(evt events.NewRound) (affectedPlayerIds []int, affectedMobI
 					}
 
 					if len(possibleWeapons) > 0 {
-						mob.Command(fmt.Sprintf("equip %s", possibleWeapons[util.Rand(len(possibleWeapons))]))
+						mob.Command(fmt.Sprintf("equip %s", possibleWeapons[util.GetRNG(util.RNGCombat).Rand(len(possibleWeapons))]))
 					}
 
 				}
@@ -876,6 +881,8 @@ func handleMobCombat(evt events.NewRound) (affectedPlayerIds []int, affectedMobI
 			// If the attack connected, check for damage to equipment.
 			if roundResult.Hit {
 
+				wearArmor(defUser)
+
 				// For now, only focus on offhand items.
 				if defUser.Character.Equipment.Offhand.ItemId > 0 {
 
@@ -1105,3 +1112,25 @@ func handleAffected(affectedPlayerIds []int, affectedMobInstanceIds []int) {
 	}
 
 }
+
+// Weapons wear down as they land hits
+func wearWeapon(user *users.UserRecord) {
+	if itm, changed := user.Character.WearWeapon(); changed {
+		sendConditionChange(user, itm)
+	}
+}
+
+// Armor wears down as the wearer is hit
+func wearArmor(user *users.UserRecord) {
+	if itm, changed := user.Character.WearArmor(); changed {
+		sendConditionChange(user, itm)
+	}
+}
+
+func sendConditionChange(user *users.UserRecord, itm items.Item) {
+	if itm.IsBroken() {
+		user.SendText(fmt.Sprintf(`<ansi fg="202">***</ansi> Your <ansi fg="item">%s</ansi> is <ansi fg="%s">broken</ansi> and needs to be <ansi fg="command">repair</ansi>ed! <ansi fg="202">***</ansi>`, itm.NameSimple(), itm.ConditionColor()))
+		return
+	}
+	user.SendText(fmt.Sprintf(`Your <ansi fg="item">%s</ansi> is now <ansi fg="%s">%s</ansi>.`, itm.NameSimple(), itm.ConditionColor(), itm.ConditionString()))
+}
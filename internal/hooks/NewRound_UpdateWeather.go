@@ -0,0 +1,25 @@
+package hooks
+
+import (
+	"github.com/GoMudEngine/GoMud/internal/events"
+	"github.com/GoMudEngine/GoMud/internal/rooms"
+)
+
+//
+// Advances the weather in every zone
+// fires event when a zone's weather changes
+//
+
+func UpdateWeather(e events.Event) events.ListenerReturn {
+	evt := e.(events.NewRound)
+
+	for _, change := range rooms.UpdateWeather(evt.RoundNumber) {
+		events.AddToQueue(events.WeatherChange{
+			Zone:            change.Zone,
+			Weather:         change.Weather,
+			PreviousWeather: change.PreviousWeather,
+		})
+	}
+
+	return events.Continue
+}
@@ -21,12 +21,25 @@ func UserRoundTick(e events.Event) events.ListenerReturn {
 	evt := e.(events.NewRound)
 
 	roomsWithPlayers := rooms.GetRoomsWithPlayers()
+
+	// Zone scripts get one idle event per round, and can quiet idle messages for the whole zone.
+	quietZones := map[string]bool{}
+	for _, roomId := range roomsWithPlayers {
+		if room := rooms.LoadRoom(roomId); room != nil {
+			if _, ok := quietZones[room.Zone]; ok {
+				continue
+			}
+			handled, _ := scripting.TryZoneIdleEvent(room.Zone)
+			quietZones[room.Zone] = handled
+		}
+	}
+
 	for _, roomId := range roomsWithPlayers {
 		// Get rooom
 		if room := rooms.LoadRoom(roomId); room != nil {
 			room.RoundTick()
 
-			allowIdleMessages := true
+			allowIdleMessages := !quietZones[room.Zone]
 			if handled, err := scripting.TryRoomIdleEvent(roomId); err == nil {
 				if handled { // For this event, handled represents whether to reject the move.
 					allowIdleMessages = false
@@ -53,7 +66,7 @@ func UserRoundTick(e events.Event) events.ListenerReturn {
 				}
 
 				idleMsgCt := len(idleMsgs)
-				if idleMsgCt > 0 && util.Rand(100) < chanceIn100 {
+				if idleMsgCt > 0 && util.GetRNG(util.RNGIdle).Rand(100) < chanceIn100 {
 
 					if targetRoomId, err := strconv.Atoi(idleMsgs[0]); err == nil {
 						idleMsgCt = 0
@@ -65,7 +78,7 @@ func UserRoundTick(e events.Event) events.ListenerReturn {
 
 					if idleMsgCt > 0 {
 						// pick a random message
-						idleMsgIndex := uint8(util.Rand(idleMsgCt))
+						idleMsgIndex := uint8(util.GetRNG(util.RNGIdle).Rand(idleMsgCt))
 
 						// If it's a repeating message, treat it as a non-message
 						// (Unless it's the only one)
@@ -0,0 +1,25 @@
+package hooks
+
+import (
+	"github.com/GoMudEngine/GoMud/internal/events"
+	"github.com/GoMudEngine/GoMud/internal/mudlog"
+	"github.com/GoMudEngine/GoMud/internal/scripting"
+	"github.com/GoMudEngine/GoMud/internal/util"
+)
+
+//
+// Runs script callbacks scheduled with TimerAfterRounds() etc.
+//
+
+func RunScriptTimers(e events.Event) events.ListenerReturn {
+
+	evt, typeOk := e.(events.NewTurn)
+	if !typeOk {
+		mudlog.Error("Event", "Expected Type", "NewTurn", "Actual Type", e.Type())
+		return events.Cancel
+	}
+
+	scripting.RunTimers(evt.TurnNumber, util.GetRoundCount())
+
+	return events.Continue
+}
@@ -0,0 +1,36 @@
+package hooks
+
+import (
+	"github.com/GoMudEngine/GoMud/internal/events"
+	"github.com/GoMudEngine/GoMud/internal/mudlog"
+	"github.com/GoMudEngine/GoMud/internal/rooms"
+	"github.com/GoMudEngine/GoMud/internal/scripting"
+	"github.com/GoMudEngine/GoMud/internal/users"
+)
+
+//
+// Runs onZoneExit when a user leaves the world, so it always pairs with the onZoneEnter from joining
+//
+
+func ZoneExitOnLeave(e events.Event) events.ListenerReturn {
+
+	evt, typeOk := e.(events.PlayerDespawn)
+	if !typeOk {
+		mudlog.Error("Event", "Expected Type", "PlayerDespawn", "Actual Type", e.Type())
+		return events.Cancel
+	}
+
+	user := users.GetByUserId(evt.UserId)
+	if user == nil {
+		return events.Continue
+	}
+
+	room := rooms.LoadRoom(user.Character.RoomId)
+	if room == nil {
+		return events.Continue
+	}
+
+	scripting.TryZoneMoveEvent(`onZoneExit`, room.Zone, user.UserId, user.Character.RoomId, 0)
+
+	return events.Continue
+}
@@ -62,6 +62,8 @@ func HandleJoin(e events.Event) events.ListenerReturn {
 	}
 
 	if room != nil {
+		scripting.TryZoneMoveEvent(`onZoneEnter`, room.Zone, user.UserId, 0, user.Character.RoomId)
+
 		if doLook, err := scripting.TryRoomScriptEvent(`onEnter`, user.UserId, user.Character.RoomId); err != nil || doLook {
 			user.CommandFlagged(`look`, events.CmdSecretly) // Do a secret look.
 		}
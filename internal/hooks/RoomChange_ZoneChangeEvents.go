@@ -0,0 +1,45 @@
+package hooks
+
+import (
+	"github.com/GoMudEngine/GoMud/internal/events"
+	"github.com/GoMudEngine/GoMud/internal/mudlog"
+	"github.com/GoMudEngine/GoMud/internal/rooms"
+	"github.com/GoMudEngine/GoMud/internal/scripting"
+)
+
+//
+// Runs onZoneExit and onZoneEnter zone scripts when a user crosses into another zone
+//
+
+func ZoneChangeEvents(e events.Event) events.ListenerReturn {
+
+	evt, typeOk := e.(events.RoomChange)
+	if !typeOk {
+		mudlog.Error("Event", "Expected Type", "RoomChange", "Actual Type", e.Type())
+		return events.Cancel
+	}
+
+	// Only users trigger zone events
+	if evt.UserId == 0 {
+		return events.Continue
+	}
+
+	oldRoom := rooms.LoadRoom(evt.FromRoomId)
+	if oldRoom == nil {
+		return events.Continue
+	}
+
+	newRoom := rooms.LoadRoom(evt.ToRoomId)
+	if newRoom == nil {
+		return events.Continue
+	}
+
+	if oldRoom.Zone == newRoom.Zone {
+		return events.Continue
+	}
+
+	scripting.TryZoneMoveEvent(`onZoneExit`, oldRoom.Zone, evt.UserId, evt.FromRoomId, evt.ToRoomId)
+	scripting.TryZoneMoveEvent(`onZoneEnter`, newRoom.Zone, evt.UserId, evt.FromRoomId, evt.ToRoomId)
+
+	return events.Continue
+}
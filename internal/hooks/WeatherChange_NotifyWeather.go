@@ -0,0 +1,39 @@
+package hooks
+
+import (
+	"github.com/GoMudEngine/GoMud/internal/events"
+	"github.com/GoMudEngine/GoMud/internal/rooms"
+	"github.com/GoMudEngine/GoMud/internal/templates"
+)
+
+//
+// Tells players out in the open that the weather changed
+//
+
+func NotifyWeather(e events.Event) events.ListenerReturn {
+	evt, typeOk := e.(events.WeatherChange)
+	if !typeOk {
+		return events.Cancel
+	}
+
+	if evt.Weather == rooms.WeatherNone {
+		return events.Continue
+	}
+
+	weatherTxt, err := templates.Process("weather/"+evt.Weather, evt)
+	if err != nil {
+		return events.Continue
+	}
+
+	for _, roomId := range rooms.GetRoomsWithPlayers() {
+
+		room := rooms.LoadRoom(roomId)
+		if room == nil || room.Zone != evt.Zone || !room.IsOutdoors() {
+			continue
+		}
+
+		room.SendText(weatherTxt)
+	}
+
+	return events.Continue
+}
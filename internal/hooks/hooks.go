@@ -14,11 +14,13 @@ func RegisterListeners() {
 	events.RegisterListener(events.RoomChange{}, LocationMusicChange)
 	events.RegisterListener(events.RoomChange{}, CleanupEphemeralRooms)
 	events.RegisterListener(events.RoomChange{}, SpawnGuide)
+	events.RegisterListener(events.RoomChange{}, ZoneChangeEvents)
 
 	// NewRound Listeners
 	events.RegisterListener(events.NewRound{}, PruneVMs)
 	events.RegisterListener(events.NewRound{}, InactivePlayers)
 	events.RegisterListener(events.NewRound{}, UpdateZoneMutators)
+	events.RegisterListener(events.NewRound{}, UpdateWeather)
 	events.RegisterListener(events.NewRound{}, CheckNewDay)
 	events.RegisterListener(events.NewRound{}, SpawnLootGoblin)
 	events.RegisterListener(events.NewRound{}, UserRoundTick)
@@ -40,6 +42,7 @@ func RegisterListeners() {
 	events.RegisterListener(events.NewTurn{}, AutoSave)
 	events.RegisterListener(events.NewTurn{}, PruneBuffs)
 	events.RegisterListener(events.NewTurn{}, ActionPoints)
+	events.RegisterListener(events.NewTurn{}, RunScriptTimers)
 
 	// ItemOwnership
 	events.RegisterListener(events.ItemOwnership{}, CheckItemQuests)
@@ -50,6 +53,7 @@ func RegisterListeners() {
 	events.RegisterListener(events.Quest{}, HandleQuestUpdate)
 	// Spawn events
 	events.RegisterListener(events.PlayerSpawn{}, HandleJoin)
+	events.RegisterListener(events.PlayerDespawn{}, ZoneExitOnLeave)
 	events.RegisterListener(events.PlayerDespawn{}, HandleLeave, events.Last) // This is a final listener, has to happen last
 
 	// Levelup Notifications
@@ -58,12 +62,24 @@ func RegisterListeners() {
 
 	// Day/Night cycle
 	events.RegisterListener(events.DayNightCycle{}, NotifySunriseSunset)
+	events.RegisterListener(events.NewDay{}, ChargeClanUpkeep)
+	events.RegisterListener(events.NewDay{}, ZoneNewDay)
+
+	// Clans
+	events.RegisterListener(events.ClanDisbanded{}, ReturnClanBank)
+
+	// Mob Deaths
+	events.RegisterListener(events.MobDeath{}, ZoneMobDeath)
+
+	// Weather
+	events.RegisterListener(events.WeatherChange{}, NotifyWeather)
 
 	// Looking
 	events.RegisterListener(events.Looking{}, HandleLookHints)
 
 	// Messages
 	events.RegisterListener(events.Message{}, Message_SendMessage)
+	events.RegisterListener(events.MailReceived{}, NotifyRecipient)
 	// Prompt
 	events.RegisterListener(events.RedrawPrompt{}, RedrawPrompt_SendRedraw)
 
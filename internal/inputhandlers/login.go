@@ -4,7 +4,9 @@ import (
 	// ... other imports
 
 	"fmt"
+	"time"
 
+	"github.com/GoMudEngine/GoMud/internal/bans"
 	"github.com/GoMudEngine/GoMud/internal/configs"
 	"github.com/GoMudEngine/GoMud/internal/connections"
 	"github.com/GoMudEngine/GoMud/internal/language"
@@ -20,15 +22,36 @@ func FinalizeLoginOrCreate(results map[string]string, sharedState map[string]any
 	username := results["username"]
 	password := results["password"]
 
+	connDetails := connections.Get(clientInput.ConnectionId)
+	remoteAddr := ``
+	if connDetails != nil {
+		remoteAddr = connDetails.RemoteAddr().String()
+	}
+
 	if username != `new` {
+
+		if lockedFor := bans.LoginLockedFor(remoteAddr, username); lockedFor > 0 {
+			mudlog.Warn("User login refused", "username", username, "remoteAddr", remoteAddr, "error", "too many failed logins")
+			connections.SendTo([]byte(fmt.Sprintf(`Too many failed logins. Try again in %s.`, lockedFor.Round(time.Second))), clientInput.ConnectionId)
+			connections.SendTo(term.CRLF, clientInput.ConnectionId)
+			connections.Remove(clientInput.ConnectionId)
+			return false // Indicate failure, connection removed
+		}
+
+		if ban := bans.GetUsernameBan(username); ban != nil {
+			mudlog.Warn("User login refused", "username", username, "remoteAddr", remoteAddr, "banId", ban.BanId, "reason", ban.Reason)
+			connections.SendTo([]byte(ban.Message()), clientInput.ConnectionId)
+			connections.SendTo(term.CRLF, clientInput.ConnectionId)
+			connections.Remove(clientInput.ConnectionId)
+			return false // Indicate failure, connection removed
+		}
+
 		userExists := users.Exists(username)
 
 		if userExists {
 
 			if results["kickuser"] == "y" {
 
-				connDetails := connections.Get(clientInput.ConnectionId)
-
 				// Disconnect/kick the user currently connected
 				userid := users.FindUserId(results["username"])
 				user := users.GetByUserId(userid)
@@ -55,12 +78,25 @@ func FinalizeLoginOrCreate(results map[string]string, sharedState map[string]any
 			}
 
 			if !tmpUser.PasswordMatches(password) {
+				if lockedFor := bans.RecordLoginFailure(remoteAddr, username); lockedFor > 0 {
+					mudlog.Warn("Login lockout", "username", username, "remoteAddr", remoteAddr, "duration", lockedFor)
+				}
 				connections.SendTo([]byte(`Nope. Bye!`), clientInput.ConnectionId)
 				connections.SendTo(term.CRLF, clientInput.ConnectionId)
 				connections.Remove(clientInput.ConnectionId)
 				return false // Indicate failure, connection removed
 			}
 
+			if tmpUser.RequiresSecureConnection() && !connections.IsSecure(clientInput.ConnectionId) {
+				mudlog.Warn("User login refused", "username", username, "role", tmpUser.Role, "error", "insecure connection")
+				connections.SendTo([]byte(`This account requires a secure connection (TLS). Please reconnect using a secure port.`), clientInput.ConnectionId)
+				connections.SendTo(term.CRLF, clientInput.ConnectionId)
+				connections.Remove(clientInput.ConnectionId)
+				return false // Indicate failure, connection removed
+			}
+
+			bans.RecordLoginSuccess(remoteAddr, username)
+
 			loggedInUser, msg, err := users.LoginUser(tmpUser, clientInput.ConnectionId)
 			if err != nil {
 				connections.SendTo([]byte(msg), clientInput.ConnectionId)
@@ -79,6 +115,8 @@ func FinalizeLoginOrCreate(results map[string]string, sharedState map[string]any
 			return true // Indicate success, handler can be removed
 
 		} else {
+			// Unknown accounts still count against the ip
+			bans.RecordLoginFailure(remoteAddr, ``)
 			connections.SendTo([]byte(`Invalid login.`), clientInput.ConnectionId)
 			connections.SendTo(term.CRLF, clientInput.ConnectionId)
 			connections.Remove(clientInput.ConnectionId)
@@ -105,6 +143,13 @@ func FinalizeLoginOrCreate(results map[string]string, sharedState map[string]any
 		username := results["username-new"]
 		password := results["password-new"]
 
+		if ban := bans.GetUsernameBan(username); ban != nil {
+			connections.SendTo([]byte(`I'm sorry, that name is not allowed.`), clientInput.ConnectionId)
+			connections.SendTo(term.CRLF, clientInput.ConnectionId)
+			connections.Remove(clientInput.ConnectionId)
+			return false
+		}
+
 		if users.Exists(results["username-new"]) {
 			connections.SendTo([]byte(`I'm sorry, that user already exists!`), clientInput.ConnectionId) // Use language key
 			connections.SendTo(term.CRLF, clientInput.ConnectionId)
@@ -192,8 +237,13 @@ func GetLoginPromptHandler() connections.InputHandler {
 
 				user := users.GetByUserId(userid)
 
+				// Don't reveal a correct password while the account is locked out
+				if bans.LoginLockedFor(``, results["username"]) > 0 {
+					return false
+				}
+
 				return user != nil && user.PasswordMatches(results["password"])
-			}, // Only run if username was not "new", password matches, and user is currently online.
+			}, // Only run if username was not "new", not locked out, password matches, and user is currently online.
 		},
 		//////////////////////////////////////////////////
 		// End If NOT a new user signup (Just a login)
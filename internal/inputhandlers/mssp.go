@@ -0,0 +1,113 @@
+package inputhandlers
+
+import (
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/GoMudEngine/GoMud/internal/configs"
+	"github.com/GoMudEngine/GoMud/internal/connections"
+	"github.com/GoMudEngine/GoMud/internal/items"
+	"github.com/GoMudEngine/GoMud/internal/mobs"
+	"github.com/GoMudEngine/GoMud/internal/mudlog"
+	"github.com/GoMudEngine/GoMud/internal/rooms"
+	"github.com/GoMudEngine/GoMud/internal/term"
+	"github.com/GoMudEngine/GoMud/internal/users"
+	"github.com/GoMudEngine/GoMud/internal/util"
+)
+
+var (
+	msspCodebase  = `GoMud`
+	msspStartTime = time.Now()
+)
+
+// Sets the server details reported to MSSP crawlers
+func SetMSSPServerInfo(version string, startTime time.Time) {
+	msspCodebase = `GoMud ` + version
+	msspStartTime = startTime
+}
+
+// Returns the MSSP variable names (in a stable order) and their values
+func GetMSSPData() ([]string, map[string]string) {
+
+	c := configs.GetConfig()
+
+	port := ``
+	if len(c.Network.TelnetPort) > 0 {
+		port = c.Network.TelnetPort[0]
+	}
+
+	util.RLockMud()
+	playerCt := len(users.GetAllActiveUsers())
+	roomCt := len(rooms.GetAllRoomIds())
+	mobCt := len(mobs.GetAllMobInfo())
+	itemCt := len(items.GetAllItemSpecs())
+	util.RUnlockMud()
+
+	// Required/standard fields first
+	names := []string{`NAME`, `PLAYERS`, `UPTIME`, `CODEBASE`, `PORT`, `ROOMS`, `MOBILES`, `OBJECTS`}
+	values := map[string]string{
+		`NAME`:     string(c.Server.MudName),
+		`PLAYERS`:  strconv.Itoa(playerCt),
+		`UPTIME`:   strconv.FormatInt(msspStartTime.Unix(), 10),
+		`CODEBASE`: msspCodebase,
+		`PORT`:     port,
+		`ROOMS`:    strconv.Itoa(roomCt),
+		`MOBILES`:  strconv.Itoa(mobCt),
+		`OBJECTS`:  strconv.Itoa(itemCt),
+	}
+
+	// Extra fields from the config, sorted for consistent output
+	extraNames := []string{}
+	for name, value := range c.Network.MSSP {
+		name = strings.ToUpper(name)
+		if _, ok := values[name]; !ok {
+			extraNames = append(extraNames, name)
+		}
+		values[name] = string(value)
+	}
+	sort.Strings(extraNames)
+
+	return append(names, extraNames...), values
+}
+
+// Sends the MSSP data as a telnet subnegotiation
+func SendMSSP(connectionId connections.ConnectionId) {
+	names, values := GetMSSPData()
+	connections.SendTo(
+		term.MsspCommand.BytesWithPayload(term.MsspPayload(names, values)),
+		connectionId,
+	)
+}
+
+// Handles the plain text MSSP-REQUEST fallback sent at the login prompt.
+// Replies with tab separated name/value pairs and disconnects.
+func MSSPRequestHandler(clientInput *connections.ClientInput, sharedState map[string]any) (nextHandler bool) {
+
+	if !clientInput.EnterPressed {
+		return true
+	}
+
+	if strings.TrimSpace(string(clientInput.Buffer)) != term.MsspPlainRequest {
+		return true
+	}
+
+	mudlog.Info("MSSP", "type", "plain text request", "connectionId", clientInput.ConnectionId)
+
+	names, values := GetMSSPData()
+
+	var reply strings.Builder
+	reply.WriteString(term.CRLFStr + term.MsspPlainReplyStart + term.CRLFStr)
+	for _, name := range names {
+		reply.WriteString(name + "\t" + values[name] + term.CRLFStr)
+	}
+	reply.WriteString(term.MsspPlainReplyEnd + term.CRLFStr)
+
+	connections.SendTo([]byte(reply.String()), clientInput.ConnectionId)
+	connections.Remove(clientInput.ConnectionId)
+
+	clientInput.Reset()
+
+	return false
+}
@@ -95,6 +95,72 @@ func TelnetIACHandler(clientInput *connections.ClientInput, sharedState map[stri
 			continue
 		}
 
+		if term.IsMSSPCommand(iacCmd) {
+
+			if ok, _ := term.Matches(iacCmd, term.MsspAccept); ok {
+				mudlog.Debug("Received", "type", "IAC (Client-MSSP Request)")
+				SendMSSP(clientInput.ConnectionId)
+				continue
+			}
+
+			if ok, _ := term.Matches(iacCmd, term.MsspRefuse); ok {
+				mudlog.Debug("Received", "type", "IAC (Client-MSSP Refuse)")
+				continue
+			}
+
+			continue
+		}
+
+		if term.IsMCCPCommand(iacCmd) {
+
+			if ok, _ := term.Matches(iacCmd, term.Mccp2Accept); ok {
+				mudlog.Debug("Received", "type", "IAC (Client-MCCP2 Accept)")
+
+				if cd := connections.Get(clientInput.ConnectionId); cd != nil {
+					if err := cd.StartCompression(); err != nil {
+						mudlog.Warn("MCCP2", "connectionId", clientInput.ConnectionId, "error", err)
+					}
+				}
+
+				continue
+			}
+
+			if ok, _ := term.Matches(iacCmd, term.Mccp2Refuse); ok {
+				mudlog.Debug("Received", "type", "IAC (Client-MCCP2 Refuse)")
+
+				// The client may turn off compression after it was started
+				if cd := connections.Get(clientInput.ConnectionId); cd != nil {
+					cd.StopCompression()
+				}
+
+				continue
+			}
+
+			if ok, _ := term.Matches(iacCmd, term.Mccp3Accept); ok {
+				mudlog.Debug("Received", "type", "IAC (Client-MCCP3 Accept)")
+				continue
+			}
+
+			if ok, _ := term.Matches(iacCmd, term.Mccp3Refuse); ok {
+				mudlog.Debug("Received", "type", "IAC (Client-MCCP3 Refuse)")
+
+				// No need to keep watching the input for a start sequence
+				if cd := connections.Get(clientInput.ConnectionId); cd != nil {
+					cd.DisallowInputCompression()
+				}
+
+				continue
+			}
+
+			if ok, _ := term.Matches(iacCmd, term.Mccp3Start); ok {
+				// The connection itself switches to decompressing input after this sequence
+				mudlog.Debug("Received", "type", "IAC (Client-MCCP3 Start)")
+				continue
+			}
+
+			continue
+		}
+
 		if ok, payload := term.Matches(iacCmd, term.TelnetAcceptedChangeCharset); ok {
 			mudlog.Debug("Received", "type", "IAC (TelnetAcceptedChangeCharset)", "data", term.BytesString(payload))
 			continue
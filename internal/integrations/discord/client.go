@@ -54,6 +54,7 @@ func registerListeners() {
 	events.RegisterListener(events.LevelUp{}, HandleLevelup)
 	events.RegisterListener(events.PlayerDeath{}, HandleDeath)
 	events.RegisterListener(events.Broadcast{}, HandleBroadcast)
+	events.RegisterListener(events.Communication{}, HandleChannelMessage)
 	events.RegisterListener(`AuctionUpdate`, HandleAuctionUpdate)
 }
 
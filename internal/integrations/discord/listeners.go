@@ -5,6 +5,7 @@ import (
 	"strconv"
 	"strings"
 
+	"github.com/GoMudEngine/GoMud/internal/channels"
 	"github.com/GoMudEngine/GoMud/internal/connections"
 	"github.com/GoMudEngine/GoMud/internal/events"
 	"github.com/GoMudEngine/GoMud/internal/users"
@@ -137,6 +138,31 @@ func HandleBroadcast(e events.Event) events.ListenerReturn {
 	return events.Continue
 }
 
+// Relays messages from channels that have discord enabled
+func HandleChannelMessage(e events.Event) events.ListenerReturn {
+	evt, typeOk := e.(events.Communication)
+	if !typeOk {
+		return events.Cancel
+	}
+
+	if evt.CommType != `channel` {
+		return events.Continue
+	}
+
+	c := channels.GetChannel(evt.ChannelId)
+	if c == nil || !c.Discord {
+		return events.Continue
+	}
+
+	textOut := ansitags.Parse(evt.Message, ansitags.StripTags)
+
+	message := fmt.Sprintf(`:speech_balloon: (%s) **%s:** %s`, c.Name, evt.Name, textOut)
+
+	SendRichMessage(message, Purple)
+
+	return events.Continue
+}
+
 func HandleAuctionUpdate(e events.Event) events.ListenerReturn {
 	evt, typeOk := e.(events.GenericEvent)
 	if !typeOk {
@@ -12,8 +12,9 @@ var (
 )
 
 type WeaponAttackMessageGroup struct {
-	OptionId ItemSubType `yaml:"optionid"`
-	Options  AttackTypes `yaml:"options"`
+	OptionId ItemSubType             `yaml:"optionid"`
+	Options  AttackTypes             `yaml:"options"`
+	Elements map[Element]AttackTypes `yaml:"elements,omitempty"` // Optional variants of the options for elemental attacks
 }
 
 type AttackTypes map[Intensity]AttackOptions
@@ -76,6 +77,12 @@ func (w *WeaponAttackMessageGroup) Validate() error {
 		}
 	}
 
+	for element := range w.Elements {
+		if !element.IsValid() {
+			return fmt.Errorf("invalid element `%s` for %s", element, w.OptionId)
+		}
+	}
+
 	return nil
 }
 
@@ -96,7 +103,9 @@ func GetPreAttackMessage(subType ItemSubType, messageType Intensity) AttackOptio
 	return GetPreAttackMessage(Generic, messageType)
 }
 
-func GetAttackMessage(subType ItemSubType, pctDamage int) AttackOptions {
+// Gets attack messages for a weapon subtype.
+// If an element is provided, element variants are preferred, first for the subtype, then generic ones.
+func GetAttackMessage(subType ItemSubType, pctDamage int, element ...Element) AttackOptions {
 
 	var intensity Intensity
 	if pctDamage >= 101 {
@@ -111,6 +120,16 @@ func GetAttackMessage(subType ItemSubType, pctDamage int) AttackOptions {
 		intensity = Miss
 	}
 
+	if len(element) > 0 && element[0] != `` {
+		for _, st := range []ItemSubType{subType, Generic} {
+			if attackMsgOptions, ok := attackMessages[st]; ok {
+				if attackMsgOptions, ok := attackMsgOptions.Elements[element[0]][intensity]; ok {
+					return attackMsgOptions
+				}
+			}
+		}
+	}
+
 	// Check whether this item subtype has any attack messages
 	if attackMsgOptions, ok := attackMessages[subType]; ok {
 		if attackMsgOptions, ok := attackMsgOptions.Options[intensity]; ok {
@@ -0,0 +1,145 @@
+package items
+
+import (
+	"strings"
+)
+
+const (
+	ConditionMax = 100
+)
+
+// Whether the item wears down with use (weapons and wearable equipment)
+func (i *Item) HasCondition() bool {
+	if i.ItemId < 1 {
+		return false
+	}
+	iSpec := i.GetSpec()
+	return iSpec.Type == Weapon || iSpec.Subtype == Wearable
+}
+
+// Condition from 0 (broken) to 100 (pristine)
+func (i *Item) Condition() int {
+	if int(i.Wear) >= ConditionMax {
+		return 0
+	}
+	return ConditionMax - int(i.Wear)
+}
+
+func (i *Item) IsBroken() bool {
+	return i.HasCondition() && i.Condition() == 0
+}
+
+func (i *Item) IsDamaged() bool {
+	return i.HasCondition() && i.Wear > 0
+}
+
+// Wears the item down by an amount of condition.
+// Returns true if it dropped to a worse condition tier.
+func (i *Item) AddWear(amount int) bool {
+	if !i.HasCondition() || amount < 1 {
+		return false
+	}
+
+	before := i.ConditionString()
+
+	newWear := int(i.Wear) + amount
+	if newWear > ConditionMax {
+		newWear = ConditionMax
+	}
+	i.Wear = uint8(newWear)
+
+	return i.ConditionString() != before
+}
+
+// Restores up to an amount of condition, without going above maxCondition.
+// Returns how much condition was restored.
+func (i *Item) Repair(amount int, maxCondition int) int {
+	if maxCondition > ConditionMax {
+		maxCondition = ConditionMax
+	}
+
+	before := i.Condition()
+	if before >= maxCondition {
+		return 0
+	}
+
+	after := before + amount
+	if after > maxCondition {
+		after = maxCondition
+	}
+	i.Wear = uint8(ConditionMax - after)
+
+	return after - before
+}
+
+// Percentage of the items stats and damage that still apply
+func (i *Item) ConditionEffectiveness() int {
+	if !i.HasCondition() {
+		return 100
+	}
+
+	condition := i.Condition()
+
+	if condition >= 75 {
+		return 100
+	}
+	if condition >= 50 {
+		return 75
+	}
+	if condition >= 25 {
+		return 50
+	}
+	if condition > 0 {
+		return 25
+	}
+	return 0
+}
+
+func (i *Item) ConditionString() string {
+	if !i.HasCondition() {
+		return ``
+	}
+
+	condition := i.Condition()
+
+	if condition == ConditionMax {
+		return `pristine`
+	}
+	if condition >= 75 {
+		return `good`
+	}
+	if condition >= 50 {
+		return `worn`
+	}
+	if condition >= 25 {
+		return `damaged`
+	}
+	if condition > 0 {
+		return `badly damaged`
+	}
+	return `broken`
+}
+
+// The ansi alias used to color the condition, e.g. "condition-badly-damaged"
+func (i *Item) ConditionColor() string {
+	return `condition-` + strings.ReplaceAll(i.ConditionString(), ` `, `-`)
+}
+
+// How much gold it costs to fully repair the item at a shop
+func (i *Item) RepairCost() int {
+	if !i.IsDamaged() {
+		return 0
+	}
+	cost := i.GetSpec().Value * int(i.Wear) / ConditionMax
+	if cost < 1 {
+		cost = 1
+	}
+	return cost
+}
+
+func applyCondition(value int, effectiveness int) int {
+	if value <= 0 || effectiveness >= 100 {
+		return value
+	}
+	return value * effectiveness / 100
+}
@@ -0,0 +1,56 @@
+package items
+
+import (
+	"testing"
+
+	"github.com/GoMudEngine/GoMud/internal/statmods"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestItem_Condition(t *testing.T) {
+
+	helmet := Item{ItemId: 1, Spec: &ItemSpec{
+		Type:            Head,
+		Subtype:         Wearable,
+		Value:           200,
+		DamageReduction: 8,
+		StatMods:        statmods.StatMods{`strength`: 4, `speed`: -2},
+	}}
+	sword := Item{ItemId: 2, Spec: &ItemSpec{Type: Weapon, Damage: Damage{Attacks: 1, DiceCount: 2, SideCount: 6, BonusDamage: 4}}}
+	potion := Item{ItemId: 3, Spec: &ItemSpec{Type: Drink, Subtype: Drinkable}}
+
+	assert.False(t, potion.HasCondition())
+	assert.False(t, potion.AddWear(10), "Only weapons and armor wear down")
+	assert.Equal(t, 100, potion.ConditionEffectiveness())
+
+	assert.Equal(t, `pristine`, helmet.ConditionString())
+	assert.Equal(t, 0, helmet.RepairCost())
+
+	assert.True(t, helmet.AddWear(1), "Leaving pristine is a new tier")
+	assert.False(t, helmet.AddWear(1))
+	assert.Equal(t, 98, helmet.Condition())
+	assert.Equal(t, 8, helmet.GetDefense())
+
+	helmet.AddWear(48)
+	assert.Equal(t, `worn`, helmet.ConditionString())
+	assert.Equal(t, 6, helmet.GetDefense())
+	assert.Equal(t, 3, helmet.StatMod(`strength`))
+	assert.Equal(t, -2, helmet.StatMod(`speed`), "Penalties should not fade")
+	assert.Equal(t, 100, helmet.RepairCost())
+
+	helmet.AddWear(500)
+	assert.True(t, helmet.IsBroken())
+	assert.Equal(t, 0, helmet.GetDefense())
+	assert.Equal(t, 0, helmet.StatMod(`strength`))
+
+	assert.Equal(t, 50, helmet.Repair(100, 50))
+	assert.Equal(t, 0, helmet.Repair(100, 50), "Can't repair past the limit")
+	assert.Equal(t, 50, helmet.Repair(100, ConditionMax))
+	assert.Equal(t, `pristine`, helmet.ConditionString())
+
+	sword.AddWear(60)
+	_, dCount, dSides, bonus, _ := sword.GetDiceRoll()
+	assert.Equal(t, 2, dCount)
+	assert.Equal(t, 3, dSides)
+	assert.Equal(t, 2, bonus)
+}
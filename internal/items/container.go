@@ -0,0 +1,112 @@
+package items
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+)
+
+const (
+	DefaultContainerCapacity = 10
+)
+
+var (
+	ErrNotContainer    = errors.New(`that can't hold anything`)
+	ErrContainerFull   = errors.New(`it is full`)
+	ErrContainerLock   = errors.New(`it is locked`)
+	ErrWrongType       = errors.New(`it doesn't hold that kind of item`)
+	ErrNestedContainer = errors.New(`containers can't be put inside other containers`)
+)
+
+// Whether the item can hold other items
+func (i *Item) IsContainer() bool {
+	return i.ItemId > 0 && i.GetSpec().Type == Container
+}
+
+// The lock id used by keys that open this kind of container, e.g. "item-20050"
+func (i *Item) LockId() string {
+	return fmt.Sprintf(`item-%d`, i.ItemId)
+}
+
+func (i *Item) HasLock() bool {
+	return i.Lock.Difficulty > 0
+}
+
+// Returns nil if the item could be put into this container
+func (i *Item) CanHold(itm Item) error {
+
+	if !i.IsContainer() {
+		return ErrNotContainer
+	}
+
+	if i.Lock.IsLocked() {
+		return ErrContainerLock
+	}
+
+	if itm.IsContainer() {
+		return ErrNestedContainer
+	}
+
+	iSpec := i.GetSpec()
+
+	if len(iSpec.Holds) > 0 {
+		itmSpec := itm.GetSpec()
+		if !slices.Contains(iSpec.Holds, string(itmSpec.Type)) && !slices.Contains(iSpec.Holds, string(itmSpec.Subtype)) {
+			return ErrWrongType
+		}
+	}
+
+	if len(i.Contents) >= iSpec.Capacity {
+		// A full container can still top up a stack that's already inside
+		for _, content := range i.Contents {
+			if content.CanStackWith(itm) && content.GetQuantity()+itm.GetQuantity() <= itm.GetSpec().StackSize {
+				return nil
+			}
+		}
+		return ErrContainerFull
+	}
+
+	return nil
+}
+
+func (i *Item) AddContent(itm Item) error {
+	if err := i.CanHold(itm); err != nil {
+		return err
+	}
+	i.Contents = AddToStack(i.Contents, itm)
+	return nil
+}
+
+func (i *Item) RemoveContent(itm Item) bool {
+	var removed bool
+	i.Contents, removed = RemoveFromStack(i.Contents, itm)
+	return removed
+}
+
+func (i *Item) FindContent(itemName string) (Item, bool) {
+
+	if itemName == `` {
+		return Item{}, false
+	}
+
+	closeMatchItem, matchItem := FindMatchIn(itemName, i.Contents...)
+
+	if matchItem.ItemId != 0 {
+		return matchItem.Single(), true
+	}
+
+	if closeMatchItem.ItemId != 0 {
+		return closeMatchItem.Single(), true
+	}
+
+	return Item{}, false
+}
+
+// The weight of the item (or the whole stack) plus anything stored inside it
+func (i *Item) GetWeight() int {
+	total := i.GetSpec().Weight * i.GetQuantity()
+	for _, itm := range i.Contents {
+		total += itm.GetWeight()
+	}
+	return total
+}
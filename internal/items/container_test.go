@@ -0,0 +1,37 @@
+package items
+
+import (
+	"testing"
+
+	"github.com/GoMudEngine/GoMud/internal/gamelock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestItem_CanHold(t *testing.T) {
+
+	pouch := Item{ItemId: 1, Spec: &ItemSpec{Type: Container, Capacity: 2, Holds: []string{string(Botanical)}}}
+	herb := Item{ItemId: 2, Spec: &ItemSpec{Type: Botanical, Weight: 1}}
+	sword := Item{ItemId: 3, Spec: &ItemSpec{Type: Weapon, Name: `rusty sword`, Weight: 4}}
+	bag := Item{ItemId: 4, Spec: &ItemSpec{Type: Container, Capacity: 5, Weight: 2}}
+
+	assert.Equal(t, ErrNotContainer, sword.CanHold(herb))
+	assert.Equal(t, ErrWrongType, pouch.CanHold(sword))
+	assert.Equal(t, ErrNestedContainer, bag.CanHold(pouch))
+
+	assert.NoError(t, pouch.AddContent(herb))
+	assert.NoError(t, pouch.AddContent(herb))
+	assert.Equal(t, ErrContainerFull, pouch.AddContent(herb))
+
+	assert.NoError(t, bag.AddContent(sword))
+	assert.Equal(t, 6, bag.GetWeight(), "Contents should add to the weight")
+
+	found, ok := bag.FindContent(`rusty`)
+	assert.True(t, ok)
+	assert.True(t, found.Equals(sword))
+
+	assert.True(t, bag.RemoveContent(sword))
+	assert.Empty(t, bag.Contents)
+
+	bag.Lock = gamelock.Lock{Difficulty: 3}
+	assert.Equal(t, ErrContainerLock, bag.CanHold(herb))
+}
@@ -7,6 +7,7 @@ import (
 	"unicode"
 
 	"github.com/GoMudEngine/GoMud/internal/colorpatterns"
+	"github.com/GoMudEngine/GoMud/internal/gamelock"
 	"github.com/GoMudEngine/GoMud/internal/util"
 	"github.com/GoMudEngine/GoMud/internal/uuid"
 )
@@ -39,12 +40,16 @@ type Item struct {
 	UUID          uuid.UUID      `yaml:"-"`                       // `yaml:"uuid,omitempty"`
 	Blob          string         `yaml:"blob,omitempty"`          // Does this item have a blob? Should be base64 encoded.
 	Uses          int            `yaml:"uses,omitempty"`          // How many uses it has left
+	Quantity      int            `yaml:"quantity,omitempty"`      // How many are in the stack, if it's stackable
 	LastUsedRound uint64         `yaml:"lastusedround,omitempty"` // Last round this item was used
 	Spec          *ItemSpec      `yaml:"overrides,omitempty"`
 	Uncursed      bool           `yaml:"uncursed,omitempty"`     // Is this item uncursed?
 	Enchantments  uint8          `yaml:"enchantments,omitempty"` // Is this item enchanted?
+	Wear          uint8          `yaml:"wear,omitempty"`         // How worn down it is, 0 (pristine) to 100 (broken)
 	Adjectives    []string       `yaml:"adjectives,omitempty"`   // Decorative text for the name of the item (e.g. "exploding")
 	StashedBy     int            `yaml:"stashedby,omitempty"`    // userid of whoever stashed this item
+	Contents      []Item         `yaml:"contents,omitempty"`     // What is stored inside, if it's a container
+	Lock          gamelock.Lock  `yaml:"-"`                      // Whether it's locked, if it's a lockable container. Relocks on restart.
 	tempDataStore map[string]any // Temporary data store for this item. Not saved to disk.
 }
 
@@ -166,6 +171,15 @@ func (i *Item) Validate() {
 		}
 	}
 
+	if iSpec.Type == Container {
+		if i.Lock.Difficulty == 0 && iSpec.Lock.Difficulty > 0 {
+			i.Lock = iSpec.Lock
+		}
+		for idx := range i.Contents {
+			i.Contents[idx].Validate()
+		}
+	}
+
 }
 
 func (i *Item) GetLongDescription() string {
@@ -235,6 +249,32 @@ func (i *Item) GetLongDescription() string {
 		longDesc.WriteString("\n")
 		longDesc.WriteString(` - You could probably <ansi fg="command">use</ansi> this.`)
 
+	} else if iSpec.Type == Container {
+
+		longDesc.WriteString("\n")
+
+		if i.Lock.IsLocked() {
+			longDesc.WriteString(` - It is locked.`)
+		} else if len(i.Contents) == 0 {
+			longDesc.WriteString(fmt.Sprintf(` - It is empty, and can hold up to %d items. You could <ansi fg="command">put</ansi> things in it.`, iSpec.Capacity))
+		} else {
+			names := []string{}
+			for _, itm := range i.Contents {
+				names = append(names, fmt.Sprintf(`<ansi fg="itemname">%s</ansi>`, itm.QuantityDisplayName()))
+			}
+			longDesc.WriteString(fmt.Sprintf(` - It holds (%d/%d): %s`, len(i.Contents), iSpec.Capacity, strings.Join(names, `, `)))
+		}
+
+		if len(iSpec.Holds) > 0 {
+			longDesc.WriteString("\n")
+			longDesc.WriteString(fmt.Sprintf(` - It only holds: %s`, strings.Join(iSpec.Holds, `, `)))
+		}
+
+	}
+
+	if i.HasCondition() {
+		longDesc.WriteString("\n")
+		longDesc.WriteString(fmt.Sprintf(` - It looks <ansi fg="%s">%s</ansi>.`, i.ConditionColor(), i.ConditionString()))
 	}
 
 	return longDesc.String()
@@ -351,6 +391,16 @@ func (i *Item) GetDiceRoll() (attacks int, dCount int, dSides int, bonus int, bu
 		return 1, 1, 3, 0, []int{} // Default Damages
 	}
 	dmg := i.GetDamage()
+
+	// Worn down weapons hit softer
+	if effectiveness := i.ConditionEffectiveness(); effectiveness < 100 {
+		dmg.SideCount = applyCondition(dmg.SideCount, effectiveness)
+		if dmg.SideCount < 1 {
+			dmg.SideCount = 1
+		}
+		dmg.BonusDamage = applyCondition(dmg.BonusDamage, effectiveness)
+	}
+
 	return dmg.Attacks, dmg.DiceCount, dmg.SideCount, dmg.BonusDamage, dmg.CritBuffIds
 }
 
@@ -376,7 +426,7 @@ func (i *Item) GetDamage() Damage {
 // Returns a random number up to the total possible reduction for this item.
 func (i *Item) GetDefense() int {
 	itemInfo := i.GetSpec()
-	return itemInfo.DamageReduction
+	return applyCondition(itemInfo.DamageReduction, i.ConditionEffectiveness())
 }
 
 func (i *Item) Equals(b Item) bool {
@@ -508,7 +558,7 @@ func (i *Item) NameComplex() string {
 		}
 	}
 
-	nm := i.DisplayName()
+	nm := i.QuantityDisplayName()
 
 	if i.GetSpec().Damage.BonusDamage > 0 {
 		nm = fmt.Sprintf(`%s <ansi fg="item-bonus-damage">+%d</ansi>`, nm, i.GetSpec().Damage.BonusDamage)
@@ -556,7 +606,8 @@ func (i *Item) StatMod(statName ...string) int {
 
 	itemInfo := i.GetSpec()
 
-	return itemInfo.StatMods.Get(statName...)
+	// Only bonuses fade as the item wears down, not penalties
+	return applyCondition(itemInfo.StatMods.Get(statName...), i.ConditionEffectiveness())
 }
 
 func startsWithVowel(s string) bool {
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"math"
 	"os"
+	"slices"
 	"strconv"
 	"strings"
 	"time"
@@ -11,6 +12,7 @@ import (
 	"github.com/GoMudEngine/GoMud/internal/buffs"
 	"github.com/GoMudEngine/GoMud/internal/configs"
 	"github.com/GoMudEngine/GoMud/internal/fileloader"
+	"github.com/GoMudEngine/GoMud/internal/gamelock"
 	"github.com/GoMudEngine/GoMud/internal/mudlog"
 	"github.com/GoMudEngine/GoMud/internal/statmods"
 	"github.com/GoMudEngine/GoMud/internal/util"
@@ -59,6 +61,7 @@ func ItemTypes() []ItemTypeInfo {
 		{string(Scroll), `This is a scroll.`, 0, 0, 9999},
 		{string(Grenade), `This is an explosive object.`, 0, 0, 9999},
 		{string(Junk), `This is garbage.`, 0, 0, 9999},
+		{string(Ammo), `This is ammunition, such as arrows or bolts.`, 0, 0, 9999},
 		// Other
 		{string(Readable), `This can be read.`, 0, 0, 9999},
 		{string(Key), `This is a key that opens a locked container or door.`, 0, 0, 9999},
@@ -66,6 +69,7 @@ func ItemTypes() []ItemTypeInfo {
 		{string(Gemstone), `This is a gemstone.`, 0, 0, 9999},
 		{string(Lockpicks), `This allows use of the picklock skill.`, 0, 0, 9999},
 		{string(Botanical), `This is an herb.`, 0, 30000, 39999},
+		{string(Container), `This can hold other items.`, 0, 0, 9999},
 	}
 }
 
@@ -114,6 +118,7 @@ const (
 	Scroll  ItemType = "scroll"
 	Grenade ItemType = "grenade" // Expected to be thrown
 	Junk    ItemType = "junk"
+	Ammo    ItemType = "ammo" // Arrows, bolts, etc.
 
 	// Other
 	Readable  ItemType = "readable"  // Something with writing to reveal when read
@@ -122,6 +127,7 @@ const (
 	Gemstone  ItemType = "gemstone"  // A gem
 	Lockpicks ItemType = "lockpicks" // Used for lockpicking
 	Botanical ItemType = "botanical" // A plant, herb, etc.
+	Container ItemType = "container" // A bag, quiver, pouch, etc. that holds other items
 
 	// Subtypes for wearables
 	Wearable  ItemSubType = "wearable"
@@ -204,6 +210,8 @@ type ItemSpec struct {
 	DamageReduction int         `yaml:"damagereduction,omitempty"` // % of damage it reduces when it blocks attacks
 	WaitRounds      int         `yaml:"waitrounds,omitempty"`      // How many extra rounds each combat requires
 	Hands           WeaponHands `yaml:"hands"`                     // How many hands it takes to wield
+	Weight          int         `yaml:"weight,omitempty"`          // How heavy it is to carry. Defaults based on its type if not set.
+	StackSize       int         `yaml:"stacksize,omitempty"`       // How many can share one inventory slot. Consumables, botanicals and ammo default to 20, 1 means they never stack.
 	Name            string
 	DisplayName     string `yaml:"displayname,omitempty"` // Name that is typically displayed to the user
 	NameSimple      string // A simpler name for the item, for example "Golden Battleaxe" should be "Battleaxe" or "Axe" for simple
@@ -217,12 +225,34 @@ type ItemSpec struct {
 	BreakChance     uint8             `yaml:"breakchance,omitempty"` // Chance in 100 that the item will break when used, or when the character is hit with it equipped, or if it is in the characters inventory during an explosion, etc.
 	Cursed          bool              `yaml:"cursed,omitempty"`      // Can't be removed once equipped
 	KeyLockId       string            `yaml:"keylockid,omitempty"`   // Example: `778-north` - If it's a key, what lock does it open? roomid-exitname etc.
+	Capacity        int               `yaml:"capacity,omitempty"`    // If it's a container, how many items it can hold
+	Holds           []string          `yaml:"holds,omitempty,flow"`  // If it's a container, the item types/subtypes it accepts. Empty accepts anything.
+	Lock            gamelock.Lock     `yaml:"lock,omitempty"`        // If it's a container, an optional lock. Keys with a keylockid of "item-<itemid>" open it.
 }
 
 func (i Element) String() string {
 	return string(i)
 }
 
+// The statmod that resists this element, e.g. "resist-fire"
+func (i Element) ResistStatMod() string {
+	return string(statmods.ResistPrefix) + string(i)
+}
+
+func (i Element) IsValid() bool {
+	for _, e := range AllElements() {
+		if e == i {
+			return true
+		}
+	}
+	return false
+}
+
+// All elements in a consistent order
+func AllElements() []Element {
+	return []Element{Fire, Water, Ice, Electricity, Acid, Life, Death}
+}
+
 func (i ItemType) String() string {
 	return string(i)
 }
@@ -418,13 +448,57 @@ func (i *ItemSpec) Validate() error {
 	i.Damage.InitDiceRoll(i.Damage.DiceRoll)
 	i.Damage.FormatDiceRoll()
 
+	if i.Element != `` {
+		i.Element = Element(strings.ToLower(string(i.Element)))
+		if !i.Element.IsValid() {
+			return fmt.Errorf(`invalid element: %s`, i.Element)
+		}
+	}
+
 	if i.Value < 1 {
 		i.AutoCalculateValue()
 	}
 
+	if i.Weight < 1 {
+		i.Weight = i.DefaultWeight()
+	}
+
+	if i.StackSize < 1 && slices.Contains(stackableTypes, i.Type) {
+		i.StackSize = DefaultStackSize
+	}
+
+	if i.Type == Container {
+		if i.Capacity < 1 {
+			i.Capacity = DefaultContainerCapacity
+		}
+		for idx, h := range i.Holds {
+			i.Holds[idx] = strings.ToLower(h)
+		}
+	}
+
 	return nil
 }
 
+// A reasonable weight for the item based on its type
+func (i *ItemSpec) DefaultWeight() int {
+
+	switch i.Type {
+	case Weapon:
+		return 4 * max(i.Hands, 1)
+	case Body:
+		return 15
+	case Offhand, Legs:
+		return 8
+	case Head, Feet:
+		return 4
+	case Belt, Gloves, Grenade, Object, Junk, Container:
+		return 2
+	}
+
+	// Rings, potions, keys, scrolls, etc.
+	return 1
+}
+
 func (i *ItemSpec) Filename() string {
 
 	filename := util.ConvertForFilename(i.Name)
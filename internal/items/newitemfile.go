@@ -2,6 +2,8 @@ package items
 
 import (
 	"errors"
+	"fmt"
+	"os"
 
 	"github.com/GoMudEngine/GoMud/internal/configs"
 	"github.com/GoMudEngine/GoMud/internal/fileloader"
@@ -44,6 +46,68 @@ func CreateNewItemFile(newItemInfo ItemSpec) (int, error) {
 	return newItemInfo.Id(), nil
 }
 
+// Overwrites an existing item spec on disk and in memory.
+// If the name or type changed the file (and any script) is moved to match.
+func SaveItemSpec(itemInfo ItemSpec) error {
+
+	oldItemInfo := GetItemSpec(itemInfo.ItemId)
+	if oldItemInfo == nil {
+		return fmt.Errorf(`item %d does not exist`, itemInfo.ItemId)
+	}
+
+	if err := itemInfo.Validate(); err != nil {
+		return err
+	}
+
+	// Damage info is derrived from the diceroll, so don't write it.
+	saveInfo := itemInfo
+	saveInfo.Damage.Attacks = 0
+	saveInfo.Damage.DiceCount = 0
+	saveInfo.Damage.SideCount = 0
+
+	saveModes := []fileloader.SaveOption{}
+	if configs.GetFilePathsConfig().CarefulSaveFiles {
+		saveModes = append(saveModes, fileloader.SaveCareful)
+	}
+
+	basePath := configs.GetFilePathsConfig().DataFiles.String() + `/items`
+
+	if err := fileloader.SaveFlatFile[*ItemSpec](basePath, &saveInfo, saveModes...); err != nil {
+		return err
+	}
+
+	if oldItemInfo.Filepath() != itemInfo.Filepath() {
+		os.Rename(oldItemInfo.GetScriptPath(), itemInfo.GetScriptPath())
+		if err := fileloader.DeleteFlatFile[*ItemSpec](basePath, oldItemInfo); err != nil {
+			return err
+		}
+	}
+
+	items[itemInfo.Id()] = &itemInfo
+
+	return nil
+}
+
+// Removes an item spec (and any script) from disk and memory.
+// Instances of the item already in the world are left alone.
+func DeleteItemSpec(itemId int) error {
+
+	itemInfo := GetItemSpec(itemId)
+	if itemInfo == nil {
+		return fmt.Errorf(`item %d does not exist`, itemId)
+	}
+
+	if err := fileloader.DeleteFlatFile[*ItemSpec](configs.GetFilePathsConfig().DataFiles.String()+`/items`, itemInfo); err != nil {
+		return err
+	}
+
+	os.Remove(itemInfo.GetScriptPath())
+
+	delete(items, itemId)
+
+	return nil
+}
+
 func getNextItemId(t ItemType) int {
 
 	rangeMin := 0
@@ -0,0 +1,158 @@
+package items
+
+import (
+	"fmt"
+
+	"github.com/GoMudEngine/GoMud/internal/uuid"
+)
+
+const (
+	DefaultStackSize = 20
+)
+
+// Item types that stack by default, unless their spec sets a stacksize
+var stackableTypes = []ItemType{Potion, Food, Drink, Botanical, Ammo}
+
+// Whether more than one of this item can share a single inventory slot
+func (i *Item) IsStackable() bool {
+	return i.ItemId > 0 && i.GetSpec().StackSize > 1
+}
+
+// How many of the item this is. Items that aren't a stack are always 1.
+func (i *Item) GetQuantity() int {
+	if i.Quantity < 1 {
+		return 1
+	}
+	return i.Quantity
+}
+
+func (i *Item) SetQuantity(qty int) {
+	if qty <= 1 {
+		i.Quantity = 0
+		return
+	}
+	i.Quantity = qty
+}
+
+// A single item from the stack. It shares the identity of the stack so that removing it only takes one.
+func (i Item) Single() Item {
+	i.Quantity = 0
+	return i
+}
+
+// Whether two items are interchangeable enough to share a stack
+func (i *Item) CanStackWith(other Item) bool {
+	if !i.IsStackable() || i.ItemId != other.ItemId {
+		return false
+	}
+
+	// Anything that makes the item unique keeps it out of a stack
+	for _, itm := range []*Item{i, &other} {
+		if itm.Spec != nil || itm.Blob != `` || itm.Enchantments > 0 || itm.Wear > 0 || len(itm.Adjectives) > 0 || len(itm.Contents) > 0 {
+			return false
+		}
+	}
+
+	return i.Uses == other.Uses && i.StashedBy == other.StashedBy
+}
+
+// Adds an item to a list, merging it into matching stacks where there is room.
+func AddToStack(list []Item, itm Item) []Item {
+
+	if !itm.IsStackable() {
+		return append(list, itm)
+	}
+
+	stackSize := itm.GetSpec().StackSize
+	remaining := itm.GetQuantity()
+
+	for idx := range list {
+		if remaining < 1 {
+			break
+		}
+
+		if !list[idx].CanStackWith(itm) {
+			continue
+		}
+
+		room := stackSize - list[idx].GetQuantity()
+		if room < 1 {
+			continue
+		}
+
+		if room > remaining {
+			room = remaining
+		}
+
+		list[idx].SetQuantity(list[idx].GetQuantity() + room)
+		remaining -= room
+	}
+
+	// Whatever didn't fit goes into new stacks
+	for remaining > 0 {
+		newStack := itm
+		if QuantityIn(newStack, list...) > 0 {
+			newStack.UUID = uuid.New(UUIDItem)
+		}
+
+		qty := remaining
+		if qty > stackSize {
+			qty = stackSize
+		}
+		newStack.SetQuantity(qty)
+		remaining -= qty
+
+		list = append(list, newStack)
+	}
+
+	return list
+}
+
+// Removes as many of an item from a list as its quantity.
+func RemoveFromStack(list []Item, itm Item) ([]Item, bool) {
+
+	for j := len(list) - 1; j >= 0; j-- {
+
+		if !list[j].Equals(itm) {
+			continue
+		}
+
+		left := list[j].GetQuantity() - itm.GetQuantity()
+		if left < 1 {
+			return append(list[:j], list[j+1:]...), true
+		}
+
+		list[j].SetQuantity(left)
+
+		return list, true
+	}
+
+	return list, false
+}
+
+// How many are in the stack in the list that this item came from
+func QuantityIn(itm Item, list ...Item) int {
+	for _, listItm := range list {
+		if listItm.Equals(itm) {
+			return listItm.GetQuantity()
+		}
+	}
+	return 0
+}
+
+// The display name, followed by how many there are if it's a stack. e.g. "arrow (x20)"
+func (i *Item) QuantityDisplayName() string {
+	if i.GetQuantity() > 1 {
+		return fmt.Sprintf(`%s (x%d)`, i.DisplayName(), i.GetQuantity())
+	}
+	return i.DisplayName()
+}
+
+// Sets the quantity to take from a stack that has a number available.
+// Pass util.QuantityAll (or more than are available) to take them all.
+func (i *Item) TakeQuantity(qty int, available int) {
+	if qty < 1 || qty > available {
+		qty = available
+	}
+	i.SetQuantity(qty)
+}
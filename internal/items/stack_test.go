@@ -0,0 +1,52 @@
+package items
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAddToStack(t *testing.T) {
+
+	items[900001] = &ItemSpec{ItemId: 900001, Name: `arrow`, Type: Ammo, StackSize: 10, Weight: 1}
+	items[900002] = &ItemSpec{ItemId: 900002, Name: `rusty sword`, Type: Weapon}
+	defer delete(items, 900001)
+	defer delete(items, 900002)
+
+	arrow := New(900001)
+	sword := New(900002)
+
+	assert.True(t, arrow.IsStackable())
+	assert.False(t, sword.IsStackable())
+
+	list := AddToStack(nil, sword)
+	list = AddToStack(list, New(900002))
+	assert.Len(t, list, 2, "Unstackable items each take a slot")
+
+	arrow.SetQuantity(7)
+	list = AddToStack(list, arrow)
+	list = AddToStack(list, arrow)
+	assert.Len(t, list, 4, "Overflow should start a new stack")
+	assert.Equal(t, 10, list[2].GetQuantity())
+	assert.Equal(t, 4, list[3].GetQuantity())
+	assert.NotEqual(t, list[2].UUID, list[3].UUID)
+
+	one := list[2].Single()
+	assert.Equal(t, 1, one.GetQuantity())
+	assert.Equal(t, 1, one.GetWeight(), "A single should only weigh as much as one")
+
+	list, ok := RemoveFromStack(list, one)
+	assert.True(t, ok)
+	assert.Equal(t, 9, QuantityIn(one, list...))
+
+	one.TakeQuantity(50, QuantityIn(one, list...))
+	list, ok = RemoveFromStack(list, one)
+	assert.True(t, ok)
+	assert.Len(t, list, 3, "Taking the whole stack removes it")
+
+	worn := New(900001)
+	worn.SetAdjective(`cursed`, true)
+	list = AddToStack(list, worn)
+	assert.Len(t, list, 4, "Unique items don't merge into stacks")
+	assert.Equal(t, `arrow (x4)`, list[2].QuantityDisplayName())
+}
@@ -0,0 +1,243 @@
+// Package metrics keeps counters and histograms for the server and writes them
+// in the Prometheus text exposition format.
+//
+// Values that already live elsewhere (online users, loaded rooms, etc.) are
+// registered as functions and read at scrape time, rather than being copied here.
+package metrics
+
+import (
+	"fmt"
+	"io"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+const (
+	typeCounter   = `counter`
+	typeGauge     = `gauge`
+	typeHistogram = `histogram`
+)
+
+var (
+	// Seconds. Covers a quick turn up to a slow save.
+	DefaultBuckets = []float64{.0005, .001, .0025, .005, .01, .025, .05, .1, .25, .5, 1, 2.5, 5, 10}
+
+	lock     = sync.Mutex{}
+	families = map[string]*family{}
+)
+
+// A single value reported by a GaugeFunc or CounterFunc
+type Sample struct {
+	Labels []string // Pairs of label name, label value
+	Value  float64
+}
+
+type family struct {
+	name       string
+	help       string
+	metricType string
+	collectors []func() []Sample
+	counters   []*Counter
+	histograms []*Histogram
+}
+
+// Counts up from zero. Safe to use from any goroutine.
+type Counter struct {
+	labels string
+	value  atomic.Uint64
+}
+
+func (c *Counter) Inc() {
+	c.value.Add(1)
+}
+
+func (c *Counter) Add(n uint64) {
+	c.value.Add(n)
+}
+
+func (c *Counter) Value() uint64 {
+	return c.value.Load()
+}
+
+// Counts observations into buckets. Safe to use from any goroutine.
+type Histogram struct {
+	labels  []string
+	lock    sync.Mutex
+	buckets []float64
+	counts  []uint64 // Per bucket, not cumulative
+	count   uint64
+	sum     float64
+}
+
+func (h *Histogram) Observe(value float64) {
+	h.lock.Lock()
+	defer h.lock.Unlock()
+
+	if i := sort.SearchFloat64s(h.buckets, value); i < len(h.buckets) {
+		h.counts[i]++
+	}
+	h.count++
+	h.sum += value
+}
+
+// Records the seconds passed since start
+func (h *Histogram) ObserveSince(start time.Time) {
+	h.Observe(time.Since(start).Seconds())
+}
+
+// Registers a gauge whose values are read when metrics are written
+func GaugeFunc(name string, help string, collect func() []Sample) {
+	register(name, help, typeGauge, func(f *family) {
+		f.collectors = append(f.collectors, collect)
+	})
+}
+
+// Registers a counter whose values are read when metrics are written
+func CounterFunc(name string, help string, collect func() []Sample) {
+	register(name, help, typeCounter, func(f *family) {
+		f.collectors = append(f.collectors, collect)
+	})
+}
+
+// Creates and registers a counter. labels are pairs of label name, label value
+func NewCounter(name string, help string, labels ...string) *Counter {
+	c := &Counter{labels: formatLabels(labels)}
+
+	register(name, help, typeCounter, func(f *family) {
+		f.counters = append(f.counters, c)
+	})
+
+	return c
+}
+
+// Creates and registers a histogram. labels are pairs of label name, label value
+func NewHistogram(name string, help string, buckets []float64, labels ...string) *Histogram {
+
+	if len(buckets) == 0 {
+		buckets = DefaultBuckets
+	}
+
+	h := &Histogram{
+		labels:  labels[:len(labels):len(labels)], // Appending le="" must not touch the caller's slice
+		buckets: append([]float64{}, buckets...),
+		counts:  make([]uint64, len(buckets)),
+	}
+	sort.Float64s(h.buckets)
+
+	register(name, help, typeHistogram, func(f *family) {
+		f.histograms = append(f.histograms, h)
+	})
+
+	return h
+}
+
+// Writes all registered metrics in the Prometheus text format
+func Write(w io.Writer) error {
+
+	lock.Lock()
+	names := make([]string, 0, len(families))
+	for name := range families {
+		names = append(names, name)
+	}
+	fams := make([]family, 0, len(families))
+	sort.Strings(names)
+	for _, name := range names {
+		f := families[name]
+		fams = append(fams, family{
+			name:       f.name,
+			help:       f.help,
+			metricType: f.metricType,
+			collectors: append([]func() []Sample{}, f.collectors...),
+			counters:   append([]*Counter{}, f.counters...),
+			histograms: append([]*Histogram{}, f.histograms...),
+		})
+	}
+	lock.Unlock()
+
+	var sb strings.Builder
+
+	for _, f := range fams {
+
+		fmt.Fprintf(&sb, "# HELP %s %s\n", f.name, escapeHelp(f.help))
+		fmt.Fprintf(&sb, "# TYPE %s %s\n", f.name, f.metricType)
+
+		for _, collect := range f.collectors {
+			for _, s := range collect() {
+				fmt.Fprintf(&sb, "%s%s %s\n", f.name, formatLabels(s.Labels), formatValue(s.Value))
+			}
+		}
+
+		for _, c := range f.counters {
+			fmt.Fprintf(&sb, "%s%s %d\n", f.name, c.labels, c.Value())
+		}
+
+		for _, h := range f.histograms {
+			h.lock.Lock()
+			cumulative := uint64(0)
+			for i, upper := range h.buckets {
+				cumulative += h.counts[i]
+				fmt.Fprintf(&sb, "%s_bucket%s %d\n", f.name, formatLabels(append(h.labels, `le`, formatValue(upper))), cumulative)
+			}
+			fmt.Fprintf(&sb, "%s_bucket%s %d\n", f.name, formatLabels(append(h.labels, `le`, `+Inf`)), h.count)
+			fmt.Fprintf(&sb, "%s_sum%s %s\n", f.name, formatLabels(h.labels), formatValue(h.sum))
+			fmt.Fprintf(&sb, "%s_count%s %d\n", f.name, formatLabels(h.labels), h.count)
+			h.lock.Unlock()
+		}
+	}
+
+	_, err := io.WriteString(w, sb.String())
+	return err
+}
+
+// Finds or creates the family by name, and lets add() attach to it while locked
+func register(name string, help string, metricType string, add func(f *family)) {
+	lock.Lock()
+	defer lock.Unlock()
+
+	f, ok := families[name]
+	if !ok {
+		f = &family{name: name, help: help, metricType: metricType}
+		families[name] = f
+	}
+
+	add(f)
+}
+
+func formatLabels(labels []string) string {
+	if len(labels) < 2 {
+		return ``
+	}
+
+	parts := make([]string, 0, len(labels)/2)
+	for i := 0; i+1 < len(labels); i += 2 {
+		parts = append(parts, labels[i]+`="`+escapeLabel(labels[i+1])+`"`)
+	}
+
+	return `{` + strings.Join(parts, `,`) + `}`
+}
+
+func formatValue(v float64) string {
+	if math.IsInf(v, 1) {
+		return `+Inf`
+	}
+	if math.IsInf(v, -1) {
+		return `-Inf`
+	}
+	return strconv.FormatFloat(v, 'g', -1, 64)
+}
+
+func escapeLabel(s string) string {
+	s = strings.ReplaceAll(s, `\`, `\\`)
+	s = strings.ReplaceAll(s, `"`, `\"`)
+	return strings.ReplaceAll(s, "\n", `\n`)
+}
+
+func escapeHelp(s string) string {
+	s = strings.ReplaceAll(s, `\`, `\\`)
+	return strings.ReplaceAll(s, "\n", `\n`)
+}
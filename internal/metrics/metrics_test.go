@@ -0,0 +1,43 @@
+package metrics
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWrite(t *testing.T) {
+
+	c := NewCounter(`test_things_total`, `Things counted.`, `kind`, `a"b`)
+	c.Inc()
+	c.Add(2)
+
+	h := NewHistogram(`test_duration_seconds`, `How long.`, []float64{1, 0.1}, `what`, `x`)
+	h.Observe(0.05)
+	h.Observe(0.5)
+	h.Observe(3)
+
+	GaugeFunc(`test_level`, `A level.`, func() []Sample {
+		return []Sample{{Value: 1.5}, {Labels: []string{`zone`, `east`}, Value: 2}}
+	})
+
+	var sb strings.Builder
+	assert.NoError(t, Write(&sb))
+	out := sb.String()
+
+	assert.Contains(t, out, "# HELP test_things_total Things counted.\n# TYPE test_things_total counter\ntest_things_total{kind=\"a\\\"b\"} 3\n")
+
+	assert.Contains(t, out, "# TYPE test_duration_seconds histogram\n"+
+		"test_duration_seconds_bucket{what=\"x\",le=\"0.1\"} 1\n"+
+		"test_duration_seconds_bucket{what=\"x\",le=\"1\"} 2\n"+
+		"test_duration_seconds_bucket{what=\"x\",le=\"+Inf\"} 3\n"+
+		"test_duration_seconds_sum{what=\"x\"} 3.55\n"+
+		"test_duration_seconds_count{what=\"x\"} 3\n")
+
+	assert.Contains(t, out, "# TYPE test_level gauge\ntest_level 1.5\ntest_level{zone=\"east\"} 2\n")
+
+	// Families come out sorted by name
+	assert.Less(t, strings.Index(out, `test_duration_seconds`), strings.Index(out, `test_level`))
+	assert.Less(t, strings.Index(out, `test_level`), strings.Index(out, `test_things_total`))
+}
@@ -0,0 +1,66 @@
+package migration
+
+import (
+	"github.com/GoMudEngine/GoMud/internal/mudlog"
+	"github.com/GoMudEngine/GoMud/internal/users"
+)
+
+// Description:
+// users.UserRecord.Password was changed from an unsalted sha256 hash to a salted pbkdf2 hash.
+// This function walks all user files and checks which password scheme they are stored with.
+// Plaintext passwords (usually the result of a manual reset) are hashed right away.
+// sha256 passwords cannot be converted without the original password, so those accounts are flagged
+// in the log and will be upgraded automatically the next time they successfully log in.
+func migrate_UserPasswordScheme() error {
+
+	var saveErr error
+
+	legacyCount := 0
+
+	users.SearchOfflineUsers(func(u *users.UserRecord) bool {
+
+		scheme := users.GetPasswordScheme(u.Password)
+
+		switch scheme {
+
+		case users.PasswordSchemePlaintext:
+
+			if u.Password == `` {
+				mudlog.Warn("Migration 0.9.2", "userId", u.UserId, "username", u.Username, "message", "user has no password set")
+				return true
+			}
+
+			mudlog.Info("Migration 0.9.2", "userId", u.UserId, "username", u.Username, "message", "hashing plaintext password")
+
+			hash, err := users.HashPassword(u.Password)
+			if err != nil {
+				saveErr = err
+				return false
+			}
+
+			u.Password = hash
+			if err := users.SaveUser(*u); err != nil {
+				saveErr = err
+				return false
+			}
+
+		case users.PasswordSchemeSha256:
+
+			legacyCount++
+			mudlog.Warn("Migration 0.9.2", "userId", u.UserId, "username", u.Username, "message", "password uses legacy sha256 scheme, will be upgraded on next login")
+
+		}
+
+		return true
+	})
+
+	if saveErr != nil {
+		return saveErr
+	}
+
+	if legacyCount > 0 {
+		mudlog.Warn("Migration 0.9.2", "legacy-passwords", legacyCount)
+	}
+
+	return nil
+}
@@ -0,0 +1,30 @@
+package migration
+
+import (
+	"github.com/GoMudEngine/GoMud/internal/configs"
+	"github.com/GoMudEngine/GoMud/internal/mudlog"
+)
+
+// Description:
+// Items were given weight, and GamePlay.Encumbrance was added so that heavy loads slow characters down
+// and block picking up more than they can carry. Before this only the number of objects carried mattered.
+// Worlds that have already been run have a saved Server.CurrentVersion, and characters whose inventories
+// were built under the old rules, so encumbrance is switched off for them to keep them playing the same.
+// Fresh installs have no saved version, so they keep the default from config.yaml.
+func migrate_EncumbranceDefault() error {
+
+	flatOverrides := configs.Flatten(configs.GetOverrides())
+
+	if _, ok := flatOverrides[`Server.CurrentVersion`]; !ok {
+		return nil
+	}
+
+	// Already chosen by an admin
+	if _, ok := flatOverrides[`GamePlay.Encumbrance.Enabled`]; ok {
+		return nil
+	}
+
+	mudlog.Info("Migration 0.9.3", "message", "disabling GamePlay.Encumbrance for an existing world. Set it to true to enable item weight.")
+
+	return configs.SetVal(`GamePlay.Encumbrance.Enabled`, `false`)
+}
@@ -21,6 +21,24 @@ func doAllMigrations(lastConfigVersion version.Version) error {
 
 	}
 
+	// 0.9.1 -> 0.9.2
+	if lastConfigVersion.IsOlderThan(version.New(0, 9, 2)) {
+
+		if err := migrate_UserPasswordScheme(); err != nil {
+			return err
+		}
+
+	}
+
+	// 0.9.2 -> 0.9.3
+	if lastConfigVersion.IsOlderThan(version.New(0, 9, 3)) {
+
+		if err := migrate_EncumbranceDefault(); err != nil {
+			return err
+		}
+
+	}
+
 	return nil
 }
 
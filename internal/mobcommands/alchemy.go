@@ -18,7 +18,7 @@ func Alchemy(rest string, mob *mobs.Mob, room *rooms.Room) (bool, error) {
 	if args[0] == "random" {
 		// select a random item
 		if len(mob.Character.Items) > 0 {
-			matchItem := mob.Character.Items[util.Rand(len(mob.Character.Items))]
+			matchItem := mob.Character.Items[util.GetRNG(util.RNGIdle).Rand(len(mob.Character.Items))]
 			Alchemy(matchItem.Name(), mob, room)
 
 		}
@@ -55,7 +55,7 @@ func Attack(rest string, mob *mobs.Mob, room *rooms.Room) (bool, error) {
 				allMobs = append(allMobs, mobInstanceId)
 			}
 
-			randomSelection := util.Rand(len(allMobs) + len(allPlayers))
+			randomSelection := util.GetRNG(util.RNGCombat).Rand(len(allMobs) + len(allPlayers))
 
 			if randomSelection < len(allMobs) {
 				attackMobInstanceId = allMobs[randomSelection]
@@ -75,13 +75,13 @@ func Attack(rest string, mob *mobs.Mob, room *rooms.Room) (bool, error) {
 			}
 
 			if len(allMobs) > 0 {
-				attackMobInstanceId = allMobs[util.Rand(len(allMobs))]
+				attackMobInstanceId = allMobs[util.GetRNG(util.RNGCombat).Rand(len(allMobs))]
 			}
 
 		} else { // *user etc. ANY PLAYER
 
 			if allPlayers := room.GetPlayers(); len(allPlayers) > 0 {
-				attackPlayerId = allPlayers[util.Rand(len(allPlayers))]
+				attackPlayerId = allPlayers[util.GetRNG(util.RNGCombat).Rand(len(allPlayers))]
 			}
 
 		}
@@ -17,7 +17,7 @@ func Drop(rest string, mob *mobs.Mob, room *rooms.Room) (bool, error) {
 
 	args := util.SplitButRespectQuotes(strings.ToLower(rest))
 
-	if args[0] == "all" {
+	if args[0] == "all" && len(args) == 1 {
 
 		iCopies := []items.Item{}
 
@@ -25,12 +25,11 @@ func Drop(rest string, mob *mobs.Mob, room *rooms.Room) (bool, error) {
 			Drop(fmt.Sprintf("%d gold", mob.Character.Gold), mob, room)
 		}
 
-		for _, item := range mob.Character.Items {
-			iCopies = append(iCopies, item)
-		}
+		iCopies = append(iCopies, mob.Character.Items...)
 
+		// Drop each whole stack
 		for _, item := range iCopies {
-			Drop(item.Name(), mob, room)
+			Drop(`all `+item.ShorthandId(), mob, room)
 		}
 
 		return true, nil
@@ -60,11 +59,16 @@ func Drop(rest string, mob *mobs.Mob, room *rooms.Room) (bool, error) {
 		return true, nil
 	}
 
+	// Drop 5 arrows, drop all potion
+	quantity, itemName := util.SplitQuantity(rest)
+
 	// Check whether the user has an item in their inventory that matches
-	matchItem, found := mob.Character.FindInBackpack(rest)
+	matchItem, found := mob.Character.FindInBackpack(itemName)
 
 	if found {
 
+		matchItem.TakeQuantity(quantity, mob.Character.CountInBackpack(matchItem))
+
 		// Swap the item location
 		room.AddItem(matchItem, false)
 		mob.Character.RemoveItem(matchItem)
@@ -76,7 +80,7 @@ func Drop(rest string, mob *mobs.Mob, room *rooms.Room) (bool, error) {
 		})
 
 		room.SendText(
-			fmt.Sprintf(`<ansi fg="mobname">%s</ansi> drops their <ansi fg="item">%s</ansi>...`, mob.Character.Name, matchItem.DisplayName()))
+			fmt.Sprintf(`<ansi fg="mobname">%s</ansi> drops their <ansi fg="item">%s</ansi>...`, mob.Character.Name, matchItem.QuantityDisplayName()))
 	}
 
 	return true, nil
@@ -36,7 +36,7 @@ func Equip(rest string, mob *mobs.Mob, room *rooms.Room) (bool, error) {
 
 	if rest == `random` {
 		if len(mob.Character.Items) > 0 {
-			matchItem = mob.Character.Items[util.Rand(len(mob.Character.Items))]
+			matchItem = mob.Character.Items[util.GetRNG(util.RNGIdle).Rand(len(mob.Character.Items))]
 			found = true
 		}
 	}
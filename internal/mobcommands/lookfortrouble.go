@@ -140,7 +140,7 @@ func LookForTrouble(rest string, mob *mobs.Mob, room *rooms.Room) (bool, error)
 	mobCt := len(possibleMobTargets)
 
 	if userCt > 0 || mobCt > 0 {
-		randRoll := util.Rand(userCt + mobCt)
+		randRoll := util.GetRNG(util.RNGCombat).Rand(userCt + mobCt)
 		if randRoll < userCt {
 			targetUserId = nonDownedUserTargets[randRoll]
 		} else {
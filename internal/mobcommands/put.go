@@ -90,7 +90,7 @@ func Put(rest string, mob *mobs.Mob, room *rooms.Room) (bool, error) {
 		// Enforce container size limits
 		if len(container.Items) > int(configs.GetGamePlayConfig().ContainerSizeMax) {
 
-			randItemToRemove := util.Rand(len(container.Items))
+			randItemToRemove := util.GetRNG(util.RNGIdle).Rand(len(container.Items))
 			oopsItem := container.Items[randItemToRemove]
 
 			// get all items that spawn in chests
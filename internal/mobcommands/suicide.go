@@ -99,8 +99,8 @@ func Suicide(rest string, mob *mobs.Mob, room *rooms.Room) (bool, error) {
 
 	if len(mob.Character.PlayerDamage) > 0 {
 
-		xpVal = xpVal / len(mob.Character.PlayerDamage) // Div by number of players that beat him up
-		xpVal += ((util.Rand(3) - 1) * xpVariation)     // a little bit of variation
+		xpVal = xpVal / len(mob.Character.PlayerDamage)                  // Div by number of players that beat him up
+		xpVal += ((util.GetRNG(util.RNGLoot).Rand(3) - 1) * xpVariation) // a little bit of variation
 
 		totalPlayerLevels := 0
 		for uId, _ := range mob.Character.PlayerDamage {
@@ -182,7 +182,7 @@ func Suicide(rest string, mob *mobs.Mob, room *rooms.Room) (bool, error) {
 
 					mudlog.Debug("Tame Chance", "levelDelta", levelDelta, "skillsDelta", skillsDelta, "targetNumber", targetNumber)
 
-					if util.Rand(1000) < targetNumber {
+					if util.GetRNG(util.RNGSkills).Rand(1000) < targetNumber {
 						if mob.IsTameable() && user.Character.GetSkillLevel(skills.Tame) > 0 {
 
 							currentSkill := user.Character.MobMastery.GetTame(int(mob.MobId))
@@ -262,7 +262,7 @@ func Suicide(rest string, mob *mobs.Mob, room *rooms.Room) (bool, error) {
 
 						mudlog.Debug("Tame Chance", "levelDelta", levelDelta, "skillsDelta", skillsDelta, "targetNumber", targetNumber)
 
-						if util.Rand(1000) < targetNumber {
+						if util.GetRNG(util.RNGSkills).Rand(1000) < targetNumber {
 							if mob.IsTameable() && user.Character.GetSkillLevel(skills.Tame) > 0 {
 
 								currentSkill := user.Character.MobMastery.GetTame(int(mob.MobId))
@@ -300,7 +300,7 @@ func Suicide(rest string, mob *mobs.Mob, room *rooms.Room) (bool, error) {
 
 		for _, item := range allWornItems {
 
-			roll := util.Rand(100)
+			roll := util.GetRNG(util.RNGLoot).Rand(100)
 
 			util.LogRoll(`Drop Item`, roll, mob.ItemDropChance)
 
@@ -557,14 +557,14 @@ func (m *Mob) GetAngryCommand() string {
 
 	// First check if the mob has a specific action
 	if len(m.AngryCommands) > 0 {
-		return m.AngryCommands[util.Rand(len(m.AngryCommands))]
+		return m.AngryCommands[util.GetRNG(util.RNGCombat).Rand(len(m.AngryCommands))]
 	}
 
 	// default to race based actions
 	r := races.GetRace(m.Character.RaceId)
 	actionCt := len(r.AngryCommands)
 	if actionCt > 0 {
-		return r.AngryCommands[util.Rand(actionCt)]
+		return r.AngryCommands[util.GetRNG(util.RNGCombat).Rand(actionCt)]
 	}
 	return ``
 }
@@ -575,13 +575,13 @@ func (m *Mob) GetIdleCommand() string {
 	// This is to prevent requiring Admins to assign an empy idlecommand to mob definitions
 	// while still allowing "no idle command found" behavior to run.
 	// Empty idle commands can still be defined in mobs, however.
-	if util.Rand(100) == 0 {
+	if util.GetRNG(util.RNGIdle).Rand(100) == 0 {
 		return ``
 	}
 
 	// First check if the mob has a specific action
 	if len(m.IdleCommands) > 0 {
-		return m.IdleCommands[util.Rand(len(m.IdleCommands))]
+		return m.IdleCommands[util.GetRNG(util.RNGIdle).Rand(len(m.IdleCommands))]
 	}
 
 	return ``
@@ -2,6 +2,7 @@ package mobs
 
 import (
 	"errors"
+	"fmt"
 	"os"
 	"path/filepath"
 
@@ -69,6 +70,78 @@ func CreateNewMobFile(newMobInfo Mob, copyScript string) (MobId, error) {
 	return newMobInfo.MobId, nil
 }
 
+// Overwrites an existing mob spec on disk and in memory.
+// Mobs already spawned in the world keep their old stats.
+func SaveMobSpec(mobInfo Mob) error {
+
+	oldMobInfo, ok := mobs[int(mobInfo.MobId)]
+	if !ok {
+		return fmt.Errorf(`mob %d does not exist`, mobInfo.MobId)
+	}
+
+	if err := mobInfo.Validate(); err != nil {
+		return err
+	}
+
+	saveModes := []fileloader.SaveOption{}
+	if configs.GetFilePathsConfig().CarefulSaveFiles {
+		saveModes = append(saveModes, fileloader.SaveCareful)
+	}
+
+	basePath := configs.GetFilePathsConfig().DataFiles.String() + `/mobs`
+
+	if err := fileloader.SaveFlatFile[*Mob](basePath, &mobInfo, saveModes...); err != nil {
+		return err
+	}
+
+	// The filename follows the original name, so only a zone change moves the file
+	if oldMobInfo.Filepath() != mobInfo.Filepath() {
+		newScriptPath := mobInfo.GetScriptPath()
+		os.MkdirAll(filepath.Dir(newScriptPath), os.ModePerm)
+		os.Rename(oldMobInfo.GetScriptPath(), newScriptPath)
+		if err := fileloader.DeleteFlatFile[*Mob](basePath, oldMobInfo); err != nil {
+			return err
+		}
+	}
+
+	mobInfo.Character.CacheDescription()
+	mobs[mobInfo.Id()] = &mobInfo
+
+	rebuildMobNames()
+
+	return nil
+}
+
+// Removes a mob spec (and any script) from disk and memory.
+// Mobs already spawned in the world are left alone.
+func DeleteMobSpec(mobId MobId) error {
+
+	mobInfo, ok := mobs[int(mobId)]
+	if !ok {
+		return fmt.Errorf(`mob %d does not exist`, mobId)
+	}
+
+	if err := fileloader.DeleteFlatFile[*Mob](configs.GetFilePathsConfig().DataFiles.String()+`/mobs`, mobInfo); err != nil {
+		return err
+	}
+
+	os.Remove(mobInfo.GetScriptPath())
+
+	delete(mobs, int(mobId))
+	delete(mobNameCache, mobId)
+
+	rebuildMobNames()
+
+	return nil
+}
+
+func rebuildMobNames() {
+	allMobNames = allMobNames[:0]
+	for _, mob := range mobs {
+		allMobNames = append(allMobNames, mob.Character.Name)
+	}
+}
+
 func getNextMobId() MobId {
 
 	lowestFreeId := MobId(0)
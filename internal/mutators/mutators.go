@@ -346,6 +346,49 @@ func (m *MutatorSpec) Validate() error {
 	return nil
 }
 
+// Writes a mutator to disk and memory, creating it if it doesn't exist.
+func SaveMutatorSpec(mutatorInfo MutatorSpec) error {
+
+	if mutatorInfo.MutatorId == `` {
+		return fmt.Errorf(`mutator id cannot be empty`)
+	}
+
+	if err := mutatorInfo.Validate(); err != nil {
+		return err
+	}
+
+	saveModes := []fileloader.SaveOption{}
+	if configs.GetFilePathsConfig().CarefulSaveFiles {
+		saveModes = append(saveModes, fileloader.SaveCareful)
+	}
+
+	if err := fileloader.SaveFlatFile[*MutatorSpec](configs.GetFilePathsConfig().DataFiles.String()+`/mutators`, &mutatorInfo, saveModes...); err != nil {
+		return err
+	}
+
+	allMutators[mutatorInfo.Id()] = &mutatorInfo
+
+	return nil
+}
+
+// Removes a mutator from disk and memory.
+// Rooms that still list the mutator will ignore it.
+func DeleteMutatorSpec(mutatorId string) error {
+
+	mutatorInfo, ok := allMutators[mutatorId]
+	if !ok {
+		return fmt.Errorf(`mutator %s does not exist`, mutatorId)
+	}
+
+	if err := fileloader.DeleteFlatFile[*MutatorSpec](configs.GetFilePathsConfig().DataFiles.String()+`/mutators`, mutatorInfo); err != nil {
+		return err
+	}
+
+	delete(allMutators, mutatorId)
+
+	return nil
+}
+
 // file self loads due to init()
 func LoadDataFiles() {
 
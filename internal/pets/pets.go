@@ -69,19 +69,14 @@ func (p *Pet) StoreItem(i items.Item) bool {
 		return false
 	}
 	i.Validate()
-	p.Items = append(p.Items, i)
+	p.Items = items.AddToStack(p.Items, i)
 	return true
 }
 
 func (p *Pet) RemoveItem(i items.Item) bool {
-
-	for j := len(p.Items) - 1; j >= 0; j-- {
-		if p.Items[j].Equals(i) {
-			p.Items = append(p.Items[:j], p.Items[j+1:]...)
-			return true
-		}
-	}
-	return false
+	var removed bool
+	p.Items, removed = items.RemoveFromStack(p.Items, i)
+	return removed
 }
 
 func (p *Pet) GetBuffs() []int {
@@ -97,11 +92,11 @@ func (p *Pet) FindItem(itemName string) (items.Item, bool) {
 	closeMatchItem, matchItem := items.FindMatchIn(itemName, p.Items...)
 
 	if matchItem.ItemId != 0 {
-		return matchItem, true
+		return matchItem.Single(), true
 	}
 
 	if closeMatchItem.ItemId != 0 {
-		return closeMatchItem, true
+		return closeMatchItem.Single(), true
 	}
 
 	return items.Item{}, false
@@ -179,6 +179,57 @@ func GetAllQuests() []Quest {
 	return ret
 }
 
+// Writes a quest to disk and memory, creating it if it doesn't exist.
+func SaveQuest(questInfo Quest) error {
+
+	if questInfo.QuestId < 0 {
+		return fmt.Errorf(`quest id %d cannot be negative`, questInfo.QuestId)
+	}
+
+	if err := questInfo.Validate(); err != nil {
+		return err
+	}
+
+	saveModes := []fileloader.SaveOption{}
+	if configs.GetFilePathsConfig().CarefulSaveFiles {
+		saveModes = append(saveModes, fileloader.SaveCareful)
+	}
+
+	basePath := configs.GetFilePathsConfig().DataFiles.String() + `/quests`
+
+	if err := fileloader.SaveFlatFile[*Quest](basePath, &questInfo, saveModes...); err != nil {
+		return err
+	}
+
+	if oldQuestInfo, ok := quests[questInfo.QuestId]; ok && oldQuestInfo.Filepath() != questInfo.Filepath() {
+		if err := fileloader.DeleteFlatFile[*Quest](basePath, oldQuestInfo); err != nil {
+			return err
+		}
+	}
+
+	quests[questInfo.Id()] = &questInfo
+
+	return nil
+}
+
+// Removes a quest from disk and memory.
+// Players who already have progress on it keep their quest tokens.
+func DeleteQuest(questId int) error {
+
+	questInfo, ok := quests[questId]
+	if !ok {
+		return fmt.Errorf(`quest %d does not exist`, questId)
+	}
+
+	if err := fileloader.DeleteFlatFile[*Quest](configs.GetFilePathsConfig().DataFiles.String()+`/quests`, questInfo); err != nil {
+		return err
+	}
+
+	delete(quests, questId)
+
+	return nil
+}
+
 // file self loads due to init()
 func LoadDataFiles() {
 
@@ -11,6 +11,7 @@ import (
 	"github.com/GoMudEngine/GoMud/internal/fileloader"
 	"github.com/GoMudEngine/GoMud/internal/items"
 	"github.com/GoMudEngine/GoMud/internal/mudlog"
+	"github.com/GoMudEngine/GoMud/internal/statmods"
 	"github.com/GoMudEngine/GoMud/internal/stats"
 	"github.com/GoMudEngine/GoMud/internal/util"
 	"gopkg.in/yaml.v2"
@@ -37,6 +38,8 @@ type Race struct {
 	Size             Size
 	TNLScale         float32
 	UnarmedName      string
+	UnarmedElement   items.Element     `yaml:"unarmedelement,omitempty"` // Element of unarmed attacks (e.g. fire for a fire elemental)
+	StatMods         statmods.StatMods `yaml:"statmods,omitempty"`       // Innate stat mods, such as elemental resistances
 	Tameable         bool
 	Damage           items.Damage
 	Selectable       bool
@@ -95,6 +98,10 @@ func (r *Race) Validate() error {
 	}
 	r.Size = Size(strings.ToLower(string(r.Size))) // Sometimes a mismatching CaSe value is provided.
 
+	if r.UnarmedElement != `` && !r.UnarmedElement.IsValid() {
+		return fmt.Errorf("race has an invalid unarmedelement: %s", r.UnarmedElement)
+	}
+
 	// Recalculate stats, based on level one because this is actually the baseline for the race
 	r.Stats.Strength.Recalculate(1)
 	r.Stats.Speed.Recalculate(1)
@@ -179,6 +186,52 @@ func (r *Race) Save() error {
 	return nil
 }
 
+// Writes a race to disk and memory, creating it if it doesn't exist.
+func SaveRace(raceInfo Race) error {
+
+	if err := raceInfo.Validate(); err != nil {
+		return err
+	}
+
+	saveModes := []fileloader.SaveOption{}
+	if configs.GetFilePathsConfig().CarefulSaveFiles {
+		saveModes = append(saveModes, fileloader.SaveCareful)
+	}
+
+	basePath := configs.GetFilePathsConfig().DataFiles.String() + `/races`
+
+	if err := fileloader.SaveFlatFile[*Race](basePath, &raceInfo, saveModes...); err != nil {
+		return err
+	}
+
+	if oldRaceInfo, ok := races[raceInfo.RaceId]; ok && oldRaceInfo.Filepath() != raceInfo.Filepath() {
+		if err := fileloader.DeleteFlatFile[*Race](basePath, oldRaceInfo); err != nil {
+			return err
+		}
+	}
+
+	races[raceInfo.Id()] = &raceInfo
+
+	return nil
+}
+
+// Removes a race from disk and memory.
+func DeleteRace(raceId int) error {
+
+	raceInfo, ok := races[raceId]
+	if !ok {
+		return fmt.Errorf(`race %d does not exist`, raceId)
+	}
+
+	if err := fileloader.DeleteFlatFile[*Race](configs.GetFilePathsConfig().DataFiles.String()+`/races`, raceInfo); err != nil {
+		return err
+	}
+
+	delete(races, raceId)
+
+	return nil
+}
+
 // file self loads due to init()
 func LoadDataFiles() {
 
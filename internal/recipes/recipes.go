@@ -0,0 +1,357 @@
+package recipes
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/GoMudEngine/GoMud/internal/configs"
+	"github.com/GoMudEngine/GoMud/internal/fileloader"
+	"github.com/GoMudEngine/GoMud/internal/items"
+	"github.com/GoMudEngine/GoMud/internal/mudlog"
+	"github.com/GoMudEngine/GoMud/internal/util"
+	"gopkg.in/yaml.v2"
+)
+
+var (
+	allRecipes = map[string]*RecipeSpec{}
+)
+
+type Quality string
+
+const (
+	QualityPoor       Quality = `poor`
+	QualityNormal     Quality = `normal`
+	QualityFine       Quality = `fine`
+	QualityMasterwork Quality = `masterwork`
+)
+
+// An item consumed by a recipe
+type Ingredient struct {
+	ItemId   int `yaml:"itemid"`
+	Quantity int `yaml:"quantity,omitempty"` // Defaults to 1
+}
+
+// An item that must be carried to craft, but isn't consumed.
+// Either a specific itemid or any item of a type (e.g. "lockpicks")
+type Tool struct {
+	ItemId   int            `yaml:"itemid,omitempty"`
+	ItemType items.ItemType `yaml:"itemtype,omitempty"`
+}
+
+// What a recipe produces
+type Output struct {
+	ItemId     int            `yaml:"itemid"`
+	Quantity   int            `yaml:"quantity,omitempty"`   // Defaults to 1
+	Adjectives []string       `yaml:"adjectives,omitempty"` // Added to the crafted item (e.g. "homemade")
+	Overrides  map[string]any `yaml:"overrides,omitempty"`  // Replaces values in the item spec (e.g. name, description, buffids)
+}
+
+type RecipeSpec struct {
+	RecipeId    string         `yaml:"recipeid"`
+	Name        string         `yaml:"name"`
+	Description string         `yaml:"description,omitempty"`
+	MustLearn   bool           `yaml:"mustlearn,omitempty"`  // If true, players must learn it (usually from a script) before it's known
+	Station     string         `yaml:"station,omitempty"`    // The room must have this craft station (e.g. "alchemy", "forge")
+	Tools       []Tool         `yaml:"tools,omitempty"`      // Must be carried but aren't consumed
+	Skills      map[string]int `yaml:"skills,omitempty"`     // skill name => minimum level
+	Inputs      []Ingredient   `yaml:"inputs"`               // Consumed when crafting
+	Output      Output         `yaml:"output"`               // What is produced
+	Quality     bool           `yaml:"quality,omitempty"`    // If true, the output gets a random quality that improves with skill
+	Experience  int            `yaml:"experience,omitempty"` // Experience granted per craft
+}
+
+func (r *RecipeSpec) Id() string {
+	return r.RecipeId
+}
+
+func (r *RecipeSpec) Filename() string {
+	filename := util.ConvertForFilename(r.RecipeId)
+	return fmt.Sprintf("%s.yaml", filename)
+}
+
+func (r *RecipeSpec) Filepath() string {
+	return r.Filename()
+}
+
+func (r *RecipeSpec) Validate() error {
+
+	r.RecipeId = strings.ToLower(r.RecipeId)
+
+	if r.RecipeId == `` {
+		return errors.New(`recipeid is required`)
+	}
+
+	if r.Name == `` {
+		r.Name = r.RecipeId
+	}
+
+	if len(r.Inputs) == 0 {
+		return errors.New(`recipe has no inputs`)
+	}
+
+	for i := range r.Inputs {
+		if items.GetItemSpec(r.Inputs[i].ItemId) == nil {
+			return fmt.Errorf(`input itemid %d does not exist`, r.Inputs[i].ItemId)
+		}
+		if r.Inputs[i].Quantity < 1 {
+			r.Inputs[i].Quantity = 1
+		}
+	}
+
+	for _, t := range r.Tools {
+		if t.ItemId == 0 && t.ItemType == items.Unknown {
+			return errors.New(`tool has no itemid or itemtype`)
+		}
+		if t.ItemId != 0 && items.GetItemSpec(t.ItemId) == nil {
+			return fmt.Errorf(`tool itemid %d does not exist`, t.ItemId)
+		}
+	}
+
+	if items.GetItemSpec(r.Output.ItemId) == nil {
+		return fmt.Errorf(`output itemid %d does not exist`, r.Output.ItemId)
+	}
+
+	if r.Output.Quantity < 1 {
+		r.Output.Quantity = 1
+	}
+
+	r.Station = strings.ToLower(r.Station)
+
+	return nil
+}
+
+// Whether the tool is satisfied by the item
+func (t Tool) Matches(itm items.Item) bool {
+	if t.ItemId != 0 {
+		return itm.ItemId == t.ItemId
+	}
+	return itm.GetSpec().Type == t.ItemType
+}
+
+// A short name for the tool, suitable for showing players
+func (t Tool) Name() string {
+	if t.ItemId != 0 {
+		if spec := items.GetItemSpec(t.ItemId); spec != nil {
+			return spec.Name
+		}
+	}
+	return string(t.ItemType)
+}
+
+// Whether a character with these skills is able to craft the recipe
+func (r *RecipeSpec) HasSkills(skillLevels map[string]int) bool {
+	for skillName, level := range r.Skills {
+		if skillLevels[skillName] < level {
+			return false
+		}
+	}
+	return true
+}
+
+// How many skill levels over the requirements the crafter is.
+func (r *RecipeSpec) SkillSurplus(skillLevels map[string]int) int {
+
+	surplus := 0
+	for skillName, level := range r.Skills {
+		surplus += skillLevels[skillName] - level
+	}
+
+	return max(surplus, 0)
+}
+
+// Rolls the quality of a crafted item. Each skill level over the requirements
+// shifts the roll 10% towards better results.
+func RollQuality(skillSurplus int) Quality {
+
+	roll := util.GetRNG(util.RNGSkills).Rand(100) + skillSurplus*10
+
+	switch {
+	case roll < 15:
+		return QualityPoor
+	case roll < 75:
+		return QualityNormal
+	case roll < 95:
+		return QualityFine
+	}
+	return QualityMasterwork
+}
+
+// Creates a single output item for the recipe.
+func (r *RecipeSpec) NewOutputItem(quality Quality) (items.Item, error) {
+
+	itm := items.New(r.Output.ItemId)
+	if itm.ItemId == 0 {
+		return itm, fmt.Errorf(`output itemid %d does not exist`, r.Output.ItemId)
+	}
+
+	if len(r.Output.Overrides) > 0 {
+		if err := applyOverrides(&itm, r.Output.Overrides); err != nil {
+			return itm, err
+		}
+	}
+
+	for _, adj := range r.Output.Adjectives {
+		itm.SetAdjective(adj, true)
+	}
+
+	if r.Quality {
+		applyQuality(&itm, quality)
+	}
+
+	return itm, nil
+}
+
+// Replaces values in the item spec with the overrides provided.
+// The keys are the same as the item yaml files.
+func applyOverrides(itm *items.Item, overrides map[string]any) error {
+
+	specBytes, err := yaml.Marshal(itm.GetSpec())
+	if err != nil {
+		return err
+	}
+
+	specData := map[string]any{}
+	if err := yaml.Unmarshal(specBytes, &specData); err != nil {
+		return err
+	}
+
+	for key, value := range overrides {
+		specData[strings.ToLower(key)] = value
+	}
+
+	if specBytes, err = yaml.Marshal(specData); err != nil {
+		return err
+	}
+
+	newSpec := items.ItemSpec{}
+	if err := yaml.Unmarshal(specBytes, &newSpec); err != nil {
+		return err
+	}
+
+	newSpec.ItemId = itm.ItemId
+	newSpec.Validate()
+
+	itm.Spec = &newSpec
+	if newSpec.Uses > 0 {
+		itm.Uses = newSpec.Uses
+	}
+
+	return nil
+}
+
+// Poor items are worth and hit less, fine and masterwork items more.
+func applyQuality(itm *items.Item, quality Quality) {
+
+	if quality == QualityNormal || quality == `` {
+		return
+	}
+
+	newSpec := itm.GetSpec()
+
+	bonus := 0
+	switch quality {
+	case QualityPoor:
+		bonus = -1
+		newSpec.Value /= 2
+	case QualityFine:
+		bonus = 1
+		newSpec.Value += newSpec.Value / 2
+	case QualityMasterwork:
+		bonus = 2
+		newSpec.Value *= 2
+	}
+
+	if newSpec.Type == items.Weapon {
+		newSpec.Damage.BonusDamage = max(newSpec.Damage.BonusDamage+bonus, 0)
+		newSpec.Damage.FormatDiceRoll()
+	} else if newSpec.Subtype == items.Wearable {
+		newSpec.DamageReduction = max(newSpec.DamageReduction+bonus, 0)
+	}
+
+	itm.Spec = &newSpec
+	itm.SetAdjective(string(quality), true)
+}
+
+// Returns a recipe by its id, or nil
+func GetRecipe(recipeId string) *RecipeSpec {
+	if r, ok := allRecipes[strings.ToLower(recipeId)]; ok {
+		return r
+	}
+	return nil
+}
+
+// Finds a recipe by id or name from a list of recipe ids.
+// Partial names are accepted if there is no exact match.
+func FindRecipe(search string, recipeIds []string) *RecipeSpec {
+
+	search = strings.ToLower(strings.TrimSpace(search))
+	if search == `` {
+		return nil
+	}
+
+	var closeMatch *RecipeSpec
+
+	for _, recipeId := range recipeIds {
+		r := GetRecipe(recipeId)
+		if r == nil {
+			continue
+		}
+
+		name := strings.ToLower(r.Name)
+		if r.RecipeId == search || name == search {
+			return r
+		}
+
+		if closeMatch == nil && (strings.HasPrefix(name, search) || strings.HasPrefix(r.RecipeId, search)) {
+			closeMatch = r
+		}
+	}
+
+	return closeMatch
+}
+
+// Returns the ids of all recipes known by someone who has learned the recipes provided.
+// Recipes that don't need to be learned are always known.
+func GetKnownRecipeIds(learned []string) []string {
+
+	known := []string{}
+
+	for recipeId, r := range allRecipes {
+		if !r.MustLearn {
+			known = append(known, recipeId)
+			continue
+		}
+		for _, learnedId := range learned {
+			if learnedId == recipeId {
+				known = append(known, recipeId)
+				break
+			}
+		}
+	}
+
+	sort.Strings(known)
+
+	return known
+}
+
+func LoadDataFiles() {
+
+	start := time.Now()
+
+	tmpRecipes, err := fileloader.LoadAllFlatFiles[string, *RecipeSpec](configs.GetFilePathsConfig().DataFiles.String() + `/recipes`)
+	if err != nil {
+		// Worlds without a recipes folder just have no recipes
+		if !os.IsNotExist(err) {
+			panic(err)
+		}
+		tmpRecipes = map[string]*RecipeSpec{}
+	}
+
+	allRecipes = tmpRecipes
+
+	mudlog.Info("recipes.LoadDataFiles()", "loadedCount", len(allRecipes), "Time Taken", time.Since(start))
+}
@@ -0,0 +1,101 @@
+package recipes
+
+import (
+	"testing"
+
+	"github.com/GoMudEngine/GoMud/internal/items"
+	"github.com/stretchr/testify/assert"
+)
+
+func setupRecipes() {
+	allRecipes = map[string]*RecipeSpec{
+		`healing-potion`:     {RecipeId: `healing-potion`, Name: `healing potion`},
+		`nightshade-draught`: {RecipeId: `nightshade-draught`, Name: `nightshade draught`, MustLearn: true},
+		`whittled-cudgel`:    {RecipeId: `whittled-cudgel`, Name: `whittled cudgel`, Skills: map[string]int{`brawling`: 2}},
+	}
+}
+
+func TestGetKnownRecipeIds(t *testing.T) {
+	setupRecipes()
+
+	assert.Equal(t, []string{`healing-potion`, `whittled-cudgel`}, GetKnownRecipeIds(nil))
+	assert.Equal(t, []string{`healing-potion`, `nightshade-draught`, `whittled-cudgel`}, GetKnownRecipeIds([]string{`nightshade-draught`}))
+	assert.Equal(t, []string{`healing-potion`, `whittled-cudgel`}, GetKnownRecipeIds([]string{`no-such-recipe`}))
+}
+
+func TestFindRecipe(t *testing.T) {
+	setupRecipes()
+
+	known := GetKnownRecipeIds(nil)
+
+	assert.Equal(t, `healing-potion`, FindRecipe(`Healing Potion`, known).RecipeId)
+	assert.Equal(t, `healing-potion`, FindRecipe(`healing-potion`, known).RecipeId)
+	assert.Equal(t, `whittled-cudgel`, FindRecipe(`whit`, known).RecipeId, "Partial names should match")
+	assert.Nil(t, FindRecipe(`nightshade draught`, known), "Unknown recipes should not be found")
+	assert.Nil(t, FindRecipe(``, known))
+}
+
+func TestSkills(t *testing.T) {
+	setupRecipes()
+
+	r := GetRecipe(`whittled-cudgel`)
+
+	assert.False(t, r.HasSkills(map[string]int{}))
+	assert.False(t, r.HasSkills(map[string]int{`brawling`: 1}))
+	assert.True(t, r.HasSkills(map[string]int{`brawling`: 2}))
+
+	assert.Equal(t, 0, r.SkillSurplus(map[string]int{`brawling`: 1}))
+	assert.Equal(t, 2, r.SkillSurplus(map[string]int{`brawling`: 4}))
+	assert.Equal(t, 0, GetRecipe(`healing-potion`).SkillSurplus(map[string]int{`brawling`: 4}))
+}
+
+func TestRollQuality(t *testing.T) {
+	for i := 0; i < 50; i++ {
+		assert.Equal(t, QualityMasterwork, RollQuality(10), "A large skill surplus should always be a masterwork")
+		assert.NotEqual(t, QualityPoor, RollQuality(2), "Two levels over the requirements should never be poor")
+	}
+}
+
+func TestApplyQuality(t *testing.T) {
+
+	newWeapon := func() items.Item {
+		return items.Item{ItemId: 1, Spec: &items.ItemSpec{
+			Type:   items.Weapon,
+			Value:  100,
+			Damage: items.Damage{Attacks: 1, DiceCount: 1, SideCount: 6},
+		}}
+	}
+
+	itm := newWeapon()
+	applyQuality(&itm, QualityNormal)
+	assert.Equal(t, 100, itm.GetSpec().Value)
+	assert.Empty(t, itm.Adjectives)
+
+	itm = newWeapon()
+	applyQuality(&itm, QualityMasterwork)
+	assert.Equal(t, 200, itm.GetSpec().Value)
+	assert.Equal(t, 2, itm.GetSpec().Damage.BonusDamage)
+	assert.Equal(t, `1d6+2`, itm.GetSpec().Damage.DiceRoll)
+	assert.True(t, itm.HasAdjective(`masterwork`))
+
+	itm = newWeapon()
+	applyQuality(&itm, QualityPoor)
+	assert.Equal(t, 50, itm.GetSpec().Value)
+	assert.Equal(t, 0, itm.GetSpec().Damage.BonusDamage, "Bonus damage should not go negative")
+
+	armor := items.Item{ItemId: 2, Spec: &items.ItemSpec{Type: items.Body, Subtype: items.Wearable, Value: 10, DamageReduction: 3}}
+	applyQuality(&armor, QualityFine)
+	assert.Equal(t, 15, armor.GetSpec().Value)
+	assert.Equal(t, 4, armor.GetSpec().DamageReduction)
+}
+
+func TestToolMatches(t *testing.T) {
+
+	dagger := items.Item{ItemId: 10004}
+	lockpicks := items.Item{ItemId: 8, Spec: &items.ItemSpec{Type: items.Lockpicks}}
+
+	assert.True(t, Tool{ItemId: 10004}.Matches(dagger))
+	assert.False(t, Tool{ItemId: 10004}.Matches(lockpicks))
+	assert.True(t, Tool{ItemType: items.Lockpicks}.Matches(lockpicks))
+	assert.False(t, Tool{ItemType: items.Lockpicks}.Matches(dagger))
+}
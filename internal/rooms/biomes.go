@@ -11,15 +11,17 @@ import (
 )
 
 type BiomeInfo struct {
-	BiomeId        string `yaml:"biomeid"`
-	Name           string `yaml:"name"`
-	Symbol         string `yaml:"symbol"`
-	Description    string `yaml:"description"`
-	DarkArea       bool   `yaml:"darkarea"`
-	LitArea        bool   `yaml:"litarea"`
-	RequiredItemId int    `yaml:"requireditemid"`
-	UsesItem       bool   `yaml:"usesitem"`
-	Burns          bool   `yaml:"burns"`
+	BiomeId        string         `yaml:"biomeid"`
+	Name           string         `yaml:"name"`
+	Symbol         string         `yaml:"symbol"`
+	Description    string         `yaml:"description"`
+	DarkArea       bool           `yaml:"darkarea"`
+	LitArea        bool           `yaml:"litarea"`
+	RequiredItemId int            `yaml:"requireditemid"`
+	UsesItem       bool           `yaml:"usesitem"`
+	Burns          bool           `yaml:"burns"`
+	Indoors        bool           `yaml:"indoors,omitempty"` // Sheltered from the weather
+	Weather        map[string]int `yaml:"weather,omitempty"` // Relative chance of each weather type. See weather.go
 
 	// Private fields for runtime use
 	symbolRune rune
@@ -48,6 +50,10 @@ func (bi *BiomeInfo) IsDark() bool {
 	return !bi.LitArea && bi.DarkArea
 }
 
+func (bi *BiomeInfo) IsIndoors() bool {
+	return bi.Indoors
+}
+
 // Implement Loadable interface
 func (bi *BiomeInfo) Id() string {
 	return strings.ToLower(bi.BiomeId)
@@ -66,6 +72,14 @@ func (bi *BiomeInfo) Validate() error {
 	if bi.DarkArea && bi.LitArea {
 		return fmt.Errorf("biome '%s' cannot be both dark and lit", bi.BiomeId)
 	}
+	for weatherType, chance := range bi.Weather {
+		if !IsWeatherType(weatherType) {
+			return fmt.Errorf("biome '%s' has unknown weather type '%s'", bi.BiomeId, weatherType)
+		}
+		if chance < 0 {
+			return fmt.Errorf("biome '%s' has a negative chance for weather '%s'", bi.BiomeId, weatherType)
+		}
+	}
 	return nil
 }
 
@@ -18,16 +18,11 @@ func (c Container) HasLock() bool {
 }
 
 func (c *Container) AddItem(i items.Item) {
-	c.Items = append(c.Items, i)
+	c.Items = items.AddToStack(c.Items, i)
 }
 
 func (c *Container) RemoveItem(i items.Item) {
-	for j := len(c.Items) - 1; j >= 0; j-- {
-		if c.Items[j].Equals(i) {
-			c.Items = append(c.Items[:j], c.Items[j+1:]...)
-			break
-		}
-	}
+	c.Items, _ = items.RemoveFromStack(c.Items, i)
 }
 
 func (c *Container) FindItem(itemName string) (items.Item, bool) {
@@ -36,11 +31,11 @@ func (c *Container) FindItem(itemName string) (items.Item, bool) {
 	closeMatchItem, matchItem := items.FindMatchIn(itemName, c.Items...)
 
 	if matchItem.ItemId != 0 {
-		return matchItem, true
+		return matchItem.Single(), true
 	}
 
 	if closeMatchItem.ItemId != 0 {
-		return closeMatchItem, true
+		return closeMatchItem.Single(), true
 	}
 
 	return items.Item{}, false
@@ -51,7 +46,7 @@ func (c *Container) FindItemById(itemId int) (items.Item, bool) {
 	// Search floor
 	for _, matchItem := range c.Items {
 		if matchItem.ItemId == itemId {
-			return matchItem, true
+			return matchItem.Single(), true
 		}
 	}
 
@@ -76,7 +71,7 @@ func (c *Container) RecipeReady() int {
 		}
 
 		for _, containsItem := range c.Items {
-			if neededItems[containsItem.ItemId] > 0 {
+			for qty := containsItem.GetQuantity(); qty > 0 && neededItems[containsItem.ItemId] > 0; qty-- {
 				neededItems[containsItem.ItemId] -= 1
 				totalNeeded--
 			}
@@ -97,7 +92,7 @@ func (c *Container) Count(itemId int) int {
 	total := 0
 	for _, containsItem := range c.Items {
 		if containsItem.ItemId == itemId {
-			total++
+			total += containsItem.GetQuantity()
 		}
 	}
 	return total
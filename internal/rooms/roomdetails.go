@@ -35,6 +35,7 @@ type RoomTemplateDetails struct {
 	IsDark         bool
 	IsNight        bool
 	TrackingString string
+	Weather        string   // Current weather if outdoors, such as "rain"
 	RoomAlerts     []string // Messages to show below room description as a special alert
 	ShowPvp        bool     // Whether to display that the room is PVP
 }
@@ -77,6 +78,7 @@ func GetDetails(r *Room, user *users.UserRecord, tinymap ...[]string) RoomTempla
 		IsDark:         b.IsDark(),
 		IsNight:        gametime.IsNight(),
 		TrackingString: ``,
+		Weather:        r.GetWeather(),
 		ShowPvp:        showPvp,
 	}
 
@@ -96,6 +98,14 @@ func GetDetails(r *Room, user *users.UserRecord, tinymap ...[]string) RoomTempla
 		details.RoomAlerts = append(details.RoomAlerts, ` <ansi fg="yellow-bold">This is an item storage location!</ansi> Type <ansi fg="command">storage</ansi> to store/unstore.`)
 	}
 
+	if len(r.CraftStations) > 0 {
+		details.RoomAlerts = append(details.RoomAlerts, `    <ansi fg="yellow-bold">You can craft here!</ansi> Type <ansi fg="command">recipes</ansi> to see what you can make.`)
+	}
+
+	if r.IsPostOffice {
+		details.RoomAlerts = append(details.RoomAlerts, `     <ansi fg="yellow-bold">This is a post office!</ansi> Type <ansi fg="command">mail</ansi> to send and collect mail.`)
+	}
+
 	if r.IsCharacterRoom {
 		details.RoomAlerts = append(details.RoomAlerts, `      <ansi fg="yellow-bold">This is a character room!</ansi> Type <ansi fg="command">character</ansi> to interact.`)
 	}
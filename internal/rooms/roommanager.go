@@ -122,6 +122,11 @@ func SetNextRoomId(nextRoomId int) {
 	configs.SetVal(`Server.NextRoomId`, strconv.Itoa(nextRoomId))
 }
 
+// Returns how many rooms are currently loaded in memory
+func GetLoadedRoomCount() int {
+	return len(roomManager.rooms)
+}
+
 func GetAllRoomIds() []int {
 
 	var roomIds []int = make([]int, len(roomManager.roomIdToFileCache))
@@ -788,6 +793,110 @@ func ConnectRoom(fromRoomId int, toRoomId int, exitName string, mapDirection ...
 	return nil
 }
 
+// Adds a fully described room to an existing zone under a new room id
+func CreateRoom(roomTpl Room) (roomId int, err error) {
+
+	if _, ok := roomManager.zones[roomTpl.Zone]; !ok {
+		return 0, fmt.Errorf(`zone %s does not exist`, roomTpl.Zone)
+	}
+
+	roomTpl.RoomId = GetNextRoomId()
+	SetNextRoomId(roomTpl.RoomId + 1)
+
+	if roomTpl.Exits == nil {
+		roomTpl.Exits = make(map[string]exit.RoomExit)
+	}
+	roomTpl.players = []int{}
+	roomTpl.mobs = []int{}
+	roomTpl.visitors = make(map[VisitorType]map[int]uint64)
+	roomTpl.tempDataStore = make(map[string]any)
+
+	if err := roomTpl.Validate(); err != nil {
+		return 0, err
+	}
+
+	addRoomToMemory(&roomTpl)
+
+	if err := SaveRoomTemplate(roomTpl); err != nil {
+		return 0, err
+	}
+
+	return roomTpl.RoomId, nil
+}
+
+// Removes a room from disk and memory.
+// Any exits in the same zone that lead to the room are removed as well.
+func DeleteRoom(roomId int) error {
+
+	if LoadRoomTemplate(roomId) == nil {
+		return fmt.Errorf(`room %d not found`, roomId)
+	}
+
+	room := LoadRoom(roomId)
+	if room == nil {
+		return fmt.Errorf(`room %d not found`, roomId)
+	}
+
+	zoneInfo, ok := roomManager.zones[room.Zone]
+	if ok && zoneInfo.RoomId == roomId {
+		return errors.New("can't delete the root room of a zone")
+	}
+
+	if len(room.players) > 0 {
+		return fmt.Errorf(`room %d has players in it`, roomId)
+	}
+
+	for _, mobInstanceId := range room.mobs {
+		mobs.DestroyInstance(mobInstanceId)
+	}
+
+	filename := roomManager.GetFilePath(roomId)
+
+	if err := os.Remove(util.FilePath(configs.GetFilePathsConfig().DataFiles.String(), `/rooms/`, filename)); err != nil {
+		return err
+	}
+	os.Remove(util.FilePath(configs.GetFilePathsConfig().DataFiles.String(), `/rooms.instances/`, filename))
+
+	ClearRoomCache(roomId)
+
+	// Exits can lead in from any zone, so check every room, loaded or not.
+	// Saving rooms modifies the zone room lists, so copy them first.
+	otherRoomIds := []int{}
+	for _, otherZone := range roomManager.zones {
+		for otherRoomId := range otherZone.RoomIds {
+			otherRoomIds = append(otherRoomIds, otherRoomId)
+		}
+	}
+
+	for _, otherRoomId := range otherRoomIds {
+
+		otherRoom := LoadRoomTemplate(otherRoomId)
+		if otherRoom == nil {
+			continue
+		}
+
+		changed := false
+		for exitName, exitInfo := range otherRoom.Exits {
+			if exitInfo.RoomId == roomId {
+				delete(otherRoom.Exits, exitName)
+				changed = true
+			}
+		}
+
+		if changed {
+			// SaveRoomTemplate expects the room to be in memory
+			LoadRoom(otherRoomId)
+			SaveRoomTemplate(*otherRoom)
+		}
+	}
+
+	if ok {
+		events.AddToQueue(events.RebuildMap{MapRootRoomId: zoneInfo.RoomId})
+	}
+
+	return nil
+}
+
 func GetRoomCount(zoneName string) int {
 
 	zoneInfo, ok := roomManager.zones[zoneName]
@@ -69,7 +69,9 @@ type Room struct {
 	MusicFile         string                            `yaml:"musicfile,omitempty"`                 // background music to play when in this room
 	IsBank            bool                              `yaml:"isbank,omitempty"`                    // Is this a bank room? If so, players can deposit/withdraw gold here.
 	IsStorage         bool                              `yaml:"isstorage,omitempty"`                 // Is this a storage room? If so, players can add/remove objects here.
+	IsPostOffice      bool                              `yaml:"ispostoffice,omitempty"`              // Is this a post office? If so, players can send mail and collect attachments here.
 	IsCharacterRoom   bool                              `yaml:"ischaracterroom,omitempty"`           // Is this a room where characters can create new characters to swap between them?
+	CraftStations     []string                          `yaml:"craftstations,omitempty"`             // Crafting stations in this room (e.g. "alchemy", "forge"). Some recipes require one.
 	Title             string                            `yaml:"title"`                               // Title shown to the user
 	Description       string                            `yaml:"description"`                         // Description shown to the user
 	MapSymbol         string                            `yaml:"mapsymbol,omitempty"`                 // The symbol to use when generating a map of the zone
@@ -149,6 +151,9 @@ func (r *Room) GetVisibility() int {
 		}
 	}
 
+	// Fog and storms make it harder to see
+	visibility += WeatherLightMod(r.GetWeather())
+
 	// Apply any mutators
 	for mut := range r.ActiveMutators {
 		spec := mut.GetSpec()
@@ -821,9 +826,9 @@ func (r *Room) AddItem(item items.Item, stash bool) {
 	item.Validate()
 
 	if stash {
-		r.Stash = append(r.Stash, item)
+		r.Stash = items.AddToStack(r.Stash, item)
 	} else {
-		r.Items = append(r.Items, item)
+		r.Items = items.AddToStack(r.Items, item)
 	}
 
 }
@@ -905,7 +910,7 @@ func (r *Room) GetRandomExit() (exitName string, roomId int) {
 		}
 	}
 
-	roomSelection := util.Rand(len(allExits))
+	roomSelection := util.GetRNG(util.RNGIdle).Rand(len(allExits))
 
 	for exitName, roomId := range allExits {
 		if roomSelection == 0 {
@@ -920,19 +925,9 @@ func (r *Room) GetRandomExit() (exitName string, roomId int) {
 func (r *Room) RemoveItem(i items.Item, stash bool) {
 
 	if stash {
-		for j := len(r.Stash) - 1; j >= 0; j-- {
-			if r.Stash[j].Equals(i) {
-				r.Stash = append(r.Stash[:j], r.Stash[j+1:]...)
-				break
-			}
-		}
+		r.Stash, _ = items.RemoveFromStack(r.Stash, i)
 	} else {
-		for j := len(r.Items) - 1; j >= 0; j-- {
-			if r.Items[j].Equals(i) {
-				r.Items = append(r.Items[:j], r.Items[j+1:]...)
-				break
-			}
-		}
+		r.Items, _ = items.RemoveFromStack(r.Items, i)
 	}
 
 }
@@ -1009,11 +1004,11 @@ func (r *Room) FindOnFloor(itemName string, stash bool) (items.Item, bool) {
 		closeMatchItem, matchItem := items.FindMatchIn(itemName, r.Stash...)
 
 		if matchItem.ItemId != 0 {
-			return matchItem, true
+			return matchItem.Single(), true
 		}
 
 		if closeMatchItem.ItemId != 0 {
-			return closeMatchItem, true
+			return closeMatchItem.Single(), true
 		}
 
 		return items.Item{}, false
@@ -1023,16 +1018,24 @@ func (r *Room) FindOnFloor(itemName string, stash bool) (items.Item, bool) {
 	closeMatchItem, matchItem := items.FindMatchIn(itemName, r.Items...)
 
 	if matchItem.ItemId != 0 {
-		return matchItem, true
+		return matchItem.Single(), true
 	}
 
 	if closeMatchItem.ItemId != 0 {
-		return closeMatchItem, true
+		return closeMatchItem.Single(), true
 	}
 
 	return items.Item{}, false
 }
 
+// How many of an item found on the floor are in its stack
+func (r *Room) CountOnFloor(i items.Item, stash bool) int {
+	if stash {
+		return items.QuantityIn(i, r.Stash...)
+	}
+	return items.QuantityIn(i, r.Items...)
+}
+
 func (r *Room) MarkVisited(id int, vType VisitorType, subtrackTurns ...int) {
 
 	if r.visitors == nil {
@@ -2009,6 +2012,8 @@ func (r *Room) RoundTick() {
 	// Done adding mutator buffs
 	//
 
+	r.applyWeatherBuffs()
+
 	for idx, spawnInfo := range r.SpawnInfo {
 
 		// Make sure to clean up any instances that may be dead
@@ -2251,6 +2256,16 @@ func (r *Room) GetBiome() *BiomeInfo {
 	return bInfo
 }
 
+// Whether the room has a crafting station, such as "alchemy" or "forge"
+func (r *Room) HasCraftStation(station string) bool {
+	for _, s := range r.CraftStations {
+		if strings.EqualFold(s, station) {
+			return true
+		}
+	}
+	return false
+}
+
 func (r *Room) ActiveMutators(yield func(mutators.Mutator) bool) {
 
 	var activeMutators mutators.MutatorList
@@ -12,6 +12,7 @@ import (
 	"github.com/GoMudEngine/GoMud/internal/events"
 	"github.com/GoMudEngine/GoMud/internal/fileloader"
 	"github.com/GoMudEngine/GoMud/internal/items"
+	"github.com/GoMudEngine/GoMud/internal/metrics"
 	"github.com/GoMudEngine/GoMud/internal/mudlog"
 	"github.com/GoMudEngine/GoMud/internal/util"
 	"gopkg.in/yaml.v2"
@@ -329,6 +330,10 @@ func loadRoomFromFile(roomFilePath string) (*Room, error) {
 	return roomPtr, err
 }
 
+var (
+	saveAllSeconds = metrics.NewHistogram(`gomud_save_duration_seconds`, `Time taken to save everything of a kind.`, nil, `what`, `rooms`)
+)
+
 func SaveAllRooms() error {
 
 	start := time.Now()
@@ -349,6 +354,7 @@ func SaveAllRooms() error {
 	}
 
 	mudlog.Info("SaveAllRooms()", "savedCount", saveCt, "expectedCt", len(roomManager.rooms), "errorCount", errCt, "Time Taken", time.Since(start))
+	saveAllSeconds.ObserveSince(start)
 
 	return nil
 }
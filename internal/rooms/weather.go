@@ -0,0 +1,296 @@
+package rooms
+
+import (
+	"sort"
+
+	"github.com/GoMudEngine/GoMud/internal/buffs"
+	"github.com/GoMudEngine/GoMud/internal/configs"
+	"github.com/GoMudEngine/GoMud/internal/gametime"
+	"github.com/GoMudEngine/GoMud/internal/users"
+	"github.com/GoMudEngine/GoMud/internal/util"
+)
+
+//
+// Each zone has its own weather, picked using the weights of the zone biome
+// and adjusted for the season. Rooms with an indoors biome are sheltered.
+//
+
+const (
+	WeatherNone  = ``      // Indoors, or weather is disabled
+	WeatherClear = `clear` // Nothing special
+	WeatherRain  = `rain`
+	WeatherSnow  = `snow`  // Cold
+	WeatherStorm = `storm` // Reduces visibility. Cold in winter.
+	WeatherFog   = `fog`   // Reduces visibility
+	WeatherHeat  = `heat`  // Hot
+)
+
+var (
+	// All weather types, in a fixed order so rolls are repeatable
+	weatherTypes = []string{WeatherClear, WeatherRain, WeatherSnow, WeatherStorm, WeatherFog, WeatherHeat}
+
+	// Used for biomes that don't define any weather
+	defaultWeatherWeights = map[string]int{
+		WeatherClear: 60,
+		WeatherRain:  20,
+		WeatherFog:   10,
+		WeatherStorm: 5,
+		WeatherSnow:  5,
+	}
+
+	// zone name => weather
+	zoneWeather = map[string]*ZoneWeather{}
+)
+
+type ZoneWeather struct {
+	Weather         string
+	PreviousWeather string
+	ChangedRound    uint64
+	NextChangeRound uint64
+}
+
+// Describes a zone whose weather just changed
+type WeatherChange struct {
+	Zone            string
+	Weather         string
+	PreviousWeather string
+}
+
+func IsWeatherType(weatherType string) bool {
+	for _, w := range weatherTypes {
+		if w == weatherType {
+			return true
+		}
+	}
+	return false
+}
+
+// How much the weather changes visibility (see GetVisibility)
+func WeatherLightMod(weatherType string) int {
+	switch weatherType {
+	case WeatherFog, WeatherStorm:
+		return -1
+	}
+	return 0
+}
+
+// The buff (if any) exposed players get from the weather,
+// and the buff flag that protects them from it.
+func WeatherBuff(weatherType string, season string) (int, buffs.Flag) {
+
+	weatherConfig := configs.GetWeatherConfig()
+
+	switch weatherType {
+	case WeatherSnow:
+		return int(weatherConfig.ColdBuffId), buffs.Warmed
+	case WeatherStorm:
+		if season == gametime.SeasonWinter {
+			return int(weatherConfig.ColdBuffId), buffs.Warmed
+		}
+	case WeatherHeat:
+		return int(weatherConfig.HeatBuffId), buffs.Hydrated
+	}
+
+	return 0, buffs.All
+}
+
+// Biome weights adjusted for the season.
+// Winter turns half the rain to snow and has no heat.
+// Summer turns half the snow to rain and doubles heat.
+func GetWeatherWeights(biome *BiomeInfo, season string) map[string]int {
+
+	if biome == nil || biome.IsIndoors() {
+		return map[string]int{}
+	}
+
+	weights := map[string]int{}
+
+	source := biome.Weather
+	if len(source) == 0 {
+		source = defaultWeatherWeights
+	}
+
+	for weatherType, chance := range source {
+		weights[weatherType] = chance
+	}
+
+	switch season {
+	case gametime.SeasonWinter:
+		moved := weights[WeatherRain] / 2
+		weights[WeatherRain] -= moved
+		weights[WeatherSnow] += moved
+		weights[WeatherClear] += weights[WeatherHeat]
+		weights[WeatherHeat] = 0
+	case gametime.SeasonSpring:
+		weights[WeatherRain] += weights[WeatherRain] / 2
+	case gametime.SeasonSummer:
+		moved := weights[WeatherSnow] / 2
+		weights[WeatherSnow] -= moved
+		weights[WeatherRain] += moved
+		weights[WeatherHeat] *= 2
+	case gametime.SeasonAutumn:
+		weights[WeatherFog] += weights[WeatherFog] / 2
+	}
+
+	return weights
+}
+
+// Picks a weather type using weights. Returns WeatherClear if there are none.
+func rollWeather(weights map[string]int) string {
+
+	total := 0
+	for _, weatherType := range weatherTypes {
+		total += weights[weatherType]
+	}
+
+	if total < 1 {
+		return WeatherClear
+	}
+
+	roll := util.Rand(total)
+	for _, weatherType := range weatherTypes {
+		if roll < weights[weatherType] {
+			return weatherType
+		}
+		roll -= weights[weatherType]
+	}
+
+	return WeatherClear
+}
+
+// The biome that decides a zone's weather: its default biome, or the biome of its root room.
+func getZoneWeatherBiome(zoneName string) *BiomeInfo {
+
+	zoneConfig := GetZoneConfig(zoneName)
+	if zoneConfig == nil {
+		return nil
+	}
+
+	if zoneConfig.DefaultBiome != `` {
+		if b, ok := GetBiome(zoneConfig.DefaultBiome); ok {
+			return b
+		}
+	}
+
+	if rootRoom := LoadRoom(zoneConfig.RoomId); rootRoom != nil {
+		return rootRoom.GetBiome()
+	}
+
+	return nil
+}
+
+// Advances the weather of every zone. Returns the zones that changed.
+// Zones seen for the first time get weather without reporting a change.
+// Indoors zones have WeatherNone.
+func UpdateWeather(roundNumber uint64) []WeatherChange {
+
+	weatherConfig := configs.GetWeatherConfig()
+
+	if !weatherConfig.Enabled {
+		clear(zoneWeather)
+		return nil
+	}
+
+	changeRounds := int(weatherConfig.ChangeRounds)
+	season := gametime.GetDate(roundNumber).Season()
+
+	changes := []WeatherChange{}
+
+	zoneNames := GetAllZoneNames()
+	sort.Strings(zoneNames)
+
+	for _, zoneName := range zoneNames {
+
+		zw, ok := zoneWeather[zoneName]
+		if ok && roundNumber < zw.NextChangeRound {
+			continue
+		}
+
+		nextChange := roundNumber + uint64(changeRounds/2+util.Rand(changeRounds))
+
+		// Zones that are indoors are checked again later, in case their biome changes
+		newWeather := WeatherNone
+		if biome := getZoneWeatherBiome(zoneName); biome != nil && !biome.IsIndoors() {
+			newWeather = rollWeather(GetWeatherWeights(biome, season))
+		}
+
+		if !ok {
+			zoneWeather[zoneName] = &ZoneWeather{
+				Weather:         newWeather,
+				ChangedRound:    roundNumber,
+				NextChangeRound: nextChange,
+			}
+			continue
+		}
+
+		zw.NextChangeRound = nextChange
+
+		if newWeather == zw.Weather {
+			continue
+		}
+
+		zw.PreviousWeather = zw.Weather
+		zw.Weather = newWeather
+		zw.ChangedRound = roundNumber
+
+		changes = append(changes, WeatherChange{
+			Zone:            zoneName,
+			Weather:         zw.Weather,
+			PreviousWeather: zw.PreviousWeather,
+		})
+	}
+
+	return changes
+}
+
+// Current weather of a zone, or WeatherNone
+func GetZoneWeather(zoneName string) string {
+	if zw, ok := zoneWeather[zoneName]; ok {
+		return zw.Weather
+	}
+	return WeatherNone
+}
+
+// Whether the room is exposed to the weather
+func (r *Room) IsOutdoors() bool {
+	return !r.GetBiome().IsIndoors()
+}
+
+// Weather in this room, or WeatherNone if sheltered
+func (r *Room) GetWeather() string {
+
+	if !r.IsOutdoors() {
+		return WeatherNone
+	}
+
+	return GetZoneWeather(r.Zone)
+}
+
+// Gives players exposed to cold or hot weather the matching buff,
+// unless something protects them or they already have it.
+func (r *Room) applyWeatherBuffs() {
+
+	weather := r.GetWeather()
+	if weather == WeatherNone || len(r.players) == 0 {
+		return
+	}
+
+	buffId, protectedBy := WeatherBuff(weather, gametime.GetDate().Season())
+	if buffId == 0 || buffs.GetBuffSpec(buffId) == nil {
+		return
+	}
+
+	for _, uid := range r.GetPlayers() {
+
+		u := users.GetByUserId(uid)
+		if u == nil {
+			continue
+		}
+
+		if u.Character.HasBuff(buffId) || u.Character.HasBuffFlag(protectedBy) {
+			continue
+		}
+
+		u.AddBuff(buffId, `weather`)
+	}
+}
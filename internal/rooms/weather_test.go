@@ -0,0 +1,66 @@
+package rooms
+
+import (
+	"testing"
+
+	"github.com/GoMudEngine/GoMud/internal/gametime"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetWeatherWeights_Indoors(t *testing.T) {
+	biome := &BiomeInfo{Indoors: true, Weather: map[string]int{WeatherRain: 50}}
+
+	assert.Empty(t, GetWeatherWeights(biome, gametime.SeasonSpring), "Indoor biomes should have no weather")
+	assert.Empty(t, GetWeatherWeights(nil, gametime.SeasonSpring), "A missing biome should have no weather")
+}
+
+func TestGetWeatherWeights_DefaultsWhenUndefined(t *testing.T) {
+	weights := GetWeatherWeights(&BiomeInfo{}, gametime.SeasonSpring)
+
+	assert.Equal(t, defaultWeatherWeights[WeatherClear], weights[WeatherClear])
+	assert.Greater(t, weights[WeatherRain], 0)
+}
+
+func TestGetWeatherWeights_Seasons(t *testing.T) {
+	biome := &BiomeInfo{Weather: map[string]int{
+		WeatherClear: 40,
+		WeatherRain:  20,
+		WeatherSnow:  10,
+		WeatherFog:   10,
+		WeatherHeat:  10,
+	}}
+
+	winter := GetWeatherWeights(biome, gametime.SeasonWinter)
+	assert.Equal(t, 10, winter[WeatherRain], "Winter should turn half the rain to snow")
+	assert.Equal(t, 20, winter[WeatherSnow])
+	assert.Equal(t, 0, winter[WeatherHeat], "Winter should have no heat")
+	assert.Equal(t, 50, winter[WeatherClear], "Winter heat should become clear skies")
+
+	summer := GetWeatherWeights(biome, gametime.SeasonSummer)
+	assert.Equal(t, 5, summer[WeatherSnow], "Summer should turn half the snow to rain")
+	assert.Equal(t, 25, summer[WeatherRain])
+	assert.Equal(t, 20, summer[WeatherHeat], "Summer should double the heat")
+
+	// The biome itself must not be modified
+	assert.Equal(t, 20, biome.Weather[WeatherRain])
+}
+
+func TestRollWeather(t *testing.T) {
+	assert.Equal(t, WeatherClear, rollWeather(map[string]int{}), "No weights should always be clear")
+
+	for i := 0; i < 50; i++ {
+		assert.Equal(t, WeatherFog, rollWeather(map[string]int{WeatherFog: 10}))
+	}
+
+	for i := 0; i < 50; i++ {
+		w := rollWeather(map[string]int{WeatherRain: 1, WeatherSnow: 1})
+		assert.Contains(t, []string{WeatherRain, WeatherSnow}, w)
+	}
+}
+
+func TestWeatherLightMod(t *testing.T) {
+	assert.Equal(t, -1, WeatherLightMod(WeatherFog))
+	assert.Equal(t, -1, WeatherLightMod(WeatherStorm))
+	assert.Equal(t, 0, WeatherLightMod(WeatherRain))
+	assert.Equal(t, 0, WeatherLightMod(WeatherNone))
+}
@@ -1,6 +1,10 @@
 package rooms
 
 import (
+	"os"
+	"strings"
+
+	"github.com/GoMudEngine/GoMud/internal/configs"
 	"github.com/GoMudEngine/GoMud/internal/mutators"
 	"github.com/GoMudEngine/GoMud/internal/util"
 )
@@ -21,7 +25,7 @@ type ZoneConfig struct {
 
 // Generates a random number between min and max
 func (z *ZoneConfig) GenerateRandomLevel() int {
-	return util.Rand(z.MobAutoScale.Maximum-z.MobAutoScale.Minimum) + z.MobAutoScale.Minimum
+	return util.GetRNG(util.RNGSpawn).Rand(z.MobAutoScale.Maximum-z.MobAutoScale.Minimum) + z.MobAutoScale.Minimum
 }
 
 func (z *ZoneConfig) Id() string {
@@ -65,6 +69,25 @@ func (z *ZoneConfig) Filepath() string {
 	return util.FilePath(zone, `/`, z.Filename())
 }
 
+func (z *ZoneConfig) GetScript() string {
+
+	scriptPath := z.GetScriptPath()
+
+	// Load the script into a string
+	if _, err := os.Stat(scriptPath); err == nil {
+		if bytes, err := os.ReadFile(scriptPath); err == nil {
+			return string(bytes)
+		}
+	}
+
+	return ``
+}
+
+// Zone scripts live beside the zone config, as zone-config.js
+func (z *ZoneConfig) GetScriptPath() string {
+	return strings.Replace(configs.GetFilePathsConfig().DataFiles.String()+`/rooms/`+z.Filepath(), `.yaml`, `.js`, 1)
+}
+
 func NewZoneConfig(zName string) *ZoneConfig {
 	return &ZoneConfig{
 		Name:    zName,
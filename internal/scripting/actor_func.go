@@ -8,10 +8,12 @@ import (
 	"github.com/GoMudEngine/GoMud/internal/combat"
 	"github.com/GoMudEngine/GoMud/internal/configs"
 	"github.com/GoMudEngine/GoMud/internal/events"
+	"github.com/GoMudEngine/GoMud/internal/items"
 	"github.com/GoMudEngine/GoMud/internal/mobs"
 	"github.com/GoMudEngine/GoMud/internal/parties"
 	"github.com/GoMudEngine/GoMud/internal/pets"
 	"github.com/GoMudEngine/GoMud/internal/races"
+	"github.com/GoMudEngine/GoMud/internal/recipes"
 	"github.com/GoMudEngine/GoMud/internal/rooms"
 	"github.com/GoMudEngine/GoMud/internal/skills"
 	"github.com/GoMudEngine/GoMud/internal/templates"
@@ -294,6 +296,28 @@ func (a ScriptActor) AddHealth(amt int) int {
 	return ret
 }
 
+// Deals damage of an element, adjusted by the actors resistance to it.
+// Returns how much damage was actually dealt.
+func (a ScriptActor) TakeDamage(amt int, element string) int {
+
+	if amt < 1 {
+		return 0
+	}
+
+	dmg := a.characterRecord.ApplyResistance(amt, items.Element(strings.ToLower(element)))
+
+	if dmg > 0 {
+		a.AddHealth(dmg * -1)
+	}
+
+	return dmg
+}
+
+// Gets the % of damage from an element that is resisted (negative is a vulnerability)
+func (a ScriptActor) GetResistance(element string) int {
+	return a.characterRecord.GetResistance(items.Element(strings.ToLower(element)))
+}
+
 func (a ScriptActor) AddMana(amt int) int {
 	ret := a.characterRecord.ApplyManaChange(amt)
 
@@ -531,7 +555,10 @@ func (a ScriptActor) HasItemId(itemId int, excludeWorn ...bool) bool {
 func (a ScriptActor) GetBackpackItems() []ScriptItem {
 	itms := make([]ScriptItem, 0, 5)
 	for _, item := range a.characterRecord.GetAllBackpackItems() {
-		itms = append(itms, newScriptItem(item))
+		// Scripts see each item in a stack on its own
+		for i := 0; i < item.GetQuantity(); i++ {
+			itms = append(itms, newScriptItem(item.Single()))
+		}
 	}
 	return itms
 }
@@ -556,6 +583,30 @@ func (a ScriptActor) LearnSpell(spellId string) bool {
 	return a.characterRecord.LearnSpell(spellId)
 }
 
+func (a ScriptActor) LearnRecipe(recipeId string) bool {
+
+	if recipes.GetRecipe(recipeId) == nil {
+		return false
+	}
+
+	if !a.characterRecord.LearnRecipe(recipeId) {
+		return false
+	}
+
+	if a.userId > 0 {
+		events.AddToQueue(events.RecipeLearned{
+			UserId:   a.userId,
+			RecipeId: recipeId,
+		})
+	}
+
+	return true
+}
+
+func (a ScriptActor) HasRecipe(recipeId string) bool {
+	return a.characterRecord.HasLearnedRecipe(recipeId)
+}
+
 func (a ScriptActor) IsAggro(actor ScriptActor) bool {
 	return a.characterRecord.IsAggro(actor.UserId(), actor.InstanceId())
 }
@@ -45,7 +45,7 @@ func TryBuffScriptEvent(eventName string, userId int, mobInstanceId int, buffId
 		roomTextWrap.Set(`buff-text`, ``, `cyan`, colorpatterns.Stretch)
 
 		tmr := time.AfterFunc(scriptRoomTimeout, func() {
-			vmw.VM.Interrupt(errTimeout)
+			interruptTimeout(vmw.VM, `buff`)
 		})
 
 		res, err := onCommandFunc(goja.Undefined(),
@@ -65,14 +65,14 @@ func TryBuffScriptEvent(eventName string, userId int, mobInstanceId int, buffId
 			finalErr := fmt.Errorf("%s(): %w", eventName, err)
 
 			if _, ok := finalErr.(*goja.Exception); ok {
-				mudlog.Error("JSVM", "exception", finalErr)
+				logScriptError(vmw.VM, "exception", finalErr)
 				return false, finalErr
 			} else if errors.Is(finalErr, errTimeout) {
-				mudlog.Error("JSVM", "interrupted", finalErr)
+				logScriptError(vmw.VM, "interrupted", finalErr)
 				return false, finalErr
 			}
 
-			mudlog.Error("JSVM", "error", finalErr)
+			logScriptError(vmw.VM, "error", finalErr)
 			return false, finalErr
 		}
 
@@ -102,7 +102,7 @@ func TryBuffCommand(cmd string, rest string, userId int, mobInstanceId int, buff
 	if onCommandFunc, ok := vmw.GetFunction(`onCommand_` + cmd); ok {
 
 		tmr := time.AfterFunc(scriptRoomTimeout, func() {
-			vmw.VM.Interrupt(errTimeout)
+			interruptTimeout(vmw.VM, `buff`)
 		})
 		res, err := onCommandFunc(goja.Undefined(),
 			vmw.VM.ToValue(rest),
@@ -118,14 +118,14 @@ func TryBuffCommand(cmd string, rest string, userId int, mobInstanceId int, buff
 			finalErr := fmt.Errorf("onCommand_%s(): %w", cmd, err)
 
 			if _, ok := finalErr.(*goja.Exception); ok {
-				mudlog.Error("JSVM", "exception", finalErr)
+				logScriptError(vmw.VM, "exception", finalErr)
 				return false, finalErr
 			} else if errors.Is(finalErr, errTimeout) {
-				mudlog.Error("JSVM", "interrupted", finalErr)
+				logScriptError(vmw.VM, "interrupted", finalErr)
 				return false, finalErr
 			}
 
-			mudlog.Error("JSVM", "error", finalErr)
+			logScriptError(vmw.VM, "error", finalErr)
 			return false, finalErr
 		}
 
@@ -139,7 +139,7 @@ func TryBuffCommand(cmd string, rest string, userId int, mobInstanceId int, buff
 		sRoom := GetRoom(sActor.GetRoomId())
 
 		tmr := time.AfterFunc(scriptRoomTimeout, func() {
-			vmw.VM.Interrupt(errTimeout)
+			interruptTimeout(vmw.VM, `buff`)
 		})
 		res, err := onCommandFunc(goja.Undefined(),
 			vmw.VM.ToValue(cmd),
@@ -156,14 +156,14 @@ func TryBuffCommand(cmd string, rest string, userId int, mobInstanceId int, buff
 			finalErr := fmt.Errorf("onCommand(): %w", err)
 
 			if _, ok := finalErr.(*goja.Exception); ok {
-				mudlog.Error("JSVM", "exception", finalErr)
+				logScriptError(vmw.VM, "exception", finalErr)
 				return false, finalErr
 			} else if errors.Is(finalErr, errTimeout) {
-				mudlog.Error("JSVM", "interrupted", finalErr)
+				logScriptError(vmw.VM, "interrupted", finalErr)
 				return false, finalErr
 			}
 
-			mudlog.Error("JSVM", "error", finalErr)
+			logScriptError(vmw.VM, "error", finalErr)
 			return false, finalErr
 		}
 
@@ -196,11 +196,12 @@ func getBuffVM(buffId int) (*VMWrapper, error) {
 	}
 
 	vm := goja.New()
-	setAllScriptingFunctions(vm)
+	setAllScriptingFunctions(vm, scriptRef{Type: `buff`, Id: buffId})
 
 	prg, err := goja.Compile(fmt.Sprintf(`buff-%d`, buffId), script, false)
 	if err != nil {
 		finalErr := fmt.Errorf("Compile: %w", err)
+		logScriptError(vm, "error", finalErr)
 		return nil, finalErr
 	}
 
@@ -208,7 +209,7 @@ func getBuffVM(buffId int) (*VMWrapper, error) {
 	// Run the program
 	//
 	tmr := time.AfterFunc(scriptLoadTimeout, func() {
-		vm.Interrupt(errTimeout)
+		interruptTimeout(vm, `buff`)
 	})
 	if _, err = vm.RunProgram(prg); err != nil {
 
@@ -216,14 +217,14 @@ func getBuffVM(buffId int) (*VMWrapper, error) {
 		finalErr := fmt.Errorf("RunProgram: %w", err)
 
 		if _, ok := finalErr.(*goja.Exception); ok {
-			mudlog.Error("JSVM", "exception", finalErr)
+			logScriptError(vm, "exception", finalErr)
 			return nil, finalErr
 		} else if errors.Is(finalErr, errTimeout) {
-			mudlog.Error("JSVM", "interrupted", finalErr)
+			logScriptError(vm, "interrupted", finalErr)
 			return nil, finalErr
 		}
 
-		mudlog.Error("JSVM", "error", finalErr)
+		logScriptError(vm, "error", finalErr)
 		return nil, finalErr
 	}
 	vm.ClearInterrupt()
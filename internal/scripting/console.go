@@ -1,30 +1,40 @@
 package scripting
 
 import (
+	"fmt"
+
 	"github.com/GoMudEngine/GoMud/internal/mudlog"
 	"github.com/dop251/goja"
 )
 
-type console struct{}
+// Console output goes to the log, and to anyone watching the script
+type console struct {
+	script scriptRef
+}
 
 func (c *console) log(msg any) {
 	mudlog.Info(`JSVM`, `msg`, msg)
+	debugOutput(c.script, `log`, fmt.Sprint(msg))
 }
 func (c *console) info(msg any) {
 	mudlog.Info(`JSVM`, `msg`, msg)
+	debugOutput(c.script, `info`, fmt.Sprint(msg))
 }
 func (c *console) debug(msg any) {
 	mudlog.Debug(`JSVM`, `msg`, msg)
+	debugOutput(c.script, `debug`, fmt.Sprint(msg))
 }
 func (c *console) warn(msg any) {
 	mudlog.Warn(`JSVM`, `msg`, msg)
+	debugOutput(c.script, `warn`, fmt.Sprint(msg))
 }
 func (c *console) error(msg any) {
 	mudlog.Error(`JSVM`, `msg`, msg)
+	debugOutput(c.script, `error`, fmt.Sprint(msg))
 }
 
 func newConsole(vm *goja.Runtime) *goja.Object {
-	c := &console{}
+	c := &console{script: vmScripts[vm]}
 	obj := vm.NewObject()
 	obj.Set(`log`, c.log)
 	obj.Set(`info`, c.info)
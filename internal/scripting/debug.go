@@ -0,0 +1,280 @@
+package scripting
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/GoMudEngine/GoMud/internal/mudlog"
+	"github.com/GoMudEngine/GoMud/internal/rooms"
+	"github.com/GoMudEngine/GoMud/internal/users"
+	"github.com/dop251/goja"
+)
+
+var (
+	// Which script each VM was created for, so output and errors can be traced back to it
+	vmScripts = map[*goja.Runtime]scriptRef{}
+
+	// userId => what they are watching, such as "room-1", "zone-Frostfang" or "all"
+	debugWatchers = map[int]map[string]struct{}{}
+
+	debugColors = map[string]string{
+		`debug`:       `13`,
+		`log`:         `2`,
+		`info`:        `2`,
+		`warn`:        `11`,
+		`error`:       `1`,
+		`exception`:   `1`,
+		`interrupted`: `1`,
+	}
+)
+
+// Starts sending script output and errors to a user.
+// scriptType is room, zone, mob, item, buff, spell or all
+func WatchScripts(userId int, scriptType string, id string) error {
+
+	watchKey := `all`
+
+	switch scriptType {
+	case `all`:
+	case `room`, `mob`, `item`, `buff`:
+		if _, err := strconv.Atoi(id); err != nil {
+			return fmt.Errorf("invalid %s id: %s", scriptType, id)
+		}
+		watchKey = scriptType + `-` + id
+	case `zone`:
+		zoneConfig := rooms.GetZoneConfig(rooms.FindZoneName(id))
+		if zoneConfig == nil {
+			return fmt.Errorf("zone not found: %s", id)
+		}
+		watchKey = `zone-` + zoneConfig.Name
+	case `spell`:
+		watchKey = `spell-` + id
+	default:
+		return fmt.Errorf("unknown script type: %s", scriptType)
+	}
+
+	if debugWatchers[userId] == nil {
+		debugWatchers[userId] = map[string]struct{}{}
+	}
+	debugWatchers[userId][watchKey] = struct{}{}
+
+	return nil
+}
+
+// Stops sending script output and errors to a user
+func UnwatchScripts(userId int) {
+	delete(debugWatchers, userId)
+}
+
+// Returns what a user is watching, sorted
+func GetWatchedScripts(userId int) []string {
+	watching := []string{}
+	for watchKey := range debugWatchers[userId] {
+		watching = append(watching, watchKey)
+	}
+	sort.Strings(watching)
+	return watching
+}
+
+// Whether a watch key covers a script.
+// A zone covers its zone script and the scripts of every room in it.
+func watchMatches(watchKey string, script scriptRef) bool {
+
+	if watchKey == `all` {
+		return true
+	}
+
+	switch script.Type {
+	case `room`:
+		if watchKey == script.String() {
+			return true
+		}
+		if room := rooms.LoadRoom(script.Id); room != nil {
+			return watchKey == `zone-`+room.Zone
+		}
+	case `mob`:
+		// Any script tag of the mob
+		return watchKey == fmt.Sprintf(`mob-%d`, script.Id)
+	default:
+		return watchKey == script.String()
+	}
+
+	return false
+}
+
+// Sends a line of script output to everyone watching the script
+func debugOutput(script scriptRef, level string, msg string) {
+
+	if len(debugWatchers) == 0 {
+		return
+	}
+
+	color, ok := debugColors[level]
+	if !ok {
+		color = `2`
+	}
+
+	// goja stack traces mark native frames as <native>, which would otherwise be read as an ansi tag
+	msg = strings.ReplaceAll(strings.TrimSpace(msg), `<native>`, `native`)
+	prefix := fmt.Sprintf(`<ansi fg="8">[%s]</ansi> <ansi fg="%s">%s:</ansi> `, script.String(), color, level)
+
+	for userId, watching := range debugWatchers {
+
+		user := users.GetByUserId(userId)
+		if user == nil {
+			delete(debugWatchers, userId)
+			continue
+		}
+
+		for watchKey := range watching {
+			if watchMatches(watchKey, script) {
+				for _, line := range strings.Split(msg, "\n") {
+					user.SendText(prefix + line)
+				}
+				break
+			}
+		}
+	}
+}
+
+// Logs a script error, and sends it to anyone watching the script
+func logScriptError(vm *goja.Runtime, kind string, err error) {
+
+	mudlog.Error("JSVM", kind, err)
+
+	script, ok := vmScripts[vm]
+	if !ok {
+		return
+	}
+
+	// Error() only includes the innermost line number.
+	// Exceptions and interrupts carry the full stack, worth showing when the call went deeper.
+	msg := err.Error()
+	stack := ``
+
+	var exception *goja.Exception
+	var interrupted *goja.InterruptedError
+	if errors.As(err, &exception) {
+		_, stack, _ = strings.Cut(exception.String(), "\n")
+	} else if errors.As(err, &interrupted) {
+		_, stack, _ = strings.Cut(interrupted.String(), "\n")
+	}
+
+	if stack = strings.TrimRight(stack, "\n"); strings.Contains(stack, "\n") {
+		msg = msg + "\n" + stack
+	}
+
+	debugOutput(script, kind, msg)
+}
+
+// Forgets VMs that are no longer cached
+func pruneVMScripts() {
+
+	cached := map[*goja.Runtime]struct{}{}
+	for _, cache := range []map[string]*VMWrapper{mobVMCache, itemVMCache, spellVMCache, zoneVMCache} {
+		for _, vmw := range cache {
+			if vmw != nil {
+				cached[vmw.VM] = struct{}{}
+			}
+		}
+	}
+	for _, cache := range []map[int]*VMWrapper{roomVMCache, buffVMCache} {
+		for _, vmw := range cache {
+			if vmw != nil {
+				cached[vmw.VM] = struct{}{}
+			}
+		}
+	}
+
+	for vm := range vmScripts {
+		if _, ok := cached[vm]; !ok {
+			delete(vmScripts, vm)
+		}
+	}
+}
+
+// Runs code inside a room's VM, and returns the result
+func EvalRoom(roomId int, code string) (string, error) {
+
+	vmw, err := getRoomVM(roomId)
+	if err != nil {
+		return ``, err
+	}
+
+	return evalVM(vmw, `room`, code)
+}
+
+// Runs code inside the VM of a mob's script, and returns the result
+func EvalMob(mobInstanceId int, code string) (string, error) {
+
+	sMob := GetActor(0, mobInstanceId)
+	if sMob == nil {
+		return ``, fmt.Errorf("mob not found: #%d", mobInstanceId)
+	}
+
+	vmw, err := getMobVM(sMob)
+	if err != nil {
+		return ``, err
+	}
+
+	return evalVM(vmw, `mob`, code)
+}
+
+func evalVM(vmw *VMWrapper, scriptType string, code string) (string, error) {
+
+	tmr := time.AfterFunc(scriptLoadTimeout, func() {
+		interruptTimeout(vmw.VM, scriptType)
+	})
+	res, err := vmw.VM.RunString(code)
+	vmw.VM.ClearInterrupt()
+	tmr.Stop()
+
+	if err != nil {
+		return ``, err
+	}
+
+	// Functions defined by the snippet would otherwise be missed by the function cache
+	clear(vmw.callableCache)
+	vmw.cacheSize = 0
+
+	if res == nil {
+		return `undefined`, nil
+	}
+
+	if obj, ok := res.(*goja.Object); ok && obj.ClassName() != `Function` {
+		if b, err := obj.MarshalJSON(); err == nil {
+			return string(b), nil
+		}
+	}
+
+	return res.String(), nil
+}
+
+// Drops a room's cached VM, so its script is reloaded from disk the next time it runs
+func ReloadRoomScript(roomId int) {
+	PruneRoomVMs(roomId)
+}
+
+// Drops the cached VMs for every script tag of a mob
+func ReloadMobScript(mobId int) {
+	prefix := fmt.Sprintf(`%d-`, mobId)
+	for scriptId := range mobVMCache {
+		if strings.HasPrefix(scriptId, prefix) {
+			delete(mobVMCache, scriptId)
+		}
+	}
+}
+
+// Drops an item's cached VM
+func ReloadItemScript(itemId int) {
+	delete(itemVMCache, strconv.Itoa(itemId))
+}
+
+// Drops a zone's cached VM
+func ReloadZoneScript(zoneName string) {
+	delete(zoneVMCache, zoneName)
+}
@@ -0,0 +1,112 @@
+package scripting
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/GoMudEngine/GoMud/internal/configs"
+	"github.com/GoMudEngine/GoMud/internal/mudlog"
+	"github.com/GoMudEngine/GoMud/internal/rooms"
+	"github.com/dop251/goja"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWatchMatches(t *testing.T) {
+
+	mudlog.SetupLogger(nil, `ERROR`, ``, false)
+
+	dataFiles := t.TempDir()
+	configs.AddOverlayOverrides(map[string]any{
+		`FilePaths.DataFiles`: dataFiles,
+		`Server.NextRoomId`:   100,
+	})
+	os.MkdirAll(filepath.Join(dataFiles, `rooms`), os.ModePerm)
+	os.MkdirAll(filepath.Join(dataFiles, `rooms.instances`), os.ModePerm)
+
+	roomId, err := rooms.CreateZone(`Watch Test`)
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	roomScript := scriptRef{Type: `room`, Id: roomId}
+	roomKey := `room-` + strconv.Itoa(roomId)
+
+	tests := []struct {
+		watchKey string
+		script   scriptRef
+		want     bool
+	}{
+		{`all`, roomScript, true},
+		{`all`, scriptRef{Type: `spell`, Name: `heal`}, true},
+
+		{roomKey, roomScript, true},
+		{`room-1`, roomScript, false},
+
+		// A zone covers every room in it, as well as the zone script
+		{`zone-Watch Test`, roomScript, true},
+		{`zone-Frostfang`, roomScript, false},
+		{`zone-Watch Test`, scriptRef{Type: `zone`, Name: `Watch Test`}, true},
+		{`zone-Watch`, scriptRef{Type: `zone`, Name: `Watch Test`}, false},
+
+		// A mob covers every script tag
+		{`mob-5`, scriptRef{Type: `mob`, Id: 5}, true},
+		{`mob-5`, scriptRef{Type: `mob`, Id: 5, Name: `guard`}, true},
+		{`mob-5`, scriptRef{Type: `mob`, Id: 50}, false},
+
+		{`spell-heal`, scriptRef{Type: `spell`, Name: `heal`}, true},
+		{`spell-heal`, scriptRef{Type: `spell`, Name: `healall`}, false},
+
+		{`item-3`, scriptRef{Type: `item`, Id: 3}, true},
+		{`buff-3`, scriptRef{Type: `item`, Id: 3}, false},
+	}
+
+	for _, tt := range tests {
+		assert.Equal(t, tt.want, watchMatches(tt.watchKey, tt.script), "%s watching %s", tt.watchKey, tt.script)
+	}
+}
+
+func TestEvalRoom(t *testing.T) {
+
+	mudlog.SetupLogger(nil, `ERROR`, ``, false)
+
+	oldTimeout := scriptLoadTimeout
+	scriptLoadTimeout = 50 * time.Millisecond
+
+	// Stands in for a loaded room script, so no room has to exist
+	roomId := -1
+	vm := goja.New()
+	setAllScriptingFunctions(vm, scriptRef{Type: `room`, Id: roomId})
+	roomVMCache[roomId] = newVMWrapper(vm, 100)
+
+	t.Cleanup(func() {
+		scriptLoadTimeout = oldTimeout
+		delete(roomVMCache, roomId)
+		delete(vmScripts, vm)
+	})
+
+	result, err := EvalRoom(roomId, `var counter = 1 + 2; counter`)
+	assert.NoError(t, err)
+	assert.Equal(t, `3`, result)
+
+	result, err = EvalRoom(roomId, `({counter: counter})`)
+	assert.NoError(t, err)
+	assert.Equal(t, `{"counter":3}`, result)
+
+	_, err = EvalRoom(roomId, `throw new Error("boom")`)
+	var exception *goja.Exception
+	assert.True(t, errors.As(err, &exception))
+	assert.ErrorContains(t, err, `boom`)
+
+	_, err = EvalRoom(roomId, `while (true) {}`)
+	var interrupted *goja.InterruptedError
+	assert.True(t, errors.As(err, &interrupted))
+
+	// The VM is still usable after being interrupted
+	result, err = EvalRoom(roomId, `counter`)
+	assert.NoError(t, err)
+	assert.Equal(t, `3`, result)
+}
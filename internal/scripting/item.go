@@ -44,7 +44,7 @@ func TryItemScriptEvent(eventName string, item items.Item, userId int) (bool, er
 		sRoom := GetRoom(sUser.GetRoomId())
 
 		tmr := time.AfterFunc(scriptItemTimeout, func() {
-			vmw.VM.Interrupt(errTimeout)
+			interruptTimeout(vmw.VM, `item`)
 		})
 		res, err := onCommandFunc(goja.Undefined(),
 			vmw.VM.ToValue(sUser),
@@ -60,14 +60,14 @@ func TryItemScriptEvent(eventName string, item items.Item, userId int) (bool, er
 			finalErr := fmt.Errorf("%s(): %w", eventName, err)
 
 			if _, ok := finalErr.(*goja.Exception); ok {
-				mudlog.Error("JSVM", "exception", finalErr)
+				logScriptError(vmw.VM, "exception", finalErr)
 				return false, finalErr
 			} else if errors.Is(finalErr, errTimeout) {
-				mudlog.Error("JSVM", "interrupted", finalErr)
+				logScriptError(vmw.VM, "interrupted", finalErr)
 				return false, finalErr
 			}
 
-			mudlog.Error("JSVM", "error", finalErr)
+			logScriptError(vmw.VM, "error", finalErr)
 			return false, finalErr
 		}
 
@@ -105,7 +105,7 @@ func TryItemCommand(cmd string, item items.Item, userId int) (bool, error) {
 		sRoom := GetRoom(sUser.GetRoomId())
 
 		tmr := time.AfterFunc(scriptItemTimeout, func() {
-			vmw.VM.Interrupt(errTimeout)
+			interruptTimeout(vmw.VM, `item`)
 		})
 		res, err := onCommandFunc(goja.Undefined(),
 			vmw.VM.ToValue(sUser),
@@ -121,14 +121,14 @@ func TryItemCommand(cmd string, item items.Item, userId int) (bool, error) {
 			finalErr := fmt.Errorf("onCommand_%s(): %w", cmd, err)
 
 			if _, ok := finalErr.(*goja.Exception); ok {
-				mudlog.Error("JSVM", "exception", finalErr)
+				logScriptError(vmw.VM, "exception", finalErr)
 				return false, finalErr
 			} else if errors.Is(finalErr, errTimeout) {
-				mudlog.Error("JSVM", "interrupted", finalErr)
+				logScriptError(vmw.VM, "interrupted", finalErr)
 				return false, finalErr
 			}
 
-			mudlog.Error("JSVM", "error", finalErr)
+			logScriptError(vmw.VM, "error", finalErr)
 			return false, finalErr
 		}
 
@@ -145,7 +145,7 @@ func TryItemCommand(cmd string, item items.Item, userId int) (bool, error) {
 		sRoom := GetRoom(sUser.GetRoomId())
 
 		tmr := time.AfterFunc(scriptItemTimeout, func() {
-			vmw.VM.Interrupt(errTimeout)
+			interruptTimeout(vmw.VM, `item`)
 		})
 		res, err := onCommandFunc(goja.Undefined(),
 			vmw.VM.ToValue(cmd),
@@ -162,14 +162,14 @@ func TryItemCommand(cmd string, item items.Item, userId int) (bool, error) {
 			finalErr := fmt.Errorf("onCommand(): %w", err)
 
 			if _, ok := finalErr.(*goja.Exception); ok {
-				mudlog.Error("JSVM", "exception", finalErr)
+				logScriptError(vmw.VM, "exception", finalErr)
 				return false, finalErr
 			} else if errors.Is(finalErr, errTimeout) {
-				mudlog.Error("JSVM", "interrupted", finalErr)
+				logScriptError(vmw.VM, "interrupted", finalErr)
 				return false, finalErr
 			}
 
-			mudlog.Error("JSVM", "error", finalErr)
+			logScriptError(vmw.VM, "error", finalErr)
 			return false, finalErr
 		}
 
@@ -203,11 +203,12 @@ func getItemVM(sItem *ScriptItem) (*VMWrapper, error) {
 	}
 
 	vm := goja.New()
-	setAllScriptingFunctions(vm)
+	setAllScriptingFunctions(vm, scriptRef{Type: `item`, Id: sItem.ItemId()})
 
 	prg, err := goja.Compile(fmt.Sprintf(`item-%s`, scriptId), script, false)
 	if err != nil {
 		finalErr := fmt.Errorf("Compile: %w", err)
+		logScriptError(vm, "error", finalErr)
 		return nil, finalErr
 	}
 
@@ -215,7 +216,7 @@ func getItemVM(sItem *ScriptItem) (*VMWrapper, error) {
 	// Run the program
 	//
 	tmr := time.AfterFunc(scriptLoadTimeout, func() {
-		vm.Interrupt(errTimeout)
+		interruptTimeout(vm, `item`)
 	})
 	if _, err = vm.RunProgram(prg); err != nil {
 
@@ -223,14 +224,14 @@ func getItemVM(sItem *ScriptItem) (*VMWrapper, error) {
 		finalErr := fmt.Errorf("RunProgram: %w", err)
 
 		if _, ok := finalErr.(*goja.Exception); ok {
-			mudlog.Error("JSVM", "exception", finalErr)
+			logScriptError(vm, "exception", finalErr)
 			return nil, finalErr
 		} else if errors.Is(finalErr, errTimeout) {
-			mudlog.Error("JSVM", "interrupted", finalErr)
+			logScriptError(vm, "interrupted", finalErr)
 			return nil, finalErr
 		}
 
-		mudlog.Error("JSVM", "error", finalErr)
+		logScriptError(vm, "error", finalErr)
 		return nil, finalErr
 	}
 	vm.ClearInterrupt()
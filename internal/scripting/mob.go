@@ -46,7 +46,7 @@ func TryPlayerDownedEvent(mobInstanceId int, downedPlayerId int) (bool, error) {
 	if onCommandFunc, ok := vmw.GetFunction(`onPlayerDowned`); ok {
 
 		tmr := time.AfterFunc(scriptRoomTimeout, func() {
-			vmw.VM.Interrupt(errTimeout)
+			interruptTimeout(vmw.VM, `mob`)
 		})
 
 		sRoom := GetRoom(sMob.GetRoomId())
@@ -65,14 +65,14 @@ func TryPlayerDownedEvent(mobInstanceId int, downedPlayerId int) (bool, error) {
 			finalErr := fmt.Errorf("%s(): %w", `onPlayerDowned`, err)
 
 			if _, ok := finalErr.(*goja.Exception); ok {
-				mudlog.Error("JSVM", "exception", finalErr)
+				logScriptError(vmw.VM, "exception", finalErr)
 				return false, finalErr
 			} else if errors.Is(finalErr, errTimeout) {
-				mudlog.Error("JSVM", "interrupted", finalErr)
+				logScriptError(vmw.VM, "interrupted", finalErr)
 				return false, finalErr
 			}
 
-			mudlog.Error("JSVM", "error", finalErr)
+			logScriptError(vmw.VM, "error", finalErr)
 			return false, finalErr
 		}
 
@@ -105,7 +105,7 @@ func TryMobScriptEvent(eventName string, mobInstanceId int, sourceId int, source
 	if onCommandFunc, ok := vmw.GetFunction(eventName); ok {
 
 		tmr := time.AfterFunc(scriptRoomTimeout, func() {
-			vmw.VM.Interrupt(errTimeout)
+			interruptTimeout(vmw.VM, `mob`)
 		})
 
 		if details == nil {
@@ -131,14 +131,14 @@ func TryMobScriptEvent(eventName string, mobInstanceId int, sourceId int, source
 			finalErr := fmt.Errorf("%s(): %w", eventName, err)
 
 			if _, ok := finalErr.(*goja.Exception); ok {
-				mudlog.Error("JSVM", "exception", finalErr)
+				logScriptError(vmw.VM, "exception", finalErr)
 				return false, finalErr
 			} else if errors.Is(finalErr, errTimeout) {
-				mudlog.Error("JSVM", "interrupted", finalErr)
+				logScriptError(vmw.VM, "interrupted", finalErr)
 				return false, finalErr
 			}
 
-			mudlog.Error("JSVM", "error", finalErr)
+			logScriptError(vmw.VM, "error", finalErr)
 			return false, finalErr
 		}
 
@@ -178,7 +178,7 @@ func TryMobCommand(cmd string, rest string, mobInstanceId int, sourceId int, sou
 		sRoom := GetRoom(sMob.mobRecord.Character.RoomId)
 
 		tmr := time.AfterFunc(scriptRoomTimeout, func() {
-			vmw.VM.Interrupt(errTimeout)
+			interruptTimeout(vmw.VM, `mob`)
 		})
 		res, err := onCommandFunc(goja.Undefined(),
 			vmw.VM.ToValue(rest),
@@ -195,14 +195,14 @@ func TryMobCommand(cmd string, rest string, mobInstanceId int, sourceId int, sou
 			finalErr := fmt.Errorf("onCommand_%s(): %w", cmd, err)
 
 			if _, ok := finalErr.(*goja.Exception); ok {
-				mudlog.Error("JSVM", "exception", finalErr)
+				logScriptError(vmw.VM, "exception", finalErr)
 				return false, finalErr
 			} else if errors.Is(finalErr, errTimeout) {
-				mudlog.Error("JSVM", "interrupted", finalErr)
+				logScriptError(vmw.VM, "interrupted", finalErr)
 				return false, finalErr
 			}
 
-			mudlog.Error("JSVM", "error", finalErr)
+			logScriptError(vmw.VM, "error", finalErr)
 			return false, finalErr
 		}
 
@@ -220,7 +220,7 @@ func TryMobCommand(cmd string, rest string, mobInstanceId int, sourceId int, sou
 		sRoom := GetRoom(sMob.GetRoomId())
 
 		tmr := time.AfterFunc(scriptRoomTimeout, func() {
-			vmw.VM.Interrupt(errTimeout)
+			interruptTimeout(vmw.VM, `mob`)
 		})
 		res, err := onCommandFunc(goja.Undefined(),
 			vmw.VM.ToValue(cmd),
@@ -238,14 +238,14 @@ func TryMobCommand(cmd string, rest string, mobInstanceId int, sourceId int, sou
 			finalErr := fmt.Errorf("onCommand(): %w", err)
 
 			if _, ok := finalErr.(*goja.Exception); ok {
-				mudlog.Error("JSVM", "exception", finalErr)
+				logScriptError(vmw.VM, "exception", finalErr)
 				return false, finalErr
 			} else if errors.Is(finalErr, errTimeout) {
-				mudlog.Error("JSVM", "interrupted", finalErr)
+				logScriptError(vmw.VM, "interrupted", finalErr)
 				return false, finalErr
 			}
 
-			mudlog.Error("JSVM", "error", finalErr)
+			logScriptError(vmw.VM, "error", finalErr)
 			return false, finalErr
 		}
 
@@ -275,11 +275,12 @@ func getMobVM(mobActor *ScriptActor) (*VMWrapper, error) {
 	}
 
 	vm := goja.New()
-	setAllScriptingFunctions(vm)
+	setAllScriptingFunctions(vm, scriptRef{Type: `mob`, Id: mobActor.MobTypeId(), Name: mobActor.getScriptTag()})
 
 	prg, err := goja.Compile(fmt.Sprintf(`mob-%s`, scriptId), script, false)
 	if err != nil {
 		finalErr := fmt.Errorf("Compile: %w", err)
+		logScriptError(vm, "error", finalErr)
 		return nil, finalErr
 	}
 
@@ -287,7 +288,7 @@ func getMobVM(mobActor *ScriptActor) (*VMWrapper, error) {
 	// Run the program
 	//
 	tmr := time.AfterFunc(scriptLoadTimeout, func() {
-		vm.Interrupt(errTimeout)
+		interruptTimeout(vm, `mob`)
 	})
 	if _, err = vm.RunProgram(prg); err != nil {
 
@@ -295,14 +296,14 @@ func getMobVM(mobActor *ScriptActor) (*VMWrapper, error) {
 		finalErr := fmt.Errorf("RunProgram: %w", err)
 
 		if _, ok := finalErr.(*goja.Exception); ok {
-			mudlog.Error("JSVM", "exception", finalErr)
+			logScriptError(vm, "exception", finalErr)
 			return nil, finalErr
 		} else if errors.Is(finalErr, errTimeout) {
-			mudlog.Error("JSVM", "interrupted", finalErr)
+			logScriptError(vm, "interrupted", finalErr)
 			return nil, finalErr
 		}
 
-		mudlog.Error("JSVM", "error", finalErr)
+		logScriptError(vm, "error", finalErr)
 		return nil, finalErr
 	}
 	vm.ClearInterrupt()
@@ -312,7 +313,7 @@ func getMobVM(mobActor *ScriptActor) (*VMWrapper, error) {
 	// Run onLoad() function
 	//
 	tmr = time.AfterFunc(scriptLoadTimeout, func() {
-		vm.Interrupt(errTimeout)
+		interruptTimeout(vm, `mob`)
 	})
 	if fn, ok := goja.AssertFunction(vm.Get(`onLoad`)); ok {
 
@@ -321,14 +322,14 @@ func getMobVM(mobActor *ScriptActor) (*VMWrapper, error) {
 			finalErr := fmt.Errorf("onLoad: %w", err)
 
 			if _, ok := finalErr.(*goja.Exception); ok {
-				mudlog.Error("JSVM", "exception", finalErr)
+				logScriptError(vm, "exception", finalErr)
 				return nil, finalErr
 			} else if errors.Is(finalErr, errTimeout) {
-				mudlog.Error("JSVM", "interrupted", finalErr)
+				logScriptError(vm, "interrupted", finalErr)
 				return nil, finalErr
 			}
 
-			mudlog.Error("JSVM", "error", finalErr)
+			logScriptError(vm, "error", finalErr)
 			return nil, finalErr
 		}
 	}
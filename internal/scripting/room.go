@@ -68,7 +68,7 @@ func TryRoomScriptEvent(eventName string, userId int, roomId int) (bool, error)
 		sRoom := GetRoom(roomId)
 
 		tmr := time.AfterFunc(scriptRoomTimeout, func() {
-			vmw.VM.Interrupt(errTimeout)
+			interruptTimeout(vmw.VM, `room`)
 		})
 
 		res, err := onCommandFunc(goja.Undefined(),
@@ -88,14 +88,14 @@ func TryRoomScriptEvent(eventName string, userId int, roomId int) (bool, error)
 			finalErr := fmt.Errorf("%s(): %w", eventName, err)
 
 			if _, ok := finalErr.(*goja.Exception); ok {
-				mudlog.Error("JSVM", "exception", finalErr)
+				logScriptError(vmw.VM, "exception", finalErr)
 				return false, finalErr
 			} else if errors.Is(finalErr, errTimeout) {
-				mudlog.Error("JSVM", "interrupted", finalErr)
+				logScriptError(vmw.VM, "interrupted", finalErr)
 				return false, finalErr
 			}
 
-			mudlog.Error("JSVM", "error", finalErr)
+			logScriptError(vmw.VM, "error", finalErr)
 			return false, finalErr
 		}
 
@@ -128,7 +128,7 @@ func TryRoomIdleEvent(roomId int) (bool, error) {
 		sRoom := GetRoom(roomId)
 
 		tmr := time.AfterFunc(scriptRoomTimeout, func() {
-			vmw.VM.Interrupt(errTimeout)
+			interruptTimeout(vmw.VM, `room`)
 		})
 
 		res, err := onCommandFunc(goja.Undefined(),
@@ -147,14 +147,14 @@ func TryRoomIdleEvent(roomId int) (bool, error) {
 			finalErr := fmt.Errorf("TryRoomIdleEvent(): %w", err)
 
 			if _, ok := finalErr.(*goja.Exception); ok {
-				mudlog.Error("JSVM", "exception", finalErr)
+				logScriptError(vmw.VM, "exception", finalErr)
 				return false, finalErr
 			} else if errors.Is(finalErr, errTimeout) {
-				mudlog.Error("JSVM", "interrupted", finalErr)
+				logScriptError(vmw.VM, "interrupted", finalErr)
 				return false, finalErr
 			}
 
-			mudlog.Error("JSVM", "error", finalErr)
+			logScriptError(vmw.VM, "error", finalErr)
 			return false, finalErr
 		}
 
@@ -189,14 +189,31 @@ func TryRoomCommand(cmd string, rest string, userId int) (bool, error) {
 		}
 	}
 
-	vmw, err := getRoomVM(user.Character.RoomId)
+	handled, err := tryRoomVMCommand(cmd, altCmd, rest, userId, user.Character.RoomId)
+	if handled {
+		return true, err
+	}
+
+	// Anything the room script doesn't handle falls back to the zone script
+	if room != nil {
+		if zoneHandled, zoneErr := tryZoneCommand(cmd, altCmd, rest, userId, room.RoomId, room.Zone); zoneHandled {
+			return true, zoneErr
+		}
+	}
+
+	return handled, err
+}
+
+func tryRoomVMCommand(cmd string, altCmd string, rest string, userId int, roomId int) (bool, error) {
+
+	vmw, err := getRoomVM(roomId)
 	if err != nil {
 		return false, err
 	}
 
 	timestart := time.Now()
 	defer func() {
-		mudlog.Debug("TryRoomCommand()", "cmd", cmd, "roomId", user.Character.RoomId, "time", time.Since(timestart))
+		mudlog.Debug("TryRoomCommand()", "cmd", cmd, "roomId", roomId, "time", time.Since(timestart))
 	}()
 
 	onCommandFunc, cmdFound := vmw.GetFunction(`onCommand_` + cmd)
@@ -211,10 +228,10 @@ func TryRoomCommand(cmd string, rest string, userId int) (bool, error) {
 		roomTextWrap.Set(`script-text`, ``, ``)
 
 		sUser := GetUser(userId)
-		sRoom := GetRoom(user.Character.RoomId)
+		sRoom := GetRoom(roomId)
 
 		tmr := time.AfterFunc(scriptRoomTimeout, func() {
-			vmw.VM.Interrupt(errTimeout)
+			interruptTimeout(vmw.VM, `room`)
 		})
 		res, err := onCommandFunc(goja.Undefined(),
 			vmw.VM.ToValue(rest),
@@ -233,14 +250,14 @@ func TryRoomCommand(cmd string, rest string, userId int) (bool, error) {
 			finalErr := fmt.Errorf("onCommand_%s(): %w", cmd, err)
 
 			if _, ok := finalErr.(*goja.Exception); ok {
-				mudlog.Error("JSVM", "exception", finalErr)
+				logScriptError(vmw.VM, "exception", finalErr)
 				return false, finalErr
 			} else if errors.Is(finalErr, errTimeout) {
-				mudlog.Error("JSVM", "interrupted", finalErr)
+				logScriptError(vmw.VM, "interrupted", finalErr)
 				return false, finalErr
 			}
 
-			mudlog.Error("JSVM", "error", finalErr)
+			logScriptError(vmw.VM, "error", finalErr)
 			return false, finalErr
 		}
 
@@ -255,10 +272,10 @@ func TryRoomCommand(cmd string, rest string, userId int) (bool, error) {
 		roomTextWrap.Set(`script-text`, ``, ``)
 
 		sUser := GetUser(userId)
-		sRoom := GetRoom(user.Character.RoomId)
+		sRoom := GetRoom(roomId)
 
 		tmr := time.AfterFunc(scriptRoomTimeout, func() {
-			vmw.VM.Interrupt(errTimeout)
+			interruptTimeout(vmw.VM, `room`)
 		})
 		res, err := onCommandFunc(goja.Undefined(),
 			vmw.VM.ToValue(cmd),
@@ -278,14 +295,14 @@ func TryRoomCommand(cmd string, rest string, userId int) (bool, error) {
 			finalErr := fmt.Errorf("onCommand(): %w", err)
 
 			if _, ok := finalErr.(*goja.Exception); ok {
-				mudlog.Error("JSVM", "exception", finalErr)
+				logScriptError(vmw.VM, "exception", finalErr)
 				return false, finalErr
 			} else if errors.Is(finalErr, errTimeout) {
-				mudlog.Error("JSVM", "interrupted", finalErr)
+				logScriptError(vmw.VM, "interrupted", finalErr)
 				return false, finalErr
 			}
 
-			mudlog.Error("JSVM", "error", finalErr)
+			logScriptError(vmw.VM, "error", finalErr)
 			return false, finalErr
 		}
 
@@ -318,11 +335,12 @@ func getRoomVM(roomId int) (*VMWrapper, error) {
 	}
 
 	vm := goja.New()
-	setAllScriptingFunctions(vm)
+	setAllScriptingFunctions(vm, scriptRef{Type: `room`, Id: roomId})
 
 	prg, err := goja.Compile(fmt.Sprintf(`room-%d`, roomId), script, false)
 	if err != nil {
 		finalErr := fmt.Errorf("Compile: %w", err)
+		logScriptError(vm, "error", finalErr)
 		return nil, finalErr
 	}
 
@@ -330,7 +348,7 @@ func getRoomVM(roomId int) (*VMWrapper, error) {
 	// Run the program
 	//
 	tmr := time.AfterFunc(scriptLoadTimeout, func() {
-		vm.Interrupt(errTimeout)
+		interruptTimeout(vm, `room`)
 	})
 	if _, err = vm.RunProgram(prg); err != nil {
 
@@ -338,14 +356,14 @@ func getRoomVM(roomId int) (*VMWrapper, error) {
 		finalErr := fmt.Errorf("RunProgram: %w", err)
 
 		if _, ok := finalErr.(*goja.Exception); ok {
-			mudlog.Error("JSVM", "exception", finalErr)
+			logScriptError(vm, "exception", finalErr)
 			return nil, finalErr
 		} else if errors.Is(finalErr, errTimeout) {
-			mudlog.Error("JSVM", "interrupted", finalErr)
+			logScriptError(vm, "interrupted", finalErr)
 			return nil, finalErr
 		}
 
-		mudlog.Error("JSVM", "error", finalErr)
+		logScriptError(vm, "error", finalErr)
 		return nil, finalErr
 	}
 	vm.ClearInterrupt()
@@ -355,7 +373,7 @@ func getRoomVM(roomId int) (*VMWrapper, error) {
 	// Run onLoad() function
 	//
 	tmr = time.AfterFunc(scriptLoadTimeout, func() {
-		vm.Interrupt(errTimeout)
+		interruptTimeout(vm, `room`)
 	})
 	if fn, ok := goja.AssertFunction(vm.Get(`onLoad`)); ok {
 
@@ -366,14 +384,14 @@ func getRoomVM(roomId int) (*VMWrapper, error) {
 			finalErr := fmt.Errorf("onLoad: %w", err)
 
 			if _, ok := finalErr.(*goja.Exception); ok {
-				mudlog.Error("JSVM", "exception", finalErr)
+				logScriptError(vm, "exception", finalErr)
 				return nil, finalErr
 			} else if errors.Is(finalErr, errTimeout) {
-				mudlog.Error("JSVM", "interrupted", finalErr)
+				logScriptError(vm, "interrupted", finalErr)
 				return nil, finalErr
 			}
 
-			mudlog.Error("JSVM", "error", finalErr)
+			logScriptError(vm, "error", finalErr)
 			return nil, finalErr
 		}
 	}
@@ -5,6 +5,7 @@ import (
 	"time"
 
 	"github.com/GoMudEngine/GoMud/internal/colorpatterns"
+	"github.com/GoMudEngine/GoMud/internal/metrics"
 	"github.com/dop251/goja"
 )
 
@@ -79,8 +80,62 @@ var (
 	// If non empty, will wrap output to users or rooms in this style
 	userTextWrap = TextWrapperStyle{}
 	roomTextWrap = TextWrapperStyle{}
+
+	scriptTimeouts = map[string]*metrics.Counter{}
 )
 
+func init() {
+	for _, scriptType := range []string{`room`, `mob`, `item`, `buff`, `spell`, `zone`} {
+		scriptTimeouts[scriptType] = metrics.NewCounter(`gomud_script_timeouts_total`, `Scripts interrupted for running longer than their timeout.`, `type`, scriptType)
+	}
+}
+
+// Stops a script that has run out of time
+func interruptTimeout(vm *goja.Runtime, scriptType string) {
+	if c, ok := scriptTimeouts[scriptType]; ok {
+		c.Inc()
+	}
+	vm.Interrupt(errTimeout)
+}
+
+// Returns how many script VMs are loaded, by script type
+func GetVMCounts() map[string]int {
+	counts := map[string]int{}
+
+	for _, vmw := range roomVMCache {
+		if vmw != nil {
+			counts[`room`]++
+		}
+	}
+	for _, vmw := range mobVMCache {
+		if vmw != nil {
+			counts[`mob`]++
+		}
+	}
+	for _, vmw := range itemVMCache {
+		if vmw != nil {
+			counts[`item`]++
+		}
+	}
+	for _, vmw := range buffVMCache {
+		if vmw != nil {
+			counts[`buff`]++
+		}
+	}
+	for _, vmw := range spellVMCache {
+		if vmw != nil {
+			counts[`spell`]++
+		}
+	}
+	for _, vmw := range zoneVMCache {
+		if vmw != nil {
+			counts[`zone`]++
+		}
+	}
+
+	return counts
+}
+
 func Setup(scriptLoadTimeoutMs int, scriptRoomTimeoutMs int) {
 
 	scriptLoadTimeout = time.Duration(scriptLoadTimeoutMs) * time.Millisecond
@@ -91,16 +146,21 @@ func Setup(scriptLoadTimeoutMs int, scriptRoomTimeoutMs int) {
 	scriptItemTimeout = t
 	scriptMobTimeout = t
 	scriptSpellTimeout = t
+	scriptZoneTimeout = t
 }
 
-func setAllScriptingFunctions(vm *goja.Runtime) {
+func setAllScriptingFunctions(vm *goja.Runtime, script scriptRef) {
+	vmScripts[vm] = script
+
 	setMessagingFunctions(vm)
 	setRoomFunctions(vm)
+	setZoneFunctions(vm)
 	setActorFunctions(vm)
 	setSpellFunctions(vm)
 	setItemFunctions(vm)
 	setUtilFunctions(vm)
 	setModuleFunctions(vm)
+	setTimerFunctions(vm, script)
 }
 
 func PruneVMs(forceClear ...bool) {
@@ -111,12 +171,16 @@ func PruneVMs(forceClear ...bool) {
 		ClearBuffVMs()
 		ClearItemVMs()
 		ClearSpellVMs()
+		ClearZoneVMs()
 	} else {
 		PruneRoomVMs()
 		PruneMobVMs()
 		PruneBuffVMs()
 		PruneItemVMs()
 		PruneSpellVMs()
+		PruneZoneVMs()
 	}
 
+	pruneVMScripts()
+
 }
@@ -115,7 +115,7 @@ func TrySpellScriptEvent(eventName string, sourceUserId int, sourceMobInstanceId
 		}
 
 		tmr := time.AfterFunc(scriptItemTimeout, func() {
-			vmw.VM.Interrupt(errTimeout)
+			interruptTimeout(vmw.VM, `spell`)
 		})
 		res, err := onCommandFunc(goja.Undefined(),
 			vmw.VM.ToValue(sourceActor),
@@ -134,14 +134,14 @@ func TrySpellScriptEvent(eventName string, sourceUserId int, sourceMobInstanceId
 			finalErr := fmt.Errorf("%s(): %w", eventName, err)
 
 			if _, ok := finalErr.(*goja.Exception); ok {
-				mudlog.Error("JSVM", "exception", finalErr)
+				logScriptError(vmw.VM, "exception", finalErr)
 				return false, finalErr
 			} else if errors.Is(finalErr, errTimeout) {
-				mudlog.Error("JSVM", "interrupted", finalErr)
+				logScriptError(vmw.VM, "interrupted", finalErr)
 				return false, finalErr
 			}
 
-			mudlog.Error("JSVM", "error", finalErr)
+			logScriptError(vmw.VM, "error", finalErr)
 			return false, finalErr
 		}
 
@@ -176,11 +176,12 @@ func getSpellVM(scriptId string) (*VMWrapper, error) {
 	}
 
 	vm := goja.New()
-	setAllScriptingFunctions(vm)
+	setAllScriptingFunctions(vm, scriptRef{Type: `spell`, Name: scriptId})
 
 	prg, err := goja.Compile(fmt.Sprintf(`spell-%s`, scriptId), script, false)
 	if err != nil {
 		finalErr := fmt.Errorf("Compile: %w", err)
+		logScriptError(vm, "error", finalErr)
 		return nil, finalErr
 	}
 
@@ -188,7 +189,7 @@ func getSpellVM(scriptId string) (*VMWrapper, error) {
 	// Run the program
 	//
 	tmr := time.AfterFunc(scriptLoadTimeout, func() {
-		vm.Interrupt(errTimeout)
+		interruptTimeout(vm, `spell`)
 	})
 	if _, err = vm.RunProgram(prg); err != nil {
 
@@ -196,14 +197,14 @@ func getSpellVM(scriptId string) (*VMWrapper, error) {
 		finalErr := fmt.Errorf("RunProgram: %w", err)
 
 		if _, ok := finalErr.(*goja.Exception); ok {
-			mudlog.Error("JSVM", "exception", finalErr)
+			logScriptError(vm, "exception", finalErr)
 			return nil, finalErr
 		} else if errors.Is(finalErr, errTimeout) {
-			mudlog.Error("JSVM", "interrupted", finalErr)
+			logScriptError(vm, "interrupted", finalErr)
 			return nil, finalErr
 		}
 
-		mudlog.Error("JSVM", "error", finalErr)
+		logScriptError(vm, "error", finalErr)
 		return nil, finalErr
 	}
 	vm.ClearInterrupt()
@@ -0,0 +1,210 @@
+package scripting
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/GoMudEngine/GoMud/internal/gametime"
+	"github.com/GoMudEngine/GoMud/internal/items"
+	"github.com/GoMudEngine/GoMud/internal/mobs"
+	"github.com/GoMudEngine/GoMud/internal/mudlog"
+	"github.com/GoMudEngine/GoMud/internal/util"
+	"github.com/dop251/goja"
+)
+
+const (
+	// Protects against a script that schedules timers in a loop
+	maxScriptTimers = 10000
+)
+
+var (
+	timerCounter = 0
+	scriptTimers = map[int]*scriptTimer{}
+)
+
+// Identifies a script without holding on to its VM, so it can be found again after the VM is pruned
+type scriptRef struct {
+	Type string // room, mob, item, buff, spell or zone
+	Id   int    // room id, mob id, item id or buff id
+	Name string // mob script tag, spell id or zone name
+}
+
+func (s scriptRef) String() string {
+	if s.Type == `spell` || s.Type == `zone` {
+		return fmt.Sprintf(`%s-%s`, s.Type, s.Name)
+	}
+	if s.Name != `` {
+		return fmt.Sprintf(`%s-%d-%s`, s.Type, s.Id, s.Name)
+	}
+	return fmt.Sprintf(`%s-%d`, s.Type, s.Id)
+}
+
+// Gets (or loads) the VM for the script
+func (s scriptRef) getVM() (*VMWrapper, error) {
+	switch s.Type {
+	case `room`:
+		return getRoomVM(s.Id)
+	case `mob`:
+		mobSpec := mobs.GetMobSpec(mobs.MobId(s.Id))
+		if mobSpec == nil {
+			return nil, fmt.Errorf("mob not found: %d", s.Id)
+		}
+		mobSpec.ScriptTag = s.Name
+		return getMobVM(&ScriptActor{mobRecord: mobSpec, characterRecord: &mobSpec.Character})
+	case `item`:
+		sItem := newScriptItem(items.New(s.Id))
+		return getItemVM(&sItem)
+	case `buff`:
+		return getBuffVM(s.Id)
+	case `spell`:
+		return getSpellVM(s.Name)
+	case `zone`:
+		return getZoneVM(s.Name)
+	}
+	return nil, fmt.Errorf("unknown script type: %s", s.Type)
+}
+
+func (s scriptRef) timeout() time.Duration {
+	switch s.Type {
+	case `mob`:
+		return scriptMobTimeout
+	case `item`:
+		return scriptItemTimeout
+	case `buff`:
+		return scriptBuffTimeout
+	case `spell`:
+		return scriptSpellTimeout
+	case `zone`:
+		return scriptZoneTimeout
+	}
+	return scriptRoomTimeout
+}
+
+type scriptTimer struct {
+	id       int
+	script   scriptRef
+	funcName string
+	data     any
+	dueTurn  uint64 // Zero if this is waiting on a round
+	dueRound uint64 // Zero if this is waiting on a turn
+}
+
+func (t *scriptTimer) isDue(turnNow uint64, roundNow uint64) bool {
+	if t.dueTurn > 0 {
+		return turnNow >= t.dueTurn
+	}
+	return roundNow >= t.dueRound
+}
+
+// Timer functions are bound to the script that calls them,
+// so each VM gets its own copy.
+func setTimerFunctions(vm *goja.Runtime, script scriptRef) {
+
+	vm.Set(`TimerAfterRounds`, func(rounds int, funcName string, data any) int {
+		if rounds < 1 {
+			rounds = 1
+		}
+		return addTimer(&scriptTimer{script: script, funcName: funcName, data: data, dueRound: util.GetRoundCount() + uint64(rounds)})
+	})
+
+	vm.Set(`TimerAfterTurns`, func(turns int, funcName string, data any) int {
+		if turns < 1 {
+			turns = 1
+		}
+		return addTimer(&scriptTimer{script: script, funcName: funcName, data: data, dueTurn: util.GetTurnCount() + uint64(turns)})
+	})
+
+	vm.Set(`TimerAfterPeriod`, func(period string, funcName string, data any) int {
+		dueRound := gametime.GetDate().AddPeriod(period)
+		if dueRound <= util.GetRoundCount() {
+			dueRound = util.GetRoundCount() + 1
+		}
+		return addTimer(&scriptTimer{script: script, funcName: funcName, data: data, dueRound: dueRound})
+	})
+
+	vm.Set(`TimerCancel`, TimerCancel)
+}
+
+func addTimer(t *scriptTimer) int {
+
+	if len(scriptTimers) >= maxScriptTimers {
+		mudlog.Error("Script Timer", "script", t.script.String(), "function", t.funcName, "error", "too many timers")
+		return 0
+	}
+
+	timerCounter++
+	t.id = timerCounter
+	scriptTimers[t.id] = t
+
+	return t.id
+}
+
+// Cancels a timer before it runs. Returns false if it had already run or never existed.
+func TimerCancel(timerId int) bool {
+	if _, ok := scriptTimers[timerId]; !ok {
+		return false
+	}
+	delete(scriptTimers, timerId)
+	return true
+}
+
+// Runs any timers that are due, in the order they were created.
+// Should be called once per turn.
+func RunTimers(turnNow uint64, roundNow uint64) {
+
+	dueIds := []int{}
+	for id, t := range scriptTimers {
+		if t.isDue(turnNow, roundNow) {
+			dueIds = append(dueIds, id)
+		}
+	}
+
+	if len(dueIds) == 0 {
+		return
+	}
+
+	sort.Ints(dueIds)
+
+	for _, id := range dueIds {
+
+		t, ok := scriptTimers[id]
+		if !ok { // Cancelled by an earlier timer
+			continue
+		}
+		// Removed before running, so the callback can schedule again
+		delete(scriptTimers, id)
+
+		if err := t.run(); err != nil && !errors.Is(err, errNoScript) {
+			mudlog.Error("Script Timer", "script", t.script.String(), "function", t.funcName, "error", err)
+			debugOutput(t.script, `error`, fmt.Sprintf(`timer %s(): %s`, t.funcName, err))
+		}
+	}
+}
+
+func (t *scriptTimer) run() error {
+
+	vmw, err := t.script.getVM()
+	if err != nil {
+		return err
+	}
+
+	fn, ok := vmw.GetFunction(t.funcName)
+	if !ok {
+		return fmt.Errorf("function not found: %s", t.funcName)
+	}
+
+	tmr := time.AfterFunc(t.script.timeout(), func() {
+		interruptTimeout(vmw.VM, t.script.Type)
+	})
+	_, err = fn(goja.Undefined(), vmw.VM.ToValue(t.data))
+	vmw.VM.ClearInterrupt()
+	tmr.Stop()
+
+	if err != nil {
+		return fmt.Errorf("%s(): %w", t.funcName, err)
+	}
+
+	return nil
+}
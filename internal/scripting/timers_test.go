@@ -0,0 +1,137 @@
+package scripting
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/GoMudEngine/GoMud/internal/configs"
+	"github.com/GoMudEngine/GoMud/internal/mudlog"
+	"github.com/GoMudEngine/GoMud/internal/spells"
+	"github.com/GoMudEngine/GoMud/internal/util"
+	"github.com/stretchr/testify/assert"
+)
+
+const timerTestScript = `
+var calls = [];
+
+function record(data) {
+    calls.push(data);
+}
+
+function cancelTimer(timerId) {
+    calls.push("cancel");
+    TimerCancel(timerId);
+}
+`
+
+// Creates a spell whose script records each timer callback in a global calls array,
+// and starts with no timers or cached VMs.
+func setupTimerTest(t *testing.T) scriptRef {
+	t.Helper()
+
+	mudlog.SetupLogger(nil, `ERROR`, ``, false)
+
+	configs.AddOverlayOverrides(map[string]any{
+		`FilePaths.DataFiles`: t.TempDir(),
+	})
+
+	clear(scriptTimers)
+	PruneVMs(true)
+
+	t.Cleanup(func() {
+		clear(scriptTimers)
+		PruneVMs(true)
+	})
+
+	spellId := `timertest`
+	if spells.GetSpell(spellId) == nil {
+		_, err := spells.CreateNewSpellFile(spells.SpellData{SpellId: spellId, Name: `Timer Test`, Type: spells.Neutral})
+		if !assert.NoError(t, err) {
+			t.FailNow()
+		}
+	}
+
+	// The spell stays loaded between tests, but each test has its own data folder
+	scriptPath := spells.GetSpell(spellId).GetScriptPath()
+	os.MkdirAll(filepath.Dir(scriptPath), os.ModePerm)
+
+	if err := os.WriteFile(scriptPath, []byte(timerTestScript), 0644); !assert.NoError(t, err) {
+		t.FailNow()
+	}
+
+	return scriptRef{Type: `spell`, Name: spellId}
+}
+
+// Returns what the script's calls array holds, loading the VM if needed
+func timerTestCalls(t *testing.T, script scriptRef) []any {
+	t.Helper()
+
+	vmw, err := script.getVM()
+	if !assert.NoError(t, err) {
+		return nil
+	}
+
+	calls, _ := vmw.VM.Get(`calls`).Export().([]any)
+	return calls
+}
+
+func TestRunTimers_SurvivesPruneVMs(t *testing.T) {
+	script := setupTimerTest(t)
+
+	vmw, err := script.getVM()
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	_, err = vmw.VM.RunString(`TimerAfterRounds(2, "record", "after prune")`)
+	assert.NoError(t, err)
+
+	// Every cached VM is thrown away, including the one that scheduled the timer
+	PruneVMs(true)
+
+	roundNow := util.GetRoundCount()
+
+	RunTimers(0, roundNow+1)
+	assert.Len(t, scriptTimers, 1)
+
+	RunTimers(0, roundNow+2)
+	assert.Len(t, scriptTimers, 0)
+
+	assert.Equal(t, []any{`after prune`}, timerTestCalls(t, script))
+}
+
+func TestRunTimers_CreationOrder(t *testing.T) {
+	script := setupTimerTest(t)
+
+	roundNow := util.GetRoundCount()
+
+	// Later timers that come due at the same time still run after earlier ones
+	addTimer(&scriptTimer{script: script, funcName: `record`, data: `first`, dueRound: roundNow + 3})
+	addTimer(&scriptTimer{script: script, funcName: `record`, data: `second`, dueRound: roundNow + 1})
+	addTimer(&scriptTimer{script: script, funcName: `record`, data: `third`, dueRound: roundNow + 2})
+
+	RunTimers(0, roundNow+3)
+
+	assert.Equal(t, []any{`first`, `second`, `third`}, timerTestCalls(t, script))
+}
+
+func TestTimerCancel(t *testing.T) {
+	script := setupTimerTest(t)
+
+	roundNow := util.GetRoundCount()
+
+	cancelledId := addTimer(&scriptTimer{script: script, funcName: `record`, data: `cancelled`, dueRound: roundNow + 1})
+	assert.True(t, TimerCancel(cancelledId))
+	assert.False(t, TimerCancel(cancelledId))
+
+	// An earlier timer cancels a later one that is due in the same pass
+	cancelerId := addTimer(&scriptTimer{script: script, funcName: `cancelTimer`, dueRound: roundNow + 1})
+	laterId := addTimer(&scriptTimer{script: script, funcName: `record`, data: `later`, dueRound: roundNow + 1})
+	scriptTimers[cancelerId].data = laterId
+
+	RunTimers(0, roundNow+1)
+
+	assert.Equal(t, []any{`cancel`}, timerTestCalls(t, script))
+	assert.Len(t, scriptTimers, 0)
+}
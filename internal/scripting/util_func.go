@@ -7,6 +7,7 @@ import (
 	"github.com/GoMudEngine/GoMud/internal/configs"
 	"github.com/GoMudEngine/GoMud/internal/events"
 	"github.com/GoMudEngine/GoMud/internal/gametime"
+	"github.com/GoMudEngine/GoMud/internal/rooms"
 	"github.com/GoMudEngine/GoMud/internal/users"
 	"github.com/GoMudEngine/GoMud/internal/util"
 	"github.com/dop251/goja"
@@ -39,6 +40,7 @@ func setUtilFunctions(vm *goja.Runtime) {
 	vm.Set(`UtilSetTimeDay`, UtilSetTimeDay)
 	vm.Set(`UtilSetTimeNight`, UtilSetTimeNight)
 	vm.Set(`UtilIsDay`, UtilIsDay)
+	vm.Set(`UtilGetWeather`, UtilGetWeather)
 	vm.Set(`UtilLocateUser`, UtilLocateUser)
 	vm.Set(`UtilApplyColorPattern`, UtilApplyColorPattern)
 	vm.Set(`UtilGetConfig`, UtilGetConfig)
@@ -137,6 +139,26 @@ func UtilIsDay() bool {
 	return !gametime.IsNight()
 }
 
+// Accepts a roomId for the weather in that room (none if sheltered),
+// or a zone name for the weather of the zone.
+func UtilGetWeather(roomIdOrZone any) string {
+
+	switch v := roomIdOrZone.(type) {
+	case string:
+		return rooms.GetZoneWeather(v)
+	case int:
+		if room := rooms.LoadRoom(v); room != nil {
+			return room.GetWeather()
+		}
+	case int64:
+		if room := rooms.LoadRoom(int(v)); room != nil {
+			return room.GetWeather()
+		}
+	}
+
+	return rooms.WeatherNone
+}
+
 func UtilLocateUser(idOrName any) int {
 
 	// check if is string
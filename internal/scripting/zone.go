@@ -0,0 +1,298 @@
+package scripting
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/GoMudEngine/GoMud/internal/mudlog"
+	"github.com/GoMudEngine/GoMud/internal/rooms"
+	"github.com/dop251/goja"
+)
+
+var (
+	zoneVMCache       = make(map[string]*VMWrapper)
+	scriptZoneTimeout = 50 * time.Millisecond
+)
+
+func ClearZoneVMs() {
+	clear(zoneVMCache)
+}
+
+func PruneZoneVMs(zoneNames ...string) {
+	// Do not prune, there is only ever one VM per zone.
+}
+
+// onZoneEnter and onZoneExit, when a user moves between zones.
+// fromRoomId is zero if the user just entered the world.
+func TryZoneMoveEvent(eventName string, zoneName string, userId int, fromRoomId int, toRoomId int) (bool, error) {
+
+	vmw, err := getZoneVM(zoneName)
+	if err != nil {
+		return false, err
+	}
+
+	timestart := time.Now()
+	defer func() {
+		mudlog.Debug("TryZoneMoveEvent()", "eventName", eventName, "zone", zoneName, "time", time.Since(timestart))
+	}()
+
+	if fn, ok := vmw.GetFunction(eventName); ok {
+		return callZoneFunction(vmw, eventName, fn,
+			GetUser(userId),
+			GetRoom(fromRoomId),
+			GetRoom(toRoomId),
+			GetZone(zoneName),
+		)
+	}
+
+	return false, ErrEventNotFound
+}
+
+// onZoneIdle, once a round for each zone that has players in it.
+// Returning true prevents idle messages in the zone that round.
+func TryZoneIdleEvent(zoneName string) (bool, error) {
+
+	vmw, err := getZoneVM(zoneName)
+	if err != nil {
+		return false, err
+	}
+
+	timestart := time.Now()
+	defer func() {
+		mudlog.Debug("TryZoneIdleEvent()", "zone", zoneName, "time", time.Since(timestart))
+	}()
+
+	if fn, ok := vmw.GetFunction(`onZoneIdle`); ok {
+		return callZoneFunction(vmw, `onZoneIdle`, fn,
+			GetZone(zoneName),
+		)
+	}
+
+	return false, ErrEventNotFound
+}
+
+// onZoneMobDeath, after a mob dies somewhere in the zone.
+// The mob is already gone, so what is known about it is passed in eventDetails.
+func TryZoneMobDeathEvent(zoneName string, roomId int, eventDetails map[string]any) (bool, error) {
+
+	vmw, err := getZoneVM(zoneName)
+	if err != nil {
+		return false, err
+	}
+
+	timestart := time.Now()
+	defer func() {
+		mudlog.Debug("TryZoneMobDeathEvent()", "zone", zoneName, "roomId", roomId, "time", time.Since(timestart))
+	}()
+
+	if fn, ok := vmw.GetFunction(`onZoneMobDeath`); ok {
+		return callZoneFunction(vmw, `onZoneMobDeath`, fn,
+			GetRoom(roomId),
+			GetZone(zoneName),
+			eventDetails,
+		)
+	}
+
+	return false, ErrEventNotFound
+}
+
+// onNewDay, when the game date rolls over.
+func TryZoneNewDayEvent(zoneName string, eventDetails map[string]any) (bool, error) {
+
+	vmw, err := getZoneVM(zoneName)
+	if err != nil {
+		return false, err
+	}
+
+	timestart := time.Now()
+	defer func() {
+		mudlog.Debug("TryZoneNewDayEvent()", "zone", zoneName, "time", time.Since(timestart))
+	}()
+
+	if fn, ok := vmw.GetFunction(`onNewDay`); ok {
+		return callZoneFunction(vmw, `onNewDay`, fn,
+			GetZone(zoneName),
+			eventDetails,
+		)
+	}
+
+	return false, ErrEventNotFound
+}
+
+// Tries onCommand_X, then onCommand, in the zone script.
+// Called by TryRoomCommand when the room itself doesn't handle the command.
+func tryZoneCommand(cmd string, altCmd string, rest string, userId int, roomId int, zoneName string) (bool, error) {
+
+	vmw, err := getZoneVM(zoneName)
+	if err != nil {
+		return false, err
+	}
+
+	timestart := time.Now()
+	defer func() {
+		mudlog.Debug("TryZoneCommand()", "cmd", cmd, "zone", zoneName, "time", time.Since(timestart))
+	}()
+
+	onCommandFunc, cmdFound := vmw.GetFunction(`onCommand_` + cmd)
+	if !cmdFound && altCmd != `` {
+		onCommandFunc, cmdFound = vmw.GetFunction(`onCommand_` + altCmd)
+	}
+
+	if cmdFound {
+		return callZoneFunction(vmw, `onCommand_`+cmd, onCommandFunc,
+			rest,
+			GetUser(userId),
+			GetRoom(roomId),
+			GetZone(zoneName),
+		)
+	}
+
+	if onCommandFunc, ok := vmw.GetFunction(`onCommand`); ok {
+		return callZoneFunction(vmw, `onCommand`, onCommandFunc,
+			cmd,
+			rest,
+			GetUser(userId),
+			GetRoom(roomId),
+			GetZone(zoneName),
+		)
+	}
+
+	return false, ErrEventNotFound
+}
+
+func callZoneFunction(vmw *VMWrapper, funcName string, fn goja.Callable, args ...any) (bool, error) {
+
+	// Set forced ansi tag wrappers
+	userTextWrap.Set(`script-text`, ``, ``)
+	roomTextWrap.Set(`script-text`, ``, ``)
+
+	jsArgs := make([]goja.Value, len(args))
+	for i, arg := range args {
+		jsArgs[i] = vmw.VM.ToValue(arg)
+	}
+
+	tmr := time.AfterFunc(scriptZoneTimeout, func() {
+		interruptTimeout(vmw.VM, `zone`)
+	})
+	res, err := fn(goja.Undefined(), jsArgs...)
+	vmw.VM.ClearInterrupt()
+	tmr.Stop()
+
+	userTextWrap.Reset()
+	roomTextWrap.Reset()
+
+	if err != nil {
+
+		// Wrap the error
+		finalErr := fmt.Errorf("%s(): %w", funcName, err)
+
+		if _, ok := finalErr.(*goja.Exception); ok {
+			logScriptError(vmw.VM, "exception", finalErr)
+			return false, finalErr
+		} else if errors.Is(finalErr, errTimeout) {
+			logScriptError(vmw.VM, "interrupted", finalErr)
+			return false, finalErr
+		}
+
+		logScriptError(vmw.VM, "error", finalErr)
+		return false, finalErr
+	}
+
+	if boolVal, ok := res.Export().(bool); ok {
+		return boolVal, nil
+	}
+
+	return false, nil
+}
+
+func getZoneVM(zoneName string) (*VMWrapper, error) {
+
+	if vm, ok := zoneVMCache[zoneName]; ok {
+		if vm == nil {
+			return nil, errNoScript
+		}
+		return vm, nil
+	}
+
+	zoneConfig := rooms.GetZoneConfig(zoneName)
+	if zoneConfig == nil {
+		return nil, fmt.Errorf("zone not found: %s", zoneName)
+	}
+
+	script := zoneConfig.GetScript()
+	if len(script) == 0 {
+		zoneVMCache[zoneName] = nil
+		return nil, errNoScript
+	}
+
+	vm := goja.New()
+	setAllScriptingFunctions(vm, scriptRef{Type: `zone`, Name: zoneName})
+
+	prg, err := goja.Compile(fmt.Sprintf(`zone-%s`, zoneName), script, false)
+	if err != nil {
+		finalErr := fmt.Errorf("Compile: %w", err)
+		logScriptError(vm, "error", finalErr)
+		return nil, finalErr
+	}
+
+	//
+	// Run the program
+	//
+	tmr := time.AfterFunc(scriptLoadTimeout, func() {
+		interruptTimeout(vm, `zone`)
+	})
+	if _, err = vm.RunProgram(prg); err != nil {
+
+		// Wrap the error
+		finalErr := fmt.Errorf("RunProgram: %w", err)
+
+		if _, ok := finalErr.(*goja.Exception); ok {
+			logScriptError(vm, "exception", finalErr)
+			return nil, finalErr
+		} else if errors.Is(finalErr, errTimeout) {
+			logScriptError(vm, "interrupted", finalErr)
+			return nil, finalErr
+		}
+
+		logScriptError(vm, "error", finalErr)
+		return nil, finalErr
+	}
+	vm.ClearInterrupt()
+	tmr.Stop()
+
+	//
+	// Run onLoad() function
+	//
+	tmr = time.AfterFunc(scriptLoadTimeout, func() {
+		interruptTimeout(vm, `zone`)
+	})
+	if fn, ok := goja.AssertFunction(vm.Get(`onLoad`)); ok {
+
+		sZone := GetZone(zoneName)
+
+		if _, err := fn(goja.Undefined(), vm.ToValue(sZone)); err != nil {
+			// Wrap the error
+			finalErr := fmt.Errorf("onLoad: %w", err)
+
+			if _, ok := finalErr.(*goja.Exception); ok {
+				logScriptError(vm, "exception", finalErr)
+				return nil, finalErr
+			} else if errors.Is(finalErr, errTimeout) {
+				logScriptError(vm, "interrupted", finalErr)
+				return nil, finalErr
+			}
+
+			logScriptError(vm, "error", finalErr)
+			return nil, finalErr
+		}
+	}
+	vm.ClearInterrupt()
+	tmr.Stop()
+
+	vmw := newVMWrapper(vm, 0)
+
+	zoneVMCache[zoneName] = vmw
+
+	return vmw, nil
+}
@@ -0,0 +1,87 @@
+package scripting
+
+import (
+	"slices"
+
+	"github.com/GoMudEngine/GoMud/internal/rooms"
+	"github.com/GoMudEngine/GoMud/internal/users"
+	"github.com/dop251/goja"
+)
+
+func setZoneFunctions(vm *goja.Runtime) {
+	vm.Set(`GetZone`, GetZone)
+}
+
+type ScriptZone struct {
+	zoneName   string
+	zoneConfig *rooms.ZoneConfig
+}
+
+func (z ScriptZone) ZoneName() string {
+	return z.zoneName
+}
+
+func (z ScriptZone) RootRoomId() int {
+	return z.zoneConfig.RoomId
+}
+
+func (z ScriptZone) GetRoomIds() []int {
+	roomIds := rooms.GetAllZoneRoomsIds(z.zoneName)
+	slices.Sort(roomIds)
+	return roomIds
+}
+
+func (z ScriptZone) GetPlayers() []*ScriptActor {
+	actorList := []*ScriptActor{}
+	for _, user := range users.GetAllActiveUsers() {
+		if user.Character.Zone != z.zoneName {
+			continue
+		}
+		a := GetActor(user.UserId, 0)
+		if a == nil {
+			continue
+		}
+		actorList = append(actorList, a)
+	}
+	return actorList
+}
+
+// Sends a message to every player in the zone
+func (z ScriptZone) SendText(msg string, excludeIds ...int) {
+
+	msg = roomTextWrap.Wrap(msg)
+
+	for _, user := range users.GetAllActiveUsers() {
+		if user.Character.Zone != z.zoneName {
+			continue
+		}
+		if slices.Contains(excludeIds, user.UserId) {
+			continue
+		}
+		user.SendText(msg)
+	}
+}
+
+func (z ScriptZone) HasMutator(mutName string) bool {
+	return z.zoneConfig.Mutators.Has(mutName)
+}
+
+func (z ScriptZone) AddMutator(mutName string) {
+	z.zoneConfig.Mutators.Add(mutName)
+}
+
+func (z ScriptZone) RemoveMutator(mutName string) {
+	z.zoneConfig.Mutators.Remove(mutName)
+}
+
+// ////////////////////////////////////////////////////////
+//
+// # These functions get exported to the scripting engine
+//
+// ////////////////////////////////////////////////////////
+func GetZone(zoneName string) *ScriptZone {
+	if zoneConfig := rooms.GetZoneConfig(zoneName); zoneConfig != nil {
+		return &ScriptZone{zoneConfig.Name, zoneConfig}
+	}
+	return nil
+}
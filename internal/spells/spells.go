@@ -3,6 +3,7 @@ package spells
 import (
 	"fmt"
 	"os"
+	"regexp"
 	"strings"
 	"time"
 
@@ -44,6 +45,8 @@ const (
 
 var (
 	allSpells = map[string]*SpellData{}
+
+	spellIdRegex = regexp.MustCompile(`^[a-z0-9_-]+$`)
 )
 
 func (s SpellType) HelpOrHarmString() string {
@@ -151,6 +154,11 @@ func (s *SpellData) Filepath() string {
 
 func (s *SpellData) Validate() error {
 
+	// The id becomes the yaml and script filenames
+	if !spellIdRegex.MatchString(s.SpellId) {
+		return fmt.Errorf(`invalid spell id "%s": only lowercase letters, numbers, - and _ are allowed`, s.SpellId)
+	}
+
 	if s.Difficulty < 0 {
 		s.Difficulty = 0
 	} else if s.Difficulty > 100 {
@@ -183,6 +191,50 @@ func (s *SpellData) GetScriptPath() string {
 	return strings.Replace(string(configs.GetFilePathsConfig().DataFiles)+`/spells/`+s.Filepath(), `.yaml`, `.js`, 1)
 }
 
+// Overwrites an existing spell on disk and in memory.
+func SaveSpell(spellInfo SpellData) error {
+
+	if _, ok := allSpells[spellInfo.SpellId]; !ok {
+		return fmt.Errorf(`spell %s does not exist`, spellInfo.SpellId)
+	}
+
+	if err := spellInfo.Validate(); err != nil {
+		return err
+	}
+
+	saveModes := []fileloader.SaveOption{}
+	if configs.GetFilePathsConfig().CarefulSaveFiles {
+		saveModes = append(saveModes, fileloader.SaveCareful)
+	}
+
+	if err := fileloader.SaveFlatFile[*SpellData](string(configs.GetFilePathsConfig().DataFiles)+`/spells`, &spellInfo, saveModes...); err != nil {
+		return err
+	}
+
+	allSpells[spellInfo.Id()] = &spellInfo
+
+	return nil
+}
+
+// Removes a spell (and its script) from disk and memory.
+func DeleteSpell(spellId string) error {
+
+	spellInfo, ok := allSpells[spellId]
+	if !ok {
+		return fmt.Errorf(`spell %s does not exist`, spellId)
+	}
+
+	if err := fileloader.DeleteFlatFile[*SpellData](string(configs.GetFilePathsConfig().DataFiles)+`/spells`, spellInfo); err != nil {
+		return err
+	}
+
+	os.Remove(spellInfo.GetScriptPath())
+
+	delete(allSpells, spellId)
+
+	return nil
+}
+
 func LoadSpellFiles() {
 
 	start := time.Now()
@@ -0,0 +1,34 @@
+package term
+
+const (
+	MCCP2 IACByte = 86 // https://tintin.mudhalla.net/protocols/mccp/
+	MCCP3 IACByte = 87 // https://tintin.mudhalla.net/protocols/mccp/
+)
+
+/*
+Handshake (MCCP2, server to client compression)
+The server sends IAC WILL MCCP2.
+The client responds with either IAC DO MCCP2 or IAC DONT MCCP2.
+If the client agrees, the server sends IAC SB MCCP2 IAC SE and every byte after that is zlib compressed.
+
+Handshake (MCCP3, client to server compression)
+The server sends IAC WILL MCCP3.
+The client responds with either IAC DO MCCP3 or IAC DONT MCCP3.
+If the client agrees, the client sends IAC SB MCCP3 IAC SE and every byte after that is zlib compressed.
+*/
+
+var (
+	Mccp2Enable = TerminalCommand{[]byte{TELNET_IAC, TELNET_WILL, MCCP2}, []byte{}}                 // Indicates the server wants to compress output.
+	Mccp2Accept = TerminalCommand{[]byte{TELNET_IAC, TELNET_DO, MCCP2}, []byte{}}                   // Indicates the client accepts compressed output
+	Mccp2Refuse = TerminalCommand{[]byte{TELNET_IAC, TELNET_DONT, MCCP2}, []byte{}}                 // Indicates the client refuses compressed output
+	Mccp2Start  = TerminalCommand{[]byte{TELNET_IAC, TELNET_SB, MCCP2, TELNET_IAC, TELNET_SE}, nil} // Sent by the server, everything after this is compressed
+
+	Mccp3Enable = TerminalCommand{[]byte{TELNET_IAC, TELNET_WILL, MCCP3}, []byte{}}                 // Indicates the server will accept compressed input.
+	Mccp3Accept = TerminalCommand{[]byte{TELNET_IAC, TELNET_DO, MCCP3}, []byte{}}                   // Indicates the client will send compressed input
+	Mccp3Refuse = TerminalCommand{[]byte{TELNET_IAC, TELNET_DONT, MCCP3}, []byte{}}                 // Indicates the client will not send compressed input
+	Mccp3Start  = TerminalCommand{[]byte{TELNET_IAC, TELNET_SB, MCCP3, TELNET_IAC, TELNET_SE}, nil} // Sent by the client, everything after this is compressed
+)
+
+func IsMCCPCommand(b []byte) bool {
+	return len(b) > 2 && b[0] == TELNET_IAC && (b[2] == MCCP2 || b[2] == MCCP3)
+}
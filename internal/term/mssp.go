@@ -0,0 +1,48 @@
+package term
+
+const (
+	MSSP IACByte = 70 // https://tintin.mudhalla.net/protocols/mssp/
+
+	MSSP_VAR byte = 1
+	MSSP_VAL byte = 2
+
+	// Plain text fallback, sent by a crawler at the login prompt
+	MsspPlainRequest    = `MSSP-REQUEST`
+	MsspPlainReplyStart = `MSSP-REPLY-START`
+	MsspPlainReplyEnd   = `MSSP-REPLY-END`
+)
+
+/*
+Handshake
+The server sends IAC WILL MSSP.
+The client responds with either IAC DO MSSP or IAC DONT MSSP.
+If the client agrees, the server responds with IAC SB MSSP MSSP_VAR "name" MSSP_VAL "value" ... IAC SE
+*/
+
+var (
+	MsspEnable = TerminalCommand{[]byte{TELNET_IAC, TELNET_WILL, MSSP}, []byte{}} // Indicates the server supports MSSP.
+
+	MsspAccept = TerminalCommand{[]byte{TELNET_IAC, TELNET_DO, MSSP}, []byte{}}   // Indicates the client requests MSSP data
+	MsspRefuse = TerminalCommand{[]byte{TELNET_IAC, TELNET_DONT, MSSP}, []byte{}} // Indicates the client does not want MSSP data
+
+	MsspCommand = TerminalCommand{[]byte{TELNET_IAC, TELNET_SB, MSSP}, []byte{TELNET_IAC, TELNET_SE}} // Send MSSP variables
+)
+
+func IsMSSPCommand(b []byte) bool {
+	return len(b) > 2 && b[0] == TELNET_IAC && b[2] == MSSP
+}
+
+// Encodes name/value pairs as an MSSP subnegotiation payload
+// Names are written in the order provided
+func MsspPayload(names []string, values map[string]string) []byte {
+
+	payload := []byte{}
+	for _, name := range names {
+		payload = append(payload, MSSP_VAR)
+		payload = append(payload, []byte(name)...)
+		payload = append(payload, MSSP_VAL)
+		payload = append(payload, []byte(values[name])...)
+	}
+
+	return payload
+}
@@ -0,0 +1,122 @@
+package usercommands
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/GoMudEngine/GoMud/internal/events"
+	"github.com/GoMudEngine/GoMud/internal/mudlog"
+	"github.com/GoMudEngine/GoMud/internal/rooms"
+	"github.com/GoMudEngine/GoMud/internal/templates"
+	"github.com/GoMudEngine/GoMud/internal/users"
+	"github.com/GoMudEngine/GoMud/internal/util"
+)
+
+/*
+* Role Permissions:
+* apitoken 				(All)
+ */
+func ApiToken(rest string, user *users.UserRecord, room *rooms.Room, flags events.EventFlag) (bool, error) {
+
+	// args should look like one of the following:
+	// apitoken list
+	// apitoken create <name> <scope> [scope...]
+	// apitoken revoke <tokenid>
+	args := util.SplitButRespectQuotes(rest)
+
+	if len(args) == 0 {
+		infoOutput, _ := templates.Process("admincommands/help/command.apitoken", nil, user.UserId)
+		user.SendText(infoOutput)
+		return true, nil
+	}
+
+	switch strings.ToLower(args[0]) {
+
+	case `list`:
+
+		headers := []string{"Id", "Name", "Scopes", "Created"}
+		rows := [][]string{}
+
+		for _, t := range user.ApiTokens {
+			rows = append(rows, []string{t.TokenId, t.Name, strings.Join(t.Scopes, `, `), t.Created.Format(`2006-01-02 15:04`)})
+		}
+
+		if len(rows) == 0 {
+			rows = append(rows, []string{"None", "", "", ""})
+		}
+
+		tokenTable := templates.GetTable("Api Tokens", headers, rows)
+		tplTxt, _ := templates.Process("tables/generic", tokenTable, user.UserId)
+		user.SendText(tplTxt)
+
+	case `create`:
+
+		if len(args) < 3 {
+			user.SendText(`Usage: <ansi fg="command">apitoken create [name] [scope] [scope...]</ansi>`)
+			return true, nil
+		}
+
+		tokenName := args[1]
+		scopes := []string{}
+
+		for _, scope := range args[2:] {
+
+			scope = strings.ToLower(scope)
+
+			// A token can never do more than the role that created it
+			if scope == `*` {
+				if user.Role != users.RoleAdmin {
+					user.SendText(`Only admins can create tokens with the <ansi fg="command">*</ansi> scope.`)
+					return true, nil
+				}
+			} else if !user.HasRolePermission(scope) {
+				user.SendText(fmt.Sprintf(`You don't have the <ansi fg="command">%s</ansi> permission.`, scope))
+				return true, nil
+			}
+
+			scopes = append(scopes, scope)
+		}
+
+		token, err := user.CreateApiToken(tokenName, scopes)
+		if err != nil {
+			user.SendText(err.Error())
+			return true, nil
+		}
+
+		if err := users.SaveUser(*user); err != nil {
+			user.ApiTokens = user.ApiTokens[:len(user.ApiTokens)-1]
+			user.SendText(`Could not save the token: ` + err.Error())
+			return true, nil
+		}
+
+		mudlog.Warn("API TOKEN", "action", "create", "username", user.Username, "name", tokenName, "scopes", strings.Join(scopes, `,`))
+
+		user.SendText(`Api token created. It will not be shown again, so copy it now:`)
+		user.SendText(`    <ansi fg="yellow-bold">` + token + `</ansi>`)
+		user.SendText(`Send it with web requests as: <ansi fg="command">Authorization: Bearer [token]</ansi>`)
+
+	case `revoke`:
+
+		if len(args) < 2 {
+			user.SendText(`Usage: <ansi fg="command">apitoken revoke [id]</ansi>`)
+			return true, nil
+		}
+
+		if !user.RevokeApiToken(args[1]) {
+			user.SendText(fmt.Sprintf(`No token with the id <ansi fg="command">%s</ansi> was found.`, args[1]))
+			return true, nil
+		}
+
+		users.SaveUser(*user)
+
+		mudlog.Warn("API TOKEN", "action", "revoke", "username", user.Username, "tokenId", args[1])
+
+		user.SendText(fmt.Sprintf(`Token <ansi fg="command">%s</ansi> has been revoked.`, args[1]))
+
+	default:
+		infoOutput, _ := templates.Process("admincommands/help/command.apitoken", nil, user.UserId)
+		user.SendText(infoOutput)
+	}
+
+	return true, nil
+}
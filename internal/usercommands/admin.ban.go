@@ -0,0 +1,186 @@
+package usercommands
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/GoMudEngine/GoMud/internal/bans"
+	"github.com/GoMudEngine/GoMud/internal/connections"
+	"github.com/GoMudEngine/GoMud/internal/events"
+	"github.com/GoMudEngine/GoMud/internal/mudlog"
+	"github.com/GoMudEngine/GoMud/internal/rooms"
+	"github.com/GoMudEngine/GoMud/internal/templates"
+	"github.com/GoMudEngine/GoMud/internal/users"
+	"github.com/GoMudEngine/GoMud/internal/util"
+)
+
+/*
+* Role Permissions:
+* ban 				(All)
+ */
+func Ban(rest string, user *users.UserRecord, room *rooms.Room, flags events.EventFlag) (bool, error) {
+
+	// args should look like one of the following:
+	// ban list
+	// ban add <ip|cidr|username> [duration] [reason...]
+	// ban remove <id|value>
+	// ban unlock <ip|username>
+	args := util.SplitButRespectQuotes(rest)
+
+	if len(args) == 0 {
+		infoOutput, _ := templates.Process("admincommands/help/command.ban", nil, user.UserId)
+		user.SendText(infoOutput)
+		return true, nil
+	}
+
+	switch strings.ToLower(args[0]) {
+
+	case `list`:
+
+		headers := []string{"Id", "Type", "Value", "Expires", "By", "Reason"}
+		rows := [][]string{}
+
+		for _, b := range bans.GetBans() {
+			expires := `never`
+			if !b.Permanent() {
+				expires = b.Expires.Format(`2006-01-02 15:04`)
+			}
+			rows = append(rows, []string{strconv.Itoa(b.BanId), string(b.Type), b.Value, expires, b.CreatedBy, b.Reason})
+		}
+
+		if len(rows) == 0 {
+			rows = append(rows, []string{"None", "", "", "", "", ""})
+		}
+
+		banTable := templates.GetTable("Bans", headers, rows)
+		tplTxt, _ := templates.Process("tables/generic", banTable, user.UserId)
+		user.SendText(tplTxt)
+
+		lockouts := bans.GetLockouts()
+		if len(lockouts) > 0 {
+
+			rows = [][]string{}
+			for _, l := range lockouts {
+				rows = append(rows, []string{l.Key, strconv.Itoa(l.Failures), time.Until(l.LockedUntil).Round(time.Second).String()})
+			}
+
+			lockoutTable := templates.GetTable("Login Lockouts", []string{"Ip/Account", "Failures", "Remaining"}, rows)
+			tplTxt, _ = templates.Process("tables/generic", lockoutTable, user.UserId)
+			user.SendText(tplTxt)
+		}
+
+	case `add`:
+
+		if len(args) < 2 {
+			user.SendText(`Usage: <ansi fg="command">ban add [ip|cidr|username] [duration] [reason]</ansi>`)
+			return true, nil
+		}
+
+		banType, banValue, err := bans.ParseBanValue(args[1])
+		if err != nil {
+			user.SendText(err.Error())
+			return true, nil
+		}
+
+		// Duration is optional. Without one the ban is permanent.
+		duration := time.Duration(0)
+		reasonStart := 2
+		if len(args) > 2 {
+			if d, ok := bans.ParseBanDuration(args[2]); ok {
+				duration = d
+				reasonStart = 3
+			}
+		}
+		reason := strings.Join(args[reasonStart:], ` `)
+
+		// Don't let an admin lock themselves out
+		if banType == bans.BanTypeUsername && banValue == strings.ToLower(user.Username) {
+			user.SendText(`You can't ban yourself.`)
+			return true, nil
+		}
+
+		if banType != bans.BanTypeUsername {
+			if connDetails := connections.Get(user.ConnectionId()); connDetails != nil {
+				if (bans.Ban{Type: banType, Value: banValue}).MatchesIP(bans.ParseRemoteIP(connDetails.RemoteAddr().String())) {
+					user.SendText(`That ban would include your own connection.`)
+					return true, nil
+				}
+			}
+		}
+
+		newBan, err := bans.AddBan(banValue, duration, reason, user.Username)
+		if err != nil {
+			user.SendText(err.Error())
+			return true, nil
+		}
+
+		mudlog.Warn("BAN", "action", "add", "username", user.Username, "banId", newBan.BanId, "type", newBan.Type, "value", newBan.Value, "duration", duration, "reason", reason)
+
+		user.SendText(fmt.Sprintf(`Ban <ansi fg="command">%d</ansi> added for %s <ansi fg="command">%s</ansi>.`, newBan.BanId, newBan.Type, newBan.Value))
+
+		// Disconnect anyone already connected that the ban covers
+		for _, connId := range connections.GetAllConnectionIds() {
+
+			connDetails := connections.Get(connId)
+			if connDetails == nil {
+				continue
+			}
+
+			kick := false
+			if newBan.Type == bans.BanTypeUsername {
+				if u := users.GetByConnectionId(connId); u != nil {
+					kick = strings.ToLower(u.Username) == newBan.Value
+				}
+			} else {
+				kick = newBan.MatchesIP(bans.ParseRemoteIP(connDetails.RemoteAddr().String()))
+			}
+
+			if kick {
+				connections.SendTo([]byte(templates.AnsiParse(newBan.Message()+"\n")), connId)
+				connections.Kick(connId, fmt.Sprintf(`Banned (ban %d)`, newBan.BanId))
+				user.SendText(fmt.Sprintf(`Disconnected connection <ansi fg="command">%d</ansi>.`, connId))
+			}
+		}
+
+	case `remove`:
+
+		if len(args) < 2 {
+			user.SendText(`Usage: <ansi fg="command">ban remove [id|value]</ansi>`)
+			return true, nil
+		}
+
+		removedBan, err := bans.RemoveBan(args[1])
+		if err != nil {
+			user.SendText(err.Error())
+			return true, nil
+		}
+
+		mudlog.Warn("BAN", "action", "remove", "username", user.Username, "banId", removedBan.BanId, "type", removedBan.Type, "value", removedBan.Value)
+
+		user.SendText(fmt.Sprintf(`Ban <ansi fg="command">%d</ansi> for %s <ansi fg="command">%s</ansi> has been removed.`, removedBan.BanId, removedBan.Type, removedBan.Value))
+
+	case `unlock`:
+
+		if len(args) < 2 {
+			user.SendText(`Usage: <ansi fg="command">ban unlock [ip|username]</ansi>`)
+			return true, nil
+		}
+
+		if !bans.ClearLockout(args[1]) {
+			user.SendText(fmt.Sprintf(`<ansi fg="command">%s</ansi> has no failed logins.`, args[1]))
+			return true, nil
+		}
+
+		mudlog.Warn("BAN", "action", "unlock", "username", user.Username, "value", args[1])
+
+		user.SendText(fmt.Sprintf(`Failed logins for <ansi fg="command">%s</ansi> have been cleared.`, args[1]))
+
+	default:
+		infoOutput, _ := templates.Process("admincommands/help/command.ban", nil, user.UserId)
+		user.SendText(infoOutput)
+	}
+
+	return true, nil
+}
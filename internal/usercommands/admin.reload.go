@@ -3,9 +3,11 @@ package usercommands
 import (
 	"strings"
 
+	"github.com/GoMudEngine/GoMud/internal/channels"
 	"github.com/GoMudEngine/GoMud/internal/events"
 	"github.com/GoMudEngine/GoMud/internal/items"
 	"github.com/GoMudEngine/GoMud/internal/language"
+	"github.com/GoMudEngine/GoMud/internal/recipes"
 	"github.com/GoMudEngine/GoMud/internal/rooms"
 	"github.com/GoMudEngine/GoMud/internal/templates"
 	"github.com/GoMudEngine/GoMud/internal/users"
@@ -27,6 +29,12 @@ func Reload(rest string, user *users.UserRecord, room *rooms.Room, flags events.
 	case `items`:
 		items.LoadDataFiles()
 		user.SendText(`Items reloaded.`)
+	case `recipes`:
+		recipes.LoadDataFiles()
+		user.SendText(`Recipes reloaded.`)
+	case `channels`:
+		channels.LoadDataFiles()
+		user.SendText(`Channels reloaded.`)
 	case `biomes`:
 		rooms.LoadBiomeDataFiles()
 		user.SendText(`Biomes reloaded.`)
@@ -0,0 +1,246 @@
+package usercommands
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/GoMudEngine/GoMud/internal/events"
+	"github.com/GoMudEngine/GoMud/internal/mobs"
+	"github.com/GoMudEngine/GoMud/internal/rooms"
+	"github.com/GoMudEngine/GoMud/internal/scripting"
+	"github.com/GoMudEngine/GoMud/internal/templates"
+	"github.com/GoMudEngine/GoMud/internal/users"
+)
+
+/*
+* Role Permissions:
+* script 				(All)
+* script.watch			(Watch script output and errors)
+* script.eval			(Run code inside a room or mob script)
+* script.reload			(Drop a cached script so it is reloaded)
+ */
+func Script(rest string, user *users.UserRecord, room *rooms.Room, flags events.EventFlag) (bool, error) {
+
+	// Code passed to eval can contain quotes, so only split off the leading words
+	rest = strings.TrimSpace(rest)
+	args := strings.Fields(rest)
+
+	if len(args) < 1 {
+		infoOutput, _ := templates.Process("admincommands/help/command.script", nil, user.UserId)
+		user.SendText(infoOutput)
+		return true, nil
+	}
+
+	scriptCmd := strings.ToLower(args[0])
+
+	if scriptCmd == `watch` || scriptCmd == `unwatch` {
+
+		if !user.HasRolePermission(`script.watch`) {
+			user.SendText(`you do not have <ansi fg="command">script.watch</ansi> permission`)
+			return true, nil
+		}
+
+		return script_Watch(scriptCmd, args[1:], user, room)
+	}
+
+	if scriptCmd == `eval` {
+
+		if !user.HasRolePermission(`script.eval`) {
+			user.SendText(`you do not have <ansi fg="command">script.eval</ansi> permission`)
+			return true, nil
+		}
+
+		return script_Eval(strings.TrimSpace(rest[len(args[0]):]), user, room)
+	}
+
+	if scriptCmd == `reload` {
+
+		if !user.HasRolePermission(`script.reload`) {
+			user.SendText(`you do not have <ansi fg="command">script.reload</ansi> permission`)
+			return true, nil
+		}
+
+		return script_Reload(args[1:], user, room)
+	}
+
+	infoOutput, _ := templates.Process("admincommands/help/command.script", nil, user.UserId)
+	user.SendText(infoOutput)
+
+	return true, nil
+}
+
+func script_Watch(scriptCmd string, args []string, user *users.UserRecord, room *rooms.Room) (bool, error) {
+
+	if scriptCmd == `unwatch` {
+		scripting.UnwatchScripts(user.UserId)
+		user.SendText(`No longer watching any scripts.`)
+		return true, nil
+	}
+
+	// Just list what is being watched
+	if len(args) == 0 {
+		watching := scripting.GetWatchedScripts(user.UserId)
+		if len(watching) == 0 {
+			user.SendText(`You aren't watching any scripts.`)
+		} else {
+			user.SendText(fmt.Sprintf(`Watching: <ansi fg="yellow-bold">%s</ansi>`, strings.Join(watching, `, `)))
+		}
+		return true, nil
+	}
+
+	scriptType := strings.ToLower(args[0])
+	id := strings.Join(args[1:], ` `)
+
+	// Default to wherever the user is
+	if scriptType == `here` {
+		scriptType = `room`
+	}
+	if id == `` {
+		if scriptType == `room` {
+			id = strconv.Itoa(room.RoomId)
+		} else if scriptType == `zone` {
+			id = room.Zone
+		}
+	}
+
+	if err := scripting.WatchScripts(user.UserId, scriptType, id); err != nil {
+		user.SendText(err.Error())
+		return true, nil
+	}
+
+	user.SendText(fmt.Sprintf(`Watching: <ansi fg="yellow-bold">%s</ansi>`, strings.Join(scripting.GetWatchedScripts(user.UserId), `, `)))
+	user.SendText(`Use <ansi fg="command">script unwatch</ansi> to stop.`)
+
+	return true, nil
+}
+
+func script_Eval(rest string, user *users.UserRecord, room *rooms.Room) (bool, error) {
+
+	// room <roomId|here> <code>
+	// mob <mobInstanceId|name> <code>
+	parts := strings.Fields(rest)
+	if len(parts) < 3 {
+		user.SendText(`Use <ansi fg="command">script eval room [roomId|here] [code]</ansi> or <ansi fg="command">script eval mob [#mobInstanceId|name] [code]</ansi>`)
+		return true, nil
+	}
+
+	target := parts[1]
+
+	// Only the two leading words are split off, so the spacing inside the code is kept
+	code := strings.TrimSpace(rest)
+	code = strings.TrimSpace(code[len(parts[0]):])
+	code = strings.TrimSpace(code[len(parts[1]):])
+
+	var result string
+	var err error
+
+	switch strings.ToLower(parts[0]) {
+	case `room`:
+
+		roomId := room.RoomId
+		if target != `here` {
+			if roomId, err = strconv.Atoi(target); err != nil {
+				user.SendText(fmt.Sprintf(`Invalid room id: %s`, target))
+				return true, nil
+			}
+		}
+
+		result, err = scripting.EvalRoom(roomId, code)
+
+	case `mob`:
+
+		mobInstanceId, _ := strconv.Atoi(strings.TrimPrefix(target, `#`))
+		if mobInstanceId == 0 {
+			_, mobInstanceId = room.FindByName(target)
+		}
+		if mobInstanceId == 0 {
+			user.SendText(fmt.Sprintf(`Mob not found: %s`, target))
+			return true, nil
+		}
+
+		result, err = scripting.EvalMob(mobInstanceId, code)
+
+	default:
+		user.SendText(`You can only eval in a <ansi fg="command">room</ansi> or <ansi fg="command">mob</ansi> script.`)
+		return true, nil
+	}
+
+	if err != nil {
+		user.SendText(fmt.Sprintf(`<ansi fg="red">%s</ansi>`, err.Error()))
+		return true, nil
+	}
+
+	user.SendText(result)
+
+	return true, nil
+}
+
+func script_Reload(args []string, user *users.UserRecord, room *rooms.Room) (bool, error) {
+
+	if len(args) < 1 {
+		user.SendText(`Use <ansi fg="command">script reload [room|zone|mob|item] [id]</ansi>`)
+		return true, nil
+	}
+
+	scriptType := strings.ToLower(args[0])
+	id := strings.Join(args[1:], ` `)
+
+	switch scriptType {
+	case `room`:
+
+		roomId := room.RoomId
+		if id != `` && id != `here` {
+			var err error
+			if roomId, err = strconv.Atoi(id); err != nil {
+				user.SendText(fmt.Sprintf(`Invalid room id: %s`, id))
+				return true, nil
+			}
+		}
+
+		scripting.ReloadRoomScript(roomId)
+		id = strconv.Itoa(roomId)
+
+	case `zone`:
+
+		zoneName := room.Zone
+		if id != `` {
+			zoneName = rooms.FindZoneName(id)
+		}
+		if rooms.GetZoneConfig(zoneName) == nil {
+			user.SendText(fmt.Sprintf(`Zone not found: %s`, id))
+			return true, nil
+		}
+
+		scripting.ReloadZoneScript(zoneName)
+		id = zoneName
+
+	case `mob`:
+
+		mobId, _ := strconv.Atoi(id)
+		if mobs.GetMobSpec(mobs.MobId(mobId)) == nil {
+			user.SendText(fmt.Sprintf(`Invalid mob id: %s`, id))
+			return true, nil
+		}
+
+		scripting.ReloadMobScript(mobId)
+
+	case `item`:
+
+		itemId, _ := strconv.Atoi(id)
+		if itemId == 0 {
+			user.SendText(fmt.Sprintf(`Invalid item id: %s`, id))
+			return true, nil
+		}
+
+		scripting.ReloadItemScript(itemId)
+
+	default:
+		user.SendText(`You can only reload <ansi fg="command">room</ansi>, <ansi fg="command">zone</ansi>, <ansi fg="command">mob</ansi> or <ansi fg="command">item</ansi> scripts.`)
+		return true, nil
+	}
+
+	user.SendText(fmt.Sprintf(`The %s <ansi fg="yellow-bold">%s</ansi> script will be reloaded the next time it runs.`, scriptType, id))
+
+	return true, nil
+}
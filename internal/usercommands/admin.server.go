@@ -9,6 +9,7 @@ import (
 	"time"
 
 	"github.com/GoMudEngine/GoMud/internal/configs"
+	"github.com/GoMudEngine/GoMud/internal/connections"
 	"github.com/GoMudEngine/GoMud/internal/events"
 	"github.com/GoMudEngine/GoMud/internal/gametime"
 	"github.com/GoMudEngine/GoMud/internal/rooms"
@@ -125,6 +126,15 @@ func Server(rest string, user *users.UserRecord, room *rooms.Room, flags events.
 		return true, nil
 	}
 
+	if rest == "copyover" {
+		user.SendText(`Starting copyover...`)
+		events.AddToQueue(events.System{
+			Command: `copyover`,
+			Data:    user.UserId,
+		})
+		return true, nil
+	}
+
 	if rest == "ansi-strip" {
 		templates.SetAnsiFlag(templates.AnsiTagsStrip)
 	}
@@ -180,6 +190,45 @@ func Server(rest string, user *users.UserRecord, room *rooms.Room, flags events.
 		tplTxt, _ := templates.Process("tables/generic", tblData, user.UserId)
 		user.SendText(tplTxt)
 
+		//
+		// Telnet compression (MCCP) stats
+		//
+		if allCompStats := connections.GetCompressionStats(); len(allCompStats) > 0 {
+
+			sort.Slice(allCompStats, func(i, j int) bool {
+				return allCompStats[i].ConnectionId < allCompStats[j].ConnectionId
+			})
+
+			compHeaders := []string{"Conn", "User", "MCCP2", "Out", "Sent", "Ratio", "MCCP3", "In", "Received", "Ratio"}
+			compRows := [][]string{}
+			compFormatting := []string{`<ansi fg="black-bold">%s</ansi>`, `<ansi fg="username">%s</ansi>`, `<ansi fg="yellow-bold">%s</ansi>`, `<ansi fg="cyan-bold">%s</ansi>`, `<ansi fg="cyan-bold">%s</ansi>`, `<ansi fg="red-bold">%s</ansi>`, `<ansi fg="yellow-bold">%s</ansi>`, `<ansi fg="cyan-bold">%s</ansi>`, `<ansi fg="cyan-bold">%s</ansi>`, `<ansi fg="red-bold">%s</ansi>`}
+
+			for _, cs := range allCompStats {
+
+				username := ``
+				if u := users.GetByConnectionId(cs.ConnectionId); u != nil {
+					username = u.Username
+				}
+
+				compRows = append(compRows, []string{
+					fmt.Sprintf(`%d`, cs.ConnectionId),
+					username,
+					fmt.Sprintf(`%t`, cs.MCCP2),
+					util.FormatBytes(cs.RawOut),
+					util.FormatBytes(cs.CompressedOut),
+					fmt.Sprintf(`%.1f%%`, cs.OutRatio()),
+					fmt.Sprintf(`%t`, cs.MCCP3),
+					util.FormatBytes(cs.RawIn),
+					util.FormatBytes(cs.CompressedIn),
+					fmt.Sprintf(`%.1f%%`, cs.InRatio()),
+				})
+			}
+
+			compTblData := templates.GetTable(`Compression Stats`, compHeaders, compRows, compFormatting)
+			compTxt, _ := templates.Process("tables/generic", compTblData, user.UserId)
+			user.SendText(compTxt)
+		}
+
 		//
 		// Alternative rendering
 		//
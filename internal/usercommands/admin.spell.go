@@ -129,7 +129,7 @@ func spell_Create(rest string, user *users.UserRecord, room *rooms.Room, flags e
 	// Id Selection
 	//
 	{
-		question := cmdPrompt.Ask(`What will the spell's unique id be (single-word)? This can also be used as an alias to cast the spell.`, []string{newSpell.SpellId}, newSpell.SpellId)
+		question := cmdPrompt.Ask(`What will the spell's unique id be (lowercase letters, numbers, - and _ only)? This can also be used as an alias to cast the spell.`, []string{newSpell.SpellId}, newSpell.SpellId)
 		if !question.Done {
 			return true, nil
 		}
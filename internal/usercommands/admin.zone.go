@@ -62,6 +62,10 @@ func Zone(rest string, user *users.UserRecord, room *rooms.Room, flags events.Ev
 			user.SendText(fmt.Sprintf(`  <ansi fg="yellow-bold">Mob AutoScale:</ansi>    <ansi fg="red">%d</ansi> - <ansi fg="red">%d</ansi>`, zoneConfig.MobAutoScale.Minimum, zoneConfig.MobAutoScale.Maximum))
 		}
 
+		if zoneScript := zoneConfig.GetScript(); zoneScript != `` {
+			user.SendText(fmt.Sprintf(`    <ansi fg="yellow-bold">Script Path:</ansi>    <ansi fg="white-bold">%s</ansi>`, zoneConfig.GetScriptPath()))
+		}
+
 		user.SendText(``)
 
 		return true, nil
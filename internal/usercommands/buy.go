@@ -49,6 +49,12 @@ func Buy(rest string, user *users.UserRecord, room *rooms.Room, flags events.Eve
 		}
 	}
 
+	// "buy 10 arrows"
+	quantity, itemname := util.SplitQuantity(itemname)
+	if quantity < 1 {
+		quantity = 1
+	}
+
 	success := false
 	defer func() {
 		mudlog.Debug("PURCHASE", "rest", rest, "itemname", itemname, "targetUserId", targetUserId, "targetMobInstanceId", targetMobInstanceId, "success", success)
@@ -67,7 +73,7 @@ func Buy(rest string, user *users.UserRecord, room *rooms.Room, flags events.Eve
 			continue
 		}
 
-		if success = tryPurchase(itemname, user, room, nil, shopUser); success {
+		if success = tryPurchase(itemname, quantity, user, room, nil, shopUser); success {
 			return true, nil
 		}
 	}
@@ -84,7 +90,7 @@ func Buy(rest string, user *users.UserRecord, room *rooms.Room, flags events.Eve
 
 		shopMob.Character.Shop.Restock()
 
-		if success = tryPurchase(itemname, user, room, shopMob, nil); success {
+		if success = tryPurchase(itemname, quantity, user, room, shopMob, nil); success {
 			return true, nil
 		}
 	}
@@ -94,7 +100,7 @@ func Buy(rest string, user *users.UserRecord, room *rooms.Room, flags events.Eve
 }
 
 // TODO: This would sure be a lot more straightforward with an interface...
-func tryPurchase(request string, user *users.UserRecord, room *rooms.Room, shopMob *mobs.Mob, shopUser *users.UserRecord) bool {
+func tryPurchase(request string, quantity int, user *users.UserRecord, room *rooms.Room, shopMob *mobs.Mob, shopUser *users.UserRecord) bool {
 
 	nameToShopItem := map[string]characters.ShopItem{}
 
@@ -246,9 +252,30 @@ func tryPurchase(request string, user *users.UserRecord, room *rooms.Room, shopM
 		return false
 	}
 
+	// Only stackable items can be bought more than one at a time
+	if quantity > 1 {
+		if newItm := items.New(matchedShopItem.ItemId); !newItm.IsStackable() || matchedShopItem.TradeItemId > 0 {
+			if shopMob != nil {
+				shopMob.Command(`say I only sell those one at a time.`)
+			} else if shopUser != nil {
+				user.SendText(`You can only buy those one at a time.`)
+			}
+			return false
+		}
+
+		if matchedShopItem.QuantityMax != characters.StockUnlimited && matchedShopItem.Quantity < quantity {
+			if shopMob != nil {
+				shopMob.Command(fmt.Sprintf(`say I only have %d of those right now.`, matchedShopItem.Quantity))
+			} else if shopUser != nil {
+				user.SendText(fmt.Sprintf(`<ansi fg="username">%s</ansi> only has %d of those for sale right now.`, shopUser.Character.Name, matchedShopItem.Quantity))
+			}
+			return false
+		}
+	}
+
 	price := 0
 	if matchedShopItem.ItemId > 0 {
-		price = itemPrices[matchedShopItem.ItemId]
+		price = itemPrices[matchedShopItem.ItemId] * quantity
 	} else if matchedShopItem.MobId > 0 {
 		price = mercPrices[matchedShopItem.MobId]
 	} else if matchedShopItem.BuffId > 0 {
@@ -276,6 +303,18 @@ func tryPurchase(request string, user *users.UserRecord, room *rooms.Room, shopM
 		}
 	}
 
+	purchaseItem := items.New(matchedShopItem.ItemId)
+	purchaseItem.SetQuantity(quantity)
+
+	if matchedShopItem.ItemId > 0 && !user.Character.CanCarry(purchaseItem) {
+		if shopMob != nil {
+			shopMob.Command(`say That looks like more than you can carry right now.`)
+		} else if shopUser != nil {
+			user.SendText(`That's more than you can carry right now.`)
+		}
+		return false
+	}
+
 	if matchedShopItem.MobId > 0 {
 
 		maxCharmed := user.Character.GetSkillLevel(skills.Tame) + 1
@@ -286,17 +325,19 @@ func tryPurchase(request string, user *users.UserRecord, room *rooms.Room, shopM
 
 	}
 
-	if shopMob != nil {
+	for i := 0; i < quantity; i++ {
+		if shopMob != nil {
 
-		if !shopMob.Character.Shop.Destock(matchedShopItem) {
-			shopMob.Command(`say I don't have that item right now.`)
-			return false
-		}
+			if !shopMob.Character.Shop.Destock(matchedShopItem) {
+				shopMob.Command(`say I don't have that item right now.`)
+				return false
+			}
 
-	} else if shopUser != nil {
-		if !shopUser.Character.Shop.Destock(matchedShopItem) {
-			user.SendText(`That's not for sale.`)
-			return false
+		} else if shopUser != nil {
+			if !shopUser.Character.Shop.Destock(matchedShopItem) {
+				user.SendText(`That's not for sale.`)
+				return false
+			}
 		}
 	}
 
@@ -348,7 +389,7 @@ func tryPurchase(request string, user *users.UserRecord, room *rooms.Room, shopM
 
 	if matchedShopItem.ItemId > 0 {
 		// Give them the item
-		newItm := items.New(matchedShopItem.ItemId)
+		newItm := purchaseItem
 		user.Character.StoreItem(newItm)
 		user.PlaySound(`purchase`, `other`)
 
@@ -360,28 +401,28 @@ func tryPurchase(request string, user *users.UserRecord, room *rooms.Room, shopM
 
 		if shopMob != nil {
 
-			user.EventLog.Add(`shop`, fmt.Sprintf(`Purchased a <ansi fg="itemname">%s</ansi> from <ansi fg="mobname">%s</ansi> for %s`, newItm.DisplayName(), shopMob.Character.Name, tradeInString))
+			user.EventLog.Add(`shop`, fmt.Sprintf(`Purchased a <ansi fg="itemname">%s</ansi> from <ansi fg="mobname">%s</ansi> for %s`, newItm.QuantityDisplayName(), shopMob.Character.Name, tradeInString))
 
 			user.SendText(
-				fmt.Sprintf(`You buy a <ansi fg="itemname">%s</ansi> from <ansi fg="mobname">%s</ansi> for %s.`, newItm.DisplayName(), shopMob.Character.Name, tradeInString),
+				fmt.Sprintf(`You buy a <ansi fg="itemname">%s</ansi> from <ansi fg="mobname">%s</ansi> for %s.`, newItm.QuantityDisplayName(), shopMob.Character.Name, tradeInString),
 			)
 			room.SendText(
-				fmt.Sprintf(`<ansi fg="username">%s</ansi> buys a <ansi fg="itemname">%s</ansi> from <ansi fg="mobname">%s</ansi>.`, user.Character.Name, newItm.DisplayName(), shopMob.Character.Name),
+				fmt.Sprintf(`<ansi fg="username">%s</ansi> buys a <ansi fg="itemname">%s</ansi> from <ansi fg="mobname">%s</ansi>.`, user.Character.Name, newItm.QuantityDisplayName(), shopMob.Character.Name),
 				user.UserId,
 			)
 
 		} else if shopUser != nil {
 
-			user.EventLog.Add(`shop`, fmt.Sprintf(`Purchased a <ansi fg="itemname">%s</ansi> from <ansi fg="username">%s</ansi> for %s.`, newItm.DisplayName(), shopUser.Character.Name, tradeInString))
+			user.EventLog.Add(`shop`, fmt.Sprintf(`Purchased a <ansi fg="itemname">%s</ansi> from <ansi fg="username">%s</ansi> for %s.`, newItm.QuantityDisplayName(), shopUser.Character.Name, tradeInString))
 
 			user.SendText(
-				fmt.Sprintf(`You buy a <ansi fg="itemname">%s</ansi> from <ansi fg="username">%s</ansi> for %s.`, newItm.DisplayName(), shopUser.Character.Name, tradeInString),
+				fmt.Sprintf(`You buy a <ansi fg="itemname">%s</ansi> from <ansi fg="username">%s</ansi> for %s.`, newItm.QuantityDisplayName(), shopUser.Character.Name, tradeInString),
 			)
 
-			shopUser.SendText(fmt.Sprintf(`<ansi fg="username">%s</ansi> purchased the <ansi fg="itemname">%s</ansi> you were selling for %s.`, user.Character.Name, newItm.DisplayName(), tradeInString))
+			shopUser.SendText(fmt.Sprintf(`<ansi fg="username">%s</ansi> purchased the <ansi fg="itemname">%s</ansi> you were selling for %s.`, user.Character.Name, newItm.QuantityDisplayName(), tradeInString))
 
 			room.SendText(
-				fmt.Sprintf(`<ansi fg="username">%s</ansi> buys a <ansi fg="itemname">%s</ansi> from <ansi fg="mobname">%s</ansi>.`, user.Character.Name, newItm.DisplayName(), shopUser.Character.Name),
+				fmt.Sprintf(`<ansi fg="username">%s</ansi> buys a <ansi fg="itemname">%s</ansi> from <ansi fg="mobname">%s</ansi>.`, user.Character.Name, newItm.QuantityDisplayName(), shopUser.Character.Name),
 				user.UserId, shopUser.UserId)
 		}
 
@@ -0,0 +1,190 @@
+package usercommands
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/GoMudEngine/GoMud/internal/channels"
+	"github.com/GoMudEngine/GoMud/internal/events"
+	"github.com/GoMudEngine/GoMud/internal/rooms"
+	"github.com/GoMudEngine/GoMud/internal/templates"
+	"github.com/GoMudEngine/GoMud/internal/users"
+)
+
+func Channel(rest string, user *users.UserRecord, room *rooms.Room, flags events.EventFlag) (bool, error) {
+
+	args := strings.Fields(rest)
+
+	if len(args) == 0 || strings.ToLower(args[0]) == `list` {
+
+		headers := []string{`Channel`, `Command`, `Description`, `Status`}
+		rows := [][]string{}
+
+		for _, c := range channels.GetAllChannels() {
+
+			if !user.CanUseChannel(c) {
+				continue
+			}
+
+			status := `-`
+			if user.InChannel(c) {
+				status = `joined`
+				if user.GetChannelStatus(c.ChannelId) == channels.StatusMuted {
+					status = `muted`
+				}
+			}
+
+			rows = append(rows, []string{c.Prefix(), c.ChannelId, c.Description, status})
+		}
+
+		if len(rows) == 0 {
+			rows = append(rows, []string{`None`, `-`, `-`, `-`})
+		}
+
+		formatting := []string{
+			`%s`,
+			`<ansi fg="command">%s</ansi>`,
+			`%s`,
+			`<ansi fg="yellow">%s</ansi>`,
+		}
+
+		channelTable := templates.GetTable(`Channels`, headers, rows, formatting)
+		tplTxt, _ := templates.Process("tables/generic", channelTable, user.UserId)
+		user.SendText(tplTxt)
+
+		user.SendText(`Type <ansi fg="command">[command] [message]</ansi> to talk on a channel, or <ansi fg="command">help channel</ansi> for more options.`)
+
+		return true, nil
+	}
+
+	channelCommand := strings.ToLower(args[0])
+
+	switch channelCommand {
+	case `join`, `leave`, `mute`, `unmute`, `history`:
+		if len(args) < 2 {
+			user.SendText(fmt.Sprintf(`Which channel do you want to %s?`, channelCommand))
+			return true, nil
+		}
+	default:
+		// channel [name] [message]
+		c := channels.FindChannel(args[0])
+		if c == nil || !user.CanUseChannel(c) {
+			user.SendText(fmt.Sprintf(`There is no channel called "%s". Type <ansi fg="command">channel</ansi> to see them all.`, args[0]))
+			return true, nil
+		}
+		return sendToChannel(c, strings.Join(args[1:], ` `), user)
+	}
+
+	c := channels.FindChannel(args[1])
+	if c == nil || !user.CanUseChannel(c) {
+		user.SendText(fmt.Sprintf(`There is no channel called "%s". Type <ansi fg="command">channel</ansi> to see them all.`, args[1]))
+		return true, nil
+	}
+
+	switch channelCommand {
+
+	case `join`:
+		if user.InChannel(c) {
+			user.SendText(fmt.Sprintf(`You are already in the %s channel.`, c.Prefix()))
+			return true, nil
+		}
+		user.SetChannelStatus(c.ChannelId, channels.StatusJoined)
+		user.SendText(fmt.Sprintf(`You join the %s channel. Type <ansi fg="command">%s [message]</ansi> to talk on it.`, c.Prefix(), c.ChannelId))
+
+	case `leave`:
+		if !user.InChannel(c) {
+			user.SendText(fmt.Sprintf(`You aren't in the %s channel.`, c.Prefix()))
+			return true, nil
+		}
+		user.SetChannelStatus(c.ChannelId, channels.StatusLeft)
+		user.SendText(fmt.Sprintf(`You leave the %s channel.`, c.Prefix()))
+
+	case `mute`:
+		if !user.InChannel(c) {
+			user.SendText(fmt.Sprintf(`You aren't in the %s channel.`, c.Prefix()))
+			return true, nil
+		}
+		user.SetChannelStatus(c.ChannelId, channels.StatusMuted)
+		user.SendText(fmt.Sprintf(`You will no longer see messages on the %s channel. You can still read its <ansi fg="command">history</ansi>.`, c.Prefix()))
+
+	case `unmute`:
+		if user.GetChannelStatus(c.ChannelId) != channels.StatusMuted {
+			user.SendText(fmt.Sprintf(`The %s channel isn't muted.`, c.Prefix()))
+			return true, nil
+		}
+		user.SetChannelStatus(c.ChannelId, channels.StatusJoined)
+		user.SendText(fmt.Sprintf(`You will see messages on the %s channel again.`, c.Prefix()))
+
+	case `history`:
+		sendChannelHistory(c, user)
+	}
+
+	return true, nil
+}
+
+func sendToChannel(c *channels.ChannelSpec, message string, user *users.UserRecord) (bool, error) {
+
+	if message == `` {
+		sendChannelHistory(c, user)
+		return true, nil
+	}
+
+	if user.Muted {
+		user.SendText(`You are <ansi fg="alert-5">MUTED</ansi>. You can only send <ansi fg="command">whisper</ansi>'s to Admins and Moderators.`)
+		return true, nil
+	}
+
+	if !user.InChannel(c) {
+		user.SendText(fmt.Sprintf(`You aren't in the %s channel. Type <ansi fg="command">channel join %s</ansi> first.`, c.Prefix(), c.ChannelId))
+		return true, nil
+	}
+
+	// Talking on a muted channel means they want to hear it again
+	if user.GetChannelStatus(c.ChannelId) == channels.StatusMuted {
+		user.SetChannelStatus(c.ChannelId, channels.StatusJoined)
+	}
+
+	c.AddHistory(user.Character.Name, message)
+
+	sourceIsMod := user.Role != users.RoleUser
+	msg := c.FormatMessage(user.Character.Name, message)
+
+	for _, u := range users.GetAllActiveUsers() {
+
+		if u.Deafened && !sourceIsMod {
+			continue
+		}
+
+		if !u.HearsChannel(c) {
+			continue
+		}
+
+		u.SendText(msg)
+	}
+
+	events.AddToQueue(events.Communication{
+		SourceUserId: user.UserId,
+		CommType:     `channel`,
+		ChannelId:    c.ChannelId,
+		Name:         user.Character.Name,
+		Message:      message,
+	})
+
+	return true, nil
+}
+
+func sendChannelHistory(c *channels.ChannelSpec, user *users.UserRecord) {
+
+	entries := c.GetHistory()
+
+	if len(entries) == 0 {
+		user.SendText(fmt.Sprintf(`Nothing has been said on the %s channel recently.`, c.Prefix()))
+		return
+	}
+
+	user.SendText(fmt.Sprintf(`Recent messages on the %s channel:`, c.Prefix()))
+
+	for _, entry := range entries {
+		user.SendText(fmt.Sprintf(`<ansi fg="mail-date">%s</ansi> %s`, entry.Sent.Format(`15:04`), c.FormatMessage(entry.Name, entry.Message)))
+	}
+}
@@ -0,0 +1,680 @@
+package usercommands
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/GoMudEngine/GoMud/internal/clans"
+	"github.com/GoMudEngine/GoMud/internal/configs"
+	"github.com/GoMudEngine/GoMud/internal/events"
+	"github.com/GoMudEngine/GoMud/internal/items"
+	"github.com/GoMudEngine/GoMud/internal/mudlog"
+	"github.com/GoMudEngine/GoMud/internal/rooms"
+	"github.com/GoMudEngine/GoMud/internal/templates"
+	"github.com/GoMudEngine/GoMud/internal/users"
+	"github.com/GoMudEngine/GoMud/internal/util"
+)
+
+func Clan(rest string, user *users.UserRecord, room *rooms.Room, flags events.EventFlag) (bool, error) {
+
+	clanConfig := configs.GetClansConfig()
+
+	if !clanConfig.Enabled {
+		user.SendText(`Clans are disabled.`)
+		return true, nil
+	}
+
+	args := util.SplitButRespectQuotes(rest)
+
+	clanCommand := `info`
+	if len(args) > 0 {
+		clanCommand = strings.ToLower(args[0])
+		rest, _ = strings.CutPrefix(rest, args[0])
+		rest = strings.TrimSpace(rest)
+	}
+
+	currentClan := clans.GetByUserId(user.UserId)
+
+	//
+	// Commands anyone can use
+	//
+
+	if clanCommand == `list` {
+
+		headers := []string{`Rank`, `Tag`, `Name`, `Members`, `Zone`}
+		rows := [][]string{}
+
+		for _, c := range clans.GetLeaderboard() {
+			zone := c.Zone
+			if zone == `` {
+				zone = `-`
+			}
+			rows = append(rows, []string{`#` + strconv.Itoa(c.Rank), c.ClanTag, c.ClanName, strconv.Itoa(c.Members), zone})
+		}
+
+		if len(rows) == 0 {
+			rows = append(rows, []string{`-`, `-`, `None`, `-`, `-`})
+		}
+
+		formatting := []string{
+			`<ansi fg="red">%s</ansi>`,
+			`<ansi fg="clantag">%s</ansi>`,
+			`<ansi fg="white-bold">%s</ansi>`,
+			`<ansi fg="157">%s</ansi>`,
+			`<ansi fg="magenta-bold">%s</ansi>`,
+		}
+
+		clanTable := templates.GetTable(`Clans`, headers, rows, formatting)
+		tplTxt, _ := templates.Process("tables/generic", clanTable, user.UserId)
+		user.SendText(tplTxt)
+
+		return true, nil
+	}
+
+	if clanCommand == `info` {
+
+		showClan := currentClan
+		if rest != `` {
+			showClan = clans.Get(rest)
+		}
+
+		if showClan == nil {
+			if rest != `` {
+				user.SendText(fmt.Sprintf(`No clan called <ansi fg="clantag">%s</ansi> was found.`, rest))
+			} else {
+				user.SendText(`You are not in a clan. Type <ansi fg="command">clan list</ansi> to see all clans, or <ansi fg="command">help clan</ansi> for more information.`)
+			}
+			return true, nil
+		}
+
+		sendClanInfo(showClan, user)
+
+		return true, nil
+	}
+
+	if clanCommand == `create` || clanCommand == `found` {
+
+		if currentClan != nil {
+			user.SendText(`You are already in a clan.`)
+			return true, nil
+		}
+
+		if len(args) < 3 {
+			user.SendText(`Usage: <ansi fg="command">clan create [tag] [full name]</ansi>`)
+			return true, nil
+		}
+
+		if user.Character.Level < int(clanConfig.CreateMinLevel) {
+			user.SendText(fmt.Sprintf(`You must be at least level %d to found a clan.`, clanConfig.CreateMinLevel))
+			return true, nil
+		}
+
+		createCost := int(clanConfig.CreateCost)
+		if user.Character.Gold < createCost {
+			user.SendText(fmt.Sprintf(`Founding a clan costs <ansi fg="gold">%d gold</ansi>, which you don't have on hand.`, createCost))
+			return true, nil
+		}
+
+		clanTag := args[1]
+		clanName := strings.TrimSpace(strings.TrimPrefix(rest, clanTag))
+
+		newClan, err := clans.Create(clanTag, clanName, user.UserId, user.Character.Name)
+		if err != nil {
+			user.SendText(err.Error())
+			return true, nil
+		}
+
+		// The founding fee becomes the clan's starting bank
+		if createCost > 0 {
+			user.Character.Gold -= createCost
+			newClan.Donate(user.UserId, createCost, items.Item{})
+
+			events.AddToQueue(events.EquipmentChange{
+				UserId:     user.UserId,
+				GoldChange: -createCost,
+			})
+		}
+
+		mudlog.Info("Clans", "action", "created", "clantag", newClan.ClanTag, "clanname", newClan.ClanName, "userId", user.UserId)
+
+		user.EventLog.Add(`clan`, fmt.Sprintf(`Founded the clan <ansi fg="clantag">%s</ansi> (%s)`, newClan.ClanName, newClan.ClanTag))
+		user.SendText(fmt.Sprintf(`You founded the clan <ansi fg="clantag">[%s]</ansi> <ansi fg="white-bold">%s</ansi>!`, newClan.ClanTag, newClan.ClanName))
+
+		return true, nil
+	}
+
+	if clanCommand == `apply` || clanCommand == `join` {
+
+		if currentClan != nil {
+			user.SendText(`You are already in a clan.`)
+			return true, nil
+		}
+
+		if rest == `` {
+			user.SendText(`Apply to which clan?`)
+			return true, nil
+		}
+
+		applyClan := clans.Get(rest)
+		if applyClan == nil {
+			user.SendText(fmt.Sprintf(`No clan called <ansi fg="clantag">%s</ansi> was found.`, rest))
+			return true, nil
+		}
+
+		// An invitation means they can join right away
+		if applyClan.IsInvited(user.UserId) {
+			if err := applyClan.AddMember(user.UserId, user.Character.Name); err != nil {
+				user.SendText(err.Error())
+				return true, nil
+			}
+
+			user.EventLog.Add(`clan`, fmt.Sprintf(`Joined the clan <ansi fg="clantag">%s</ansi>`, applyClan.ClanName))
+			user.SendText(fmt.Sprintf(`You joined the clan <ansi fg="clantag">[%s]</ansi> <ansi fg="white-bold">%s</ansi>!`, applyClan.ClanTag, applyClan.ClanName))
+			sendToClan(applyClan, fmt.Sprintf(`<ansi fg="username">%s</ansi> joined the clan!`, user.Character.Name), user.UserId)
+
+			return true, nil
+		}
+
+		if applyClan.HasApplied(user.UserId) {
+			user.SendText(`You have already applied to that clan.`)
+			return true, nil
+		}
+
+		applyClan.Apply(user.UserId, user.Character.Name)
+
+		user.SendText(fmt.Sprintf(`You applied to join <ansi fg="clantag">[%s]</ansi> <ansi fg="white-bold">%s</ansi>.`, applyClan.ClanTag, applyClan.ClanName))
+
+		for _, m := range applyClan.Members {
+			if !applyClan.CanAccept(m.UserId) {
+				continue
+			}
+			if u := users.GetByUserId(m.UserId); u != nil {
+				u.SendText(fmt.Sprintf(`<ansi fg="clantag">[%s]</ansi> <ansi fg="username">%s</ansi> applied to join the clan. Type <ansi fg="command">clan accept %s</ansi> to let them in.`, applyClan.ClanTag, user.Character.Name, user.Character.Name))
+			}
+		}
+
+		return true, nil
+	}
+
+	//
+	// Everything after this point requires a clan
+	//
+
+	if currentClan == nil {
+		user.SendText(`You are not in a clan.`)
+		return true, nil
+	}
+
+	if clanCommand == `say` || clanCommand == `chat` {
+
+		if rest == `` {
+			user.SendText(`What do you want to say?`)
+			return true, nil
+		}
+
+		sendToClan(currentClan, fmt.Sprintf(`<ansi fg="username">%s</ansi> says, "<ansi fg="yellow">%s</ansi>"`, user.Character.Name, rest), user.UserId)
+
+		user.SendText(fmt.Sprintf(`<ansi fg="clantag">[%s]</ansi> You say, "<ansi fg="yellow">%s</ansi>"`, currentClan.ClanTag, rest))
+
+		events.AddToQueue(events.Communication{
+			SourceUserId: user.UserId,
+			CommType:     `clan`,
+			Name:         user.Character.Name,
+			Message:      rest,
+		})
+
+		return true, nil
+	}
+
+	if clanCommand == `accept` {
+
+		if !currentClan.CanAccept(user.UserId) {
+			user.SendText(`Only clan leaders and lieutenants can accept applications.`)
+			return true, nil
+		}
+
+		if rest == `` {
+			user.SendText(`Accept whose application?`)
+			return true, nil
+		}
+
+		application, found := currentClan.FindApplication(rest)
+		if !found {
+			user.SendText(fmt.Sprintf(`%s hasn't applied to the clan.`, rest))
+			return true, nil
+		}
+
+		if err := currentClan.AddMember(application.UserId, application.CharacterName); err != nil {
+			user.SendText(err.Error())
+			return true, nil
+		}
+
+		if u := users.GetByUserId(application.UserId); u != nil {
+			u.EventLog.Add(`clan`, fmt.Sprintf(`Joined the clan <ansi fg="clantag">%s</ansi>`, currentClan.ClanName))
+			u.SendText(fmt.Sprintf(`Your application was accepted. You are now a member of <ansi fg="clantag">[%s]</ansi> <ansi fg="white-bold">%s</ansi>!`, currentClan.ClanTag, currentClan.ClanName))
+		}
+
+		sendToClan(currentClan, fmt.Sprintf(`<ansi fg="username">%s</ansi> accepted <ansi fg="username">%s</ansi> into the clan!`, user.Character.Name, application.CharacterName), application.UserId)
+
+		return true, nil
+	}
+
+	if clanCommand == `invite` {
+
+		if !currentClan.IsLeader(user.UserId) {
+			user.SendText(`Only clan leaders can invite players.`)
+			return true, nil
+		}
+
+		if rest == `` {
+			user.SendText(`Invite who?`)
+			return true, nil
+		}
+
+		invitedUser := users.GetByCharacterName(rest)
+		if invitedUser == nil {
+			user.SendText(fmt.Sprintf(`%s isn't online.`, rest))
+			return true, nil
+		}
+
+		if clans.GetByUserId(invitedUser.UserId) != nil {
+			user.SendText(fmt.Sprintf(`<ansi fg="username">%s</ansi> is already in a clan.`, invitedUser.Character.Name))
+			return true, nil
+		}
+
+		// Already applied? Then an invite is as good as accepting them.
+		if currentClan.HasApplied(invitedUser.UserId) {
+			return Clan(`accept `+invitedUser.Character.Name, user, room, flags)
+		}
+
+		currentClan.Invite(invitedUser.UserId, invitedUser.Character.Name)
+
+		user.SendText(fmt.Sprintf(`You invited <ansi fg="username">%s</ansi> to join the clan.`, invitedUser.Character.Name))
+		invitedUser.SendText(fmt.Sprintf(`<ansi fg="username">%s</ansi> invited you to join <ansi fg="clantag">[%s]</ansi> <ansi fg="white-bold">%s</ansi>. Type <ansi fg="command">clan join %s</ansi> to accept.`, user.Character.Name, currentClan.ClanTag, currentClan.ClanName, currentClan.ClanTag))
+
+		return true, nil
+	}
+
+	if clanCommand == `kick` {
+
+		if !currentClan.IsLeader(user.UserId) {
+			user.SendText(`Only clan leaders can kick members.`)
+			return true, nil
+		}
+
+		member, found := currentClan.FindMember(rest)
+		if !found {
+			user.SendText(fmt.Sprintf(`%s is not a member of the clan.`, rest))
+			return true, nil
+		}
+
+		if member.UserId == user.UserId {
+			user.SendText(`Use <ansi fg="command">clan leave</ansi> to leave the clan.`)
+			return true, nil
+		}
+
+		if member.Rank == clans.ClanRankLeader {
+			user.SendText(`You can't kick another leader.`)
+			return true, nil
+		}
+
+		currentClan.RemoveMember(member.UserId)
+
+		if u := users.GetByUserId(member.UserId); u != nil {
+			u.EventLog.Add(`clan`, fmt.Sprintf(`Kicked from the clan <ansi fg="clantag">%s</ansi>`, currentClan.ClanName))
+			u.SendText(fmt.Sprintf(`You were kicked from <ansi fg="clantag">[%s]</ansi> <ansi fg="white-bold">%s</ansi>.`, currentClan.ClanTag, currentClan.ClanName))
+		}
+
+		sendToClan(currentClan, fmt.Sprintf(`<ansi fg="username">%s</ansi> was kicked from the clan.`, member.CharacterName))
+
+		return true, nil
+	}
+
+	if clanCommand == `promote` {
+
+		if !currentClan.IsLeader(user.UserId) {
+			user.SendText(`Only clan leaders can promote members.`)
+			return true, nil
+		}
+
+		member, found := currentClan.FindMember(rest)
+		if !found {
+			user.SendText(fmt.Sprintf(`%s is not a member of the clan.`, rest))
+			return true, nil
+		}
+
+		newRank, ok := currentClan.Promote(member.UserId)
+		if !ok {
+			user.SendText(fmt.Sprintf(`<ansi fg="username">%s</ansi> can't be promoted any higher.`, member.CharacterName))
+			return true, nil
+		}
+
+		if u := users.GetByUserId(member.UserId); u != nil {
+			u.EventLog.Add(`clan`, fmt.Sprintf(`Promoted to %s of the clan <ansi fg="clantag">%s</ansi>`, newRank, currentClan.ClanName))
+		}
+
+		sendToClan(currentClan, fmt.Sprintf(`<ansi fg="username">%s</ansi> was promoted to <ansi fg="white-bold">%s</ansi>.`, member.CharacterName, newRank))
+
+		return true, nil
+	}
+
+	if clanCommand == `leave` || clanCommand == `quit` {
+
+		if len(currentClan.Members) == 1 {
+			clans.Disband(currentClan)
+			user.EventLog.Add(`clan`, fmt.Sprintf(`Disbanded the clan <ansi fg="clantag">%s</ansi>`, currentClan.ClanName))
+			user.SendText(`You were the last member, so the clan has been disbanded. Anything left in the clan bank will be mailed to you.`)
+			return true, nil
+		}
+
+		currentClan.RemoveMember(user.UserId)
+
+		user.EventLog.Add(`clan`, fmt.Sprintf(`Left the clan <ansi fg="clantag">%s</ansi>`, currentClan.ClanName))
+		user.SendText(fmt.Sprintf(`You left <ansi fg="clantag">[%s]</ansi> <ansi fg="white-bold">%s</ansi>.`, currentClan.ClanTag, currentClan.ClanName))
+
+		sendToClan(currentClan, fmt.Sprintf(`<ansi fg="username">%s</ansi> left the clan.`, user.Character.Name))
+
+		return true, nil
+	}
+
+	if clanCommand == `disband` {
+
+		if !currentClan.IsLeader(user.UserId) {
+			user.SendText(`Only clan leaders can disband the clan.`)
+			return true, nil
+		}
+
+		sendToClan(currentClan, fmt.Sprintf(`<ansi fg="username">%s</ansi> disbanded the clan.`, user.Character.Name), user.UserId)
+
+		clans.Disband(currentClan)
+
+		mudlog.Info("Clans", "action", "disbanded", "clantag", currentClan.ClanTag, "userId", user.UserId)
+
+		user.EventLog.Add(`clan`, fmt.Sprintf(`Disbanded the clan <ansi fg="clantag">%s</ansi>`, currentClan.ClanName))
+		user.SendText(`You disbanded the clan. Anything left in the clan bank will be mailed to you.`)
+
+		return true, nil
+	}
+
+	if clanCommand == `donate` {
+
+		if rest == `` {
+			user.SendText(`Usage: <ansi fg="command">clan donate [gold amount|item]</ansi>`)
+			return true, nil
+		}
+
+		if amount, err := strconv.Atoi(strings.TrimSuffix(rest, ` gold`)); err == nil {
+
+			if amount < 1 {
+				user.SendText(`You must donate more than zero gold.`)
+				return true, nil
+			}
+
+			if amount > user.Character.Gold {
+				user.SendText(`You don't have that much gold on hand.`)
+				return true, nil
+			}
+
+			user.Character.Gold -= amount
+			currentClan.Donate(user.UserId, amount, items.Item{})
+
+			events.AddToQueue(events.EquipmentChange{
+				UserId:     user.UserId,
+				GoldChange: -amount,
+			})
+
+			user.SendText(fmt.Sprintf(`You donate <ansi fg="gold">%d gold</ansi> to the clan bank.`, amount))
+			sendToClan(currentClan, fmt.Sprintf(`<ansi fg="username">%s</ansi> donated <ansi fg="gold">%d gold</ansi> to the clan bank.`, user.Character.Name, amount), user.UserId)
+
+			return true, nil
+		}
+
+		itm, found := user.Character.FindInBackpack(rest)
+		if !found {
+			user.SendText(fmt.Sprintf(`You don't have a %s to donate.`, rest))
+			return true, nil
+		}
+
+		user.Character.RemoveItem(itm)
+		currentClan.Donate(user.UserId, 0, itm)
+
+		events.AddToQueue(events.ItemOwnership{
+			UserId: user.UserId,
+			Item:   itm,
+			Gained: false,
+		})
+
+		user.SendText(fmt.Sprintf(`You donate the <ansi fg="itemname">%s</ansi> to the clan bank.`, itm.DisplayName()))
+		sendToClan(currentClan, fmt.Sprintf(`<ansi fg="username">%s</ansi> donated <ansi fg="itemname">%s</ansi> to the clan bank.`, user.Character.Name, itm.DisplayName()), user.UserId)
+
+		return true, nil
+	}
+
+	if clanCommand == `bank` {
+
+		bankArgs := util.SplitButRespectQuotes(rest)
+
+		if len(bankArgs) == 0 {
+
+			user.SendText(fmt.Sprintf(`The clan bank holds <ansi fg="gold">%d gold</ansi>. Daily upkeep is <ansi fg="gold">%d gold</ansi>.`, currentClan.Gold, currentClan.DailyUpkeep()))
+
+			itemNames := []string{}
+			for _, itm := range currentClan.Items {
+				itemNames = append(itemNames, itm.NameComplex())
+			}
+
+			if len(itemNames) > 0 {
+				user.SendText(`Items: ` + strings.Join(itemNames, `, `))
+			}
+
+			if len(currentClan.Donations) > 0 {
+
+				rows := [][]string{}
+				for i := len(currentClan.Donations) - 1; i >= 0; i-- {
+					d := currentClan.Donations[i]
+
+					donatedBy := `-`
+					if m, ok := currentClan.GetMember(d.UserId); ok {
+						donatedBy = m.CharacterName
+					} else if u := users.GetByUserId(d.UserId); u != nil {
+						donatedBy = u.Character.Name
+					}
+
+					donated := fmt.Sprintf(`%d gold`, d.Gold)
+					if d.Item.ItemId != 0 {
+						donated = d.Item.DisplayName()
+					}
+
+					rows = append(rows, []string{d.Date.Format(`2006-01-02 15:04`), donatedBy, donated})
+				}
+
+				donationTable := templates.GetTable(`Recent Donations`, []string{`Date`, `Member`, `Donation`}, rows)
+				tplTxt, _ := templates.Process("tables/generic", donationTable, user.UserId)
+				user.SendText(tplTxt)
+			}
+
+			return true, nil
+		}
+
+		if strings.ToLower(bankArgs[0]) != `withdraw` || len(bankArgs) < 2 {
+			user.SendText(`Usage: <ansi fg="command">clan bank withdraw [gold amount|item]</ansi>`)
+			return true, nil
+		}
+
+		if !currentClan.IsLeader(user.UserId) {
+			user.SendText(`Only clan leaders can withdraw from the clan bank.`)
+			return true, nil
+		}
+
+		withdrawWhat := strings.Join(bankArgs[1:], ` `)
+
+		if amount, err := strconv.Atoi(strings.TrimSuffix(withdrawWhat, ` gold`)); err == nil {
+
+			if amount < 1 {
+				user.SendText(`You must withdraw more than zero gold.`)
+				return true, nil
+			}
+
+			if err := currentClan.WithdrawGold(amount); err != nil {
+				user.SendText(err.Error())
+				return true, nil
+			}
+
+			user.Character.Gold += amount
+
+			events.AddToQueue(events.EquipmentChange{
+				UserId:     user.UserId,
+				GoldChange: amount,
+			})
+
+			user.SendText(fmt.Sprintf(`You withdraw <ansi fg="gold">%d gold</ansi> from the clan bank.`, amount))
+			sendToClan(currentClan, fmt.Sprintf(`<ansi fg="username">%s</ansi> withdrew <ansi fg="gold">%d gold</ansi> from the clan bank.`, user.Character.Name, amount), user.UserId)
+
+			return true, nil
+		}
+
+		itm, found := currentClan.FindItem(withdrawWhat)
+		if !found {
+			user.SendText(fmt.Sprintf(`There is no %s in the clan bank.`, withdrawWhat))
+			return true, nil
+		}
+
+		if !user.Character.StoreItem(itm) {
+			user.SendText(`You can't carry that!`)
+			return true, nil
+		}
+
+		currentClan.WithdrawItem(withdrawWhat)
+
+		events.AddToQueue(events.ItemOwnership{
+			UserId: user.UserId,
+			Item:   itm,
+			Gained: true,
+		})
+
+		user.SendText(fmt.Sprintf(`You take the <ansi fg="itemname">%s</ansi> from the clan bank.`, itm.DisplayName()))
+		sendToClan(currentClan, fmt.Sprintf(`<ansi fg="username">%s</ansi> took <ansi fg="itemname">%s</ansi> from the clan bank.`, user.Character.Name, itm.DisplayName()), user.UserId)
+
+		return true, nil
+	}
+
+	if clanCommand == `claim` {
+
+		if !currentClan.IsLeader(user.UserId) {
+			user.SendText(`Only clan leaders can claim a zone.`)
+			return true, nil
+		}
+
+		if room.Zone == `` {
+			user.SendText(`This place can't be claimed.`)
+			return true, nil
+		}
+
+		if strings.EqualFold(currentClan.Zone, room.Zone) {
+			user.SendText(fmt.Sprintf(`Your clan already controls <ansi fg="magenta-bold">%s</ansi>.`, room.Zone))
+			return true, nil
+		}
+
+		if err := currentClan.ClaimZone(room.Zone, int(clanConfig.ClaimCost)); err != nil {
+			if err == clans.ErrNotEnoughGold {
+				user.SendText(fmt.Sprintf(`Claiming a zone costs <ansi fg="gold">%d gold</ansi> from the clan bank, which only holds <ansi fg="gold">%d gold</ansi>.`, clanConfig.ClaimCost, currentClan.Gold))
+			} else {
+				user.SendText(err.Error())
+			}
+			return true, nil
+		}
+
+		mudlog.Info("Clans", "action", "claim", "clantag", currentClan.ClanTag, "zone", room.Zone, "userId", user.UserId)
+
+		sendToClan(currentClan, fmt.Sprintf(`<ansi fg="username">%s</ansi> claimed <ansi fg="magenta-bold">%s</ansi> for the clan!`, user.Character.Name, room.Zone))
+
+		return true, nil
+	}
+
+	infoOutput, _ := templates.Process("help/clan", nil, user.UserId)
+	user.SendText(infoOutput)
+
+	return true, nil
+}
+
+// Sends a message to every online member of a clan, except any excluded user ids
+func sendToClan(c *clans.ClanInfo, msg string, excludeUserIds ...int) {
+
+	for _, uid := range c.GetMemberIds() {
+
+		excluded := false
+		for _, exId := range excludeUserIds {
+			if exId == uid {
+				excluded = true
+				break
+			}
+		}
+
+		if excluded {
+			continue
+		}
+
+		if u := users.GetByUserId(uid); u != nil {
+			u.SendText(fmt.Sprintf(`<ansi fg="clantag">[%s]</ansi> %s`, c.ClanTag, msg))
+		}
+	}
+}
+
+func sendClanInfo(c *clans.ClanInfo, user *users.UserRecord) {
+
+	zone := c.Zone
+	if zone == `` {
+		zone = `None`
+	}
+
+	user.SendText(``)
+	user.SendText(fmt.Sprintf(`<ansi fg="clantag">[%s]</ansi> <ansi fg="white-bold">%s</ansi>`, c.ClanTag, c.ClanName))
+	user.SendText(fmt.Sprintf(`  Founded:  %s`, c.Created.Format(`2006-01-02`)))
+	user.SendText(fmt.Sprintf(`  Controls: <ansi fg="magenta-bold">%s</ansi>`, zone))
+
+	if c.IsMember(user.UserId) {
+		user.SendText(fmt.Sprintf(`  Bank:     <ansi fg="gold">%d gold</ansi> (upkeep <ansi fg="gold">%d gold</ansi> a day)`, c.Gold, c.DailyUpkeep()))
+	}
+
+	headers := []string{`Name`, `Rank`, `Joined`, `Status`}
+	rows := [][]string{}
+
+	for _, m := range c.Members {
+		status := `offline`
+		if u := users.GetByUserId(m.UserId); u != nil {
+			status = `online`
+		}
+		rows = append(rows, []string{m.CharacterName, string(m.Rank), m.Joined.Format(`2006-01-02`), status})
+	}
+
+	formatting := []string{
+		`<ansi fg="username">%s</ansi>`,
+		`<ansi fg="white-bold">%s</ansi>`,
+		`<ansi fg="yellow">%s</ansi>`,
+		`<ansi fg="black-bold">%s</ansi>`,
+	}
+
+	memberTable := templates.GetTable(`Clan Members`, headers, rows, formatting)
+	tplTxt, _ := templates.Process("tables/generic", memberTable, user.UserId)
+	user.SendText(tplTxt)
+
+	// Leaders and lieutenants can see who is waiting to join
+	if !c.CanAccept(user.UserId) {
+		return
+	}
+
+	rows = [][]string{}
+	for _, m := range c.Applications {
+		rows = append(rows, []string{m.CharacterName, `applied`, m.Joined.Format(`2006-01-02`)})
+	}
+	for _, m := range c.Invites {
+		rows = append(rows, []string{m.CharacterName, `invited`, m.Joined.Format(`2006-01-02`)})
+	}
+
+	if len(rows) > 0 {
+		pendingTable := templates.GetTable(`Pending`, []string{`Name`, `Status`, `Since`}, rows)
+		tplTxt, _ = templates.Process("tables/generic", pendingTable, user.UserId)
+		user.SendText(tplTxt)
+	}
+}
@@ -0,0 +1,179 @@
+package usercommands
+
+import (
+	"fmt"
+
+	"github.com/GoMudEngine/GoMud/internal/buffs"
+	"github.com/GoMudEngine/GoMud/internal/events"
+	"github.com/GoMudEngine/GoMud/internal/items"
+	"github.com/GoMudEngine/GoMud/internal/recipes"
+	"github.com/GoMudEngine/GoMud/internal/rooms"
+	"github.com/GoMudEngine/GoMud/internal/scripting"
+	"github.com/GoMudEngine/GoMud/internal/skills"
+	"github.com/GoMudEngine/GoMud/internal/users"
+)
+
+func Craft(rest string, user *users.UserRecord, room *rooms.Room, flags events.EventFlag) (bool, error) {
+
+	if rest == `` {
+		return Recipes(``, user, room, flags)
+	}
+
+	recipe := recipes.FindRecipe(rest, recipes.GetKnownRecipeIds(user.Character.Recipes))
+	if recipe == nil {
+		user.SendText(fmt.Sprintf(`You don't know how to make "%s". Type <ansi fg="command">recipes</ansi> to see what you can make.`, rest))
+		return true, nil
+	}
+
+	if recipe.Station != `` && !room.HasCraftStation(recipe.Station) {
+		user.SendText(fmt.Sprintf(`You need to be at a <ansi fg="magenta">%s</ansi> station to make that.`, recipe.Station))
+		return true, nil
+	}
+
+	skillLevels := map[string]int{}
+	for skillName := range recipe.Skills {
+		skillLevels[skillName] = user.Character.GetSkillLevel(skills.SkillTag(skillName))
+	}
+
+	if !recipe.HasSkills(skillLevels) {
+		user.SendText(fmt.Sprintf(`You aren't skilled enough to make that. It requires: <ansi fg="skill">%s</ansi>`, recipeSkillText(recipe)))
+		return true, nil
+	}
+
+	// Tools can be held or in the backpack, and are never consumed
+	carried := append(user.Character.GetAllBackpackItems(), user.Character.Equipment.Weapon, user.Character.Equipment.Offhand)
+	toolItems := []items.Item{}
+
+	for _, tool := range recipe.Tools {
+		found := false
+		for _, itm := range carried {
+			if itm.ItemId < 1 || itemInList(itm, toolItems) {
+				continue
+			}
+			if tool.Matches(itm) {
+				toolItems = append(toolItems, itm)
+				found = true
+				break
+			}
+		}
+		if !found {
+			user.SendText(fmt.Sprintf(`You need a <ansi fg="itemname">%s</ansi> to make that.`, tool.Name()))
+			return true, nil
+		}
+	}
+
+	inputItems := []items.Item{}
+
+	for _, input := range recipe.Inputs {
+		needed := input.Quantity
+		for _, itm := range user.Character.GetAllBackpackItems() {
+			if needed == 0 {
+				break
+			}
+			if itm.ItemId != input.ItemId || itemInList(itm, toolItems) || itemInList(itm, inputItems) {
+				continue
+			}
+			// Only take as many as are needed from a stack
+			take := min(itm.GetQuantity(), needed)
+			itm.SetQuantity(take)
+			inputItems = append(inputItems, itm)
+			needed -= take
+		}
+		if needed > 0 {
+			user.SendText(fmt.Sprintf(`You don't have all of the ingredients. It requires: %s`, recipeInputText(recipe)))
+			return true, nil
+		}
+	}
+
+	extraWeight := 0
+	if outputSpec := items.GetItemSpec(recipe.Output.ItemId); outputSpec != nil {
+		extraWeight = outputSpec.Weight * recipe.Output.Quantity
+	}
+	for _, itm := range inputItems {
+		extraWeight -= itm.GetWeight()
+	}
+
+	if !user.Character.CanCarryWeight(extraWeight) {
+		user.SendText(`You can't carry what you would make.`)
+		return true, nil
+	}
+
+	quality := recipes.QualityNormal
+	if recipe.Quality {
+		quality = recipes.RollQuality(recipe.SkillSurplus(skillLevels))
+	}
+
+	outputItems := []items.Item{}
+	for i := 0; i < recipe.Output.Quantity; i++ {
+		newItem, err := recipe.NewOutputItem(quality)
+		if err != nil {
+			user.SendText(`Something went wrong, and you make nothing.`)
+			return true, err
+		}
+		outputItems = append(outputItems, newItem)
+	}
+
+	user.Character.CancelBuffsWithFlag(buffs.Hidden)
+
+	for _, itm := range inputItems {
+		user.Character.RemoveItem(itm)
+
+		events.AddToQueue(events.ItemOwnership{
+			UserId: user.UserId,
+			Item:   itm,
+			Gained: false,
+		})
+	}
+
+	for _, itm := range outputItems {
+		user.Character.StoreItem(itm)
+
+		events.AddToQueue(events.ItemOwnership{
+			UserId: user.UserId,
+			Item:   itm,
+			Gained: true,
+		})
+	}
+
+	craftedName := outputItems[0].DisplayName()
+	if len(outputItems) > 1 {
+		craftedName = fmt.Sprintf(`%d %s`, len(outputItems), craftedName)
+	}
+
+	user.SendText(fmt.Sprintf(`You craft <ansi fg="itemname">%s</ansi>.`, craftedName))
+	room.SendText(fmt.Sprintf(`<ansi fg="username">%s</ansi> crafts <ansi fg="itemname">%s</ansi>.`, user.Character.Name, craftedName), user.UserId)
+
+	switch quality {
+	case recipes.QualityPoor:
+		user.SendText(`It didn't turn out very well.`)
+	case recipes.QualityFine:
+		user.SendText(`<ansi fg="yellow">It turned out better than usual!</ansi>`)
+	case recipes.QualityMasterwork:
+		user.SendText(`<ansi fg="yellow-bold">It's a masterpiece!</ansi>`)
+	}
+
+	for _, itm := range outputItems {
+		events.AddToQueue(events.ItemCrafted{
+			UserId:   user.UserId,
+			RecipeId: recipe.RecipeId,
+			Item:     itm,
+		})
+
+		scripting.TryItemScriptEvent(`onCraft`, itm, user.UserId)
+	}
+
+	if recipe.Experience > 0 {
+		user.GrantXP(recipe.Experience, `crafting`)
+	}
+
+	return true, nil
+}
+
+func itemInList(itm items.Item, list []items.Item) bool {
+	for _, other := range list {
+		if itm.Equals(other) {
+			return true
+		}
+	}
+	return false
+}
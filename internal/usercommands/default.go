@@ -28,6 +28,12 @@ func Default(rest string, user *users.UserRecord, room *rooms.Room, flags events
 		return true, nil
 	}
 
+	// If a post office, "mail"
+	if room.IsPostOffice {
+		Mail(``, user, room, flags)
+		return true, nil
+	}
+
 	// If a storage location, "storage"
 	if room.IsStorage {
 		Storage(``, user, room, flags)
@@ -23,7 +23,7 @@ func Drop(rest string, user *users.UserRecord, room *rooms.Room, flags events.Ev
 		return true, nil
 	}
 
-	if args[0] == "all" {
+	if args[0] == "all" && len(args) == 1 {
 
 		iCopies := []items.Item{}
 
@@ -34,7 +34,7 @@ func Drop(rest string, user *users.UserRecord, room *rooms.Room, flags events.Ev
 		iCopies = append(iCopies, user.Character.Items...)
 
 		for _, item := range iCopies {
-			Drop(item.Name(), user, room, flags)
+			Drop(`all `+item.ShorthandId(), user, room, flags)
 		}
 
 		return true, nil
@@ -74,15 +74,20 @@ func Drop(rest string, user *users.UserRecord, room *rooms.Room, flags events.Ev
 		return true, nil
 	}
 
+	// Drop 5 arrows, drop all potion
+	quantity, itemName := util.SplitQuantity(rest)
+
 	// Check whether the user has an item in their inventory that matches
-	matchItem, found := user.Character.FindInBackpack(rest)
+	matchItem, found := user.Character.FindInBackpack(itemName)
 
 	if !found {
-		user.SendText(fmt.Sprintf("You don't have a %s to drop.", rest))
+		user.SendText(fmt.Sprintf("You don't have a %s to drop.", itemName))
 	} else {
 
 		user.Character.CancelBuffsWithFlag(buffs.Hidden)
 
+		matchItem.TakeQuantity(quantity, user.Character.CountInBackpack(matchItem))
+
 		iSpec := matchItem.GetSpec()
 
 		// Swap the item location
@@ -95,10 +100,10 @@ func Drop(rest string, user *users.UserRecord, room *rooms.Room, flags events.Ev
 		})
 
 		user.SendText(
-			fmt.Sprintf(`You drop the <ansi fg="item">%s</ansi>.`, matchItem.DisplayName()),
+			fmt.Sprintf(`You drop the <ansi fg="item">%s</ansi>.`, matchItem.QuantityDisplayName()),
 		)
 		room.SendText(
-			fmt.Sprintf(`<ansi fg="username">%s</ansi> drops their <ansi fg="item">%s</ansi>...`, user.Character.Name, matchItem.DisplayName()),
+			fmt.Sprintf(`<ansi fg="username">%s</ansi> drops their <ansi fg="item">%s</ansi>...`, user.Character.Name, matchItem.QuantityDisplayName()),
 			user.UserId,
 		)
 
@@ -21,7 +21,8 @@ func Get(rest string, user *users.UserRecord, room *rooms.Room, flags events.Eve
 		return true, nil
 	}
 
-	if args[0] == "all" {
+	// "get all from bag" and "get all potion" are handled further down
+	if args[0] == "all" && len(args) == 1 {
 		if room.Gold > 0 {
 			Get(`gold`, user, room, flags)
 		}
@@ -30,7 +31,7 @@ func Get(rest string, user *users.UserRecord, room *rooms.Room, flags events.Eve
 			iCopies := append([]items.Item{}, room.Items...)
 
 			for _, item := range iCopies {
-				Get(item.Name(), user, room, flags)
+				Get(`all `+item.ShorthandId(), user, room, flags)
 			}
 		}
 
@@ -40,6 +41,7 @@ func Get(rest string, user *users.UserRecord, room *rooms.Room, flags events.Eve
 	getFromStash := false
 	containerName := ``
 	petUserId := 0
+	bagItem := items.Item{}
 
 	if len(args) >= 2 {
 		// Detect "stash" or "from stash" at end and remove it
@@ -97,11 +99,73 @@ func Get(rest string, user *users.UserRecord, room *rooms.Room, flags events.Eve
 		}
 	}
 
+	// Look for a bag, quiver, etc. they are carrying
+	if len(args) >= 2 && containerName == `` && petUserId == 0 {
+		if bag, found := user.Character.FindContainerInBackpack(args[len(args)-1]); found {
+			bagItem = bag
+			if args[len(args)-2] == "from" {
+				rest = strings.Join(args[0:len(args)-2], " ")
+			} else {
+				rest = strings.Join(args[0:len(args)-1], " ")
+			}
+		}
+	}
+
+	if bagItem.ItemId > 0 {
+
+		if bagItem.Lock.IsLocked() {
+			user.SendText(fmt.Sprintf(`The <ansi fg="itemname">%s</ansi> is locked.`, bagItem.DisplayName()))
+			return true, nil
+		}
+
+		if rest == `all` {
+			for _, itm := range append([]items.Item{}, bagItem.Contents...) {
+				Get(fmt.Sprintf(`all %s %s`, itm.ShorthandId(), args[len(args)-1]), user, room, flags)
+			}
+			return true, nil
+		}
+
+		quantity, itemName := util.SplitQuantity(rest)
+
+		matchItem, found := bagItem.FindContent(itemName)
+		if !found {
+			user.SendText(fmt.Sprintf(`You don't see a %s in your <ansi fg="itemname">%s</ansi>.`, itemName, bagItem.DisplayName()))
+			return true, nil
+		}
+
+		matchItem.TakeQuantity(quantity, items.QuantityIn(matchItem, bagItem.Contents...))
+
+		bagItem.RemoveContent(matchItem)
+		user.Character.UpdateItem(bagItem, bagItem)
+		user.Character.StoreItem(matchItem)
+
+		events.AddToQueue(events.ItemOwnership{
+			UserId:      user.UserId,
+			Item:        matchItem,
+			Gained:      true,
+			InContainer: true,
+		})
+
+		user.SendText(
+			fmt.Sprintf(`You take the <ansi fg="itemname">%s</ansi> out of your <ansi fg="itemname">%s</ansi>.`, matchItem.QuantityDisplayName(), bagItem.DisplayName()),
+		)
+		room.SendText(
+			fmt.Sprintf(`<ansi fg="username">%s</ansi> takes a <ansi fg="itemname">%s</ansi> out of their <ansi fg="itemname">%s</ansi>.`, user.Character.Name, matchItem.QuantityDisplayName(), bagItem.DisplayName()),
+			user.UserId,
+		)
+
+		return true, nil
+	}
+
 	if petUserId == user.UserId {
 
 		matchItem, found := user.Character.Pet.FindItem(rest)
 		if !found {
 			user.SendText(fmt.Sprintf(`You don't see a %s carried by %s.`, rest, user.Character.Pet.DisplayName()))
+		} else if !user.Character.CanCarry(matchItem) {
+			user.SendText(
+				fmt.Sprintf(`The <ansi fg="itemname">%s</ansi> is too heavy for you to carry right now (<ansi fg="command">help encumbrance</ansi>).`, matchItem.DisplayName()),
+			)
 		} else {
 
 			if user.Character.Pet.RemoveItem(matchItem) {
@@ -165,12 +229,30 @@ func Get(rest string, user *users.UserRecord, room *rooms.Room, flags events.Eve
 			return true, nil
 		}
 
-		matchItem, found := container.FindItem(rest)
+		if rest == `all` {
+			for _, itm := range append([]items.Item{}, container.Items...) {
+				Get(fmt.Sprintf(`all %s %s`, itm.ShorthandId(), args[len(args)-1]), user, room, flags)
+			}
+			return true, nil
+		}
+
+		quantity, itemName := util.SplitQuantity(rest)
+
+		matchItem, found := container.FindItem(itemName)
 
 		if !found {
-			user.SendText(fmt.Sprintf(`You don't see a %s in the <ansi fg="container">%s</ansi>.`, rest, containerName))
+			user.SendText(fmt.Sprintf(`You don't see a %s in the <ansi fg="container">%s</ansi>.`, itemName, containerName))
 		} else {
 
+			matchItem.TakeQuantity(quantity, items.QuantityIn(matchItem, container.Items...))
+
+			if !user.Character.CanCarry(matchItem) {
+				user.SendText(
+					fmt.Sprintf(`The <ansi fg="itemname">%s</ansi> is too heavy for you to carry right now (<ansi fg="command">help encumbrance</ansi>).`, matchItem.DisplayName()),
+				)
+				return true, nil
+			}
+
 			user.Character.CancelBuffsWithFlag(buffs.Hidden) // No longer sneaking
 
 			// Trigger onFound event
@@ -187,10 +269,10 @@ func Get(rest string, user *users.UserRecord, room *rooms.Room, flags events.Eve
 				room.Containers[containerName] = container
 
 				user.SendText(
-					fmt.Sprintf(`You take the <ansi fg="itemname">%s</ansi> from the <ansi fg="container">%s</ansi>.`, matchItem.DisplayName(), containerName),
+					fmt.Sprintf(`You take the <ansi fg="itemname">%s</ansi> from the <ansi fg="container">%s</ansi>.`, matchItem.QuantityDisplayName(), containerName),
 				)
 				room.SendText(
-					fmt.Sprintf(`<ansi fg="username">%s</ansi> picks up the <ansi fg="itemname">%s</ansi> from the <ansi fg="container">%s</ansi>...`, user.Character.Name, matchItem.DisplayName(), containerName),
+					fmt.Sprintf(`<ansi fg="username">%s</ansi> picks up the <ansi fg="itemname">%s</ansi> from the <ansi fg="container">%s</ansi>...`, user.Character.Name, matchItem.QuantityDisplayName(), containerName),
 					user.UserId,
 				)
 
@@ -236,12 +318,14 @@ func Get(rest string, user *users.UserRecord, room *rooms.Room, flags events.Eve
 			return true, nil
 		}
 
+		quantity, itemName := util.SplitQuantity(rest)
+
 		// Check whether the user has an item in their inventory that matches
-		matchItem, found := room.FindOnFloor(rest, getFromStash)
+		matchItem, found := room.FindOnFloor(itemName, getFromStash)
 
 		// Check if user is specifying an item they stashed
 		if !found && !getFromStash {
-			stashItemMatch, stashFound := room.FindOnFloor(rest, true)
+			stashItemMatch, stashFound := room.FindOnFloor(itemName, true)
 			if stashFound && stashItemMatch.StashedBy == user.UserId {
 				found = true
 				getFromStash = true
@@ -251,11 +335,20 @@ func Get(rest string, user *users.UserRecord, room *rooms.Room, flags events.Eve
 
 		if found {
 
+			matchItem.TakeQuantity(quantity, room.CountOnFloor(matchItem, getFromStash))
+
 			if matchItem.HasAdjective(`exploding`) {
 				user.SendText(`You can't pick that up, it's about to explode!`)
 				return true, nil
 			}
 
+			if !user.Character.CanCarry(matchItem) {
+				user.SendText(
+					fmt.Sprintf(`The <ansi fg="itemname">%s</ansi> is too heavy for you to carry right now (<ansi fg="command">help encumbrance</ansi>).`, matchItem.DisplayName()),
+				)
+				return true, nil
+			}
+
 			user.Character.CancelBuffsWithFlag(buffs.Hidden) // No longer sneaking
 
 			// If it was in the stash, remove the stash owner tag
@@ -276,7 +369,7 @@ func Get(rest string, user *users.UserRecord, room *rooms.Room, flags events.Eve
 
 				if getFromStash {
 					user.SendText(
-						fmt.Sprintf(`You dig out the <ansi fg="itemname">%s</ansi> from where it was stashed.`, matchItem.DisplayName()),
+						fmt.Sprintf(`You dig out the <ansi fg="itemname">%s</ansi> from where it was stashed.`, matchItem.QuantityDisplayName()),
 					)
 					room.SendText(
 						fmt.Sprintf(`<ansi fg="username">%s</ansi> digs around in the area and picks something up...`, user.Character.Name),
@@ -284,10 +377,10 @@ func Get(rest string, user *users.UserRecord, room *rooms.Room, flags events.Eve
 					)
 				} else {
 					user.SendText(
-						fmt.Sprintf(`You pick up the <ansi fg="itemname">%s</ansi>.`, matchItem.DisplayName()),
+						fmt.Sprintf(`You pick up the <ansi fg="itemname">%s</ansi>.`, matchItem.QuantityDisplayName()),
 					)
 					room.SendText(
-						fmt.Sprintf(`<ansi fg="username">%s</ansi> picks up the <ansi fg="itemname">%s</ansi>...`, user.Character.Name, matchItem.DisplayName()),
+						fmt.Sprintf(`<ansi fg="username">%s</ansi> picks up the <ansi fg="itemname">%s</ansi>...`, user.Character.Name, matchItem.QuantityDisplayName()),
 						user.UserId,
 					)
 				}
@@ -70,6 +70,13 @@ func Give(rest string, user *users.UserRecord, room *rooms.Room, flags events.Ev
 
 		targetUser := users.GetByUserId(playerId)
 
+		if giveItem.ItemId > 0 && !targetUser.Character.CanCarry(giveItem) {
+			user.SendText(
+				fmt.Sprintf(`<ansi fg="username">%s</ansi> can't carry the weight of the <ansi fg="item">%s</ansi>.`, targetUser.Character.Name, giveItem.DisplayName()),
+			)
+			return true, nil
+		}
+
 		// Swap the item location
 		if giveItem.ItemId > 0 {
 			targetUser.Character.StoreItem(giveItem)
@@ -44,9 +44,9 @@ func Go(rest string, user *users.UserRecord, room *rooms.Room, flags events.Even
 			return true, nil
 		}
 
-		actionCost := 10
+		actionCost := 10 * (100 + user.Character.GetEncumbrance().MovementPenalty()) / 100
 		encumbered := false
-		if len(user.Character.Items) > user.Character.CarryCapacity() {
+		if user.Character.IsOverloaded() {
 			actionCost = 50
 			encumbered = true
 		}
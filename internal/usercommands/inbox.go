@@ -47,18 +47,7 @@ func Inbox(rest string, user *users.UserRecord, room *rooms.Room, flags events.E
 		user.SendText(border)
 
 		if !msg.Read {
-			if msg.Gold > 0 {
-				user.Character.Bank += msg.Gold
-
-				events.AddToQueue(events.EquipmentChange{
-					UserId:     user.UserId,
-					BankChange: msg.Gold,
-				})
-
-			}
-			if msg.Item != nil {
-				user.Character.StoreItem(*msg.Item)
-			}
+			deliverAttachments(user, msg)
 		}
 
 		user.Inbox[idx].Read = true
@@ -71,3 +60,25 @@ func Inbox(rest string, user *users.UserRecord, room *rooms.Room, flags events.E
 
 	return true, nil
 }
+
+// Gold and items attached to system mail (auctions, admins) are delivered when it is first read.
+// Player mail attachments are collected at a post office instead.
+func deliverAttachments(user *users.UserRecord, msg users.Message) {
+
+	if msg.IsPlayerMail() {
+		return
+	}
+
+	if msg.Gold > 0 {
+		user.Character.Bank += msg.Gold
+
+		events.AddToQueue(events.EquipmentChange{
+			UserId:     user.UserId,
+			BankChange: msg.Gold,
+		})
+
+	}
+	if msg.Item != nil {
+		user.Character.StoreItem(*msg.Item)
+	}
+}
@@ -4,6 +4,8 @@ import (
 	"fmt"
 	"strings"
 
+	"github.com/GoMudEngine/GoMud/internal/characters"
+	"github.com/GoMudEngine/GoMud/internal/configs"
 	"github.com/GoMudEngine/GoMud/internal/events"
 	"github.com/GoMudEngine/GoMud/internal/items"
 	"github.com/GoMudEngine/GoMud/internal/races"
@@ -21,27 +23,31 @@ func Inventory(rest string, user *users.UserRecord, room *rooms.Room, flags even
 	itemList := []items.Item{}
 
 	typeSearchTerms := map[string]items.ItemType{
-		`weapons`:   items.Weapon,
-		`offhand`:   items.Offhand,
-		`shields`:   items.Offhand,
-		`head`:      items.Head,
-		`neck`:      items.Neck,
-		`body`:      items.Body,
-		`armor`:     items.Body,
-		`belts`:     items.Belt,
-		`gloves`:    items.Gloves,
-		`rings`:     items.Ring,
-		`legs`:      items.Legs,
-		`pants`:     items.Legs,
-		`leggings`:  items.Legs,
-		`feet`:      items.Feet,
-		`potions`:   items.Potion,
-		`food`:      items.Food,
-		`drinks`:    items.Drink,
-		`scrolls`:   items.Scroll,
-		`grenades`:  items.Grenade,
-		`keys`:      items.Key,
-		`gemstones`: items.Gemstone,
+		`weapons`:    items.Weapon,
+		`offhand`:    items.Offhand,
+		`shields`:    items.Offhand,
+		`head`:       items.Head,
+		`neck`:       items.Neck,
+		`body`:       items.Body,
+		`armor`:      items.Body,
+		`belts`:      items.Belt,
+		`gloves`:     items.Gloves,
+		`rings`:      items.Ring,
+		`legs`:       items.Legs,
+		`pants`:      items.Legs,
+		`leggings`:   items.Legs,
+		`feet`:       items.Feet,
+		`potions`:    items.Potion,
+		`food`:       items.Food,
+		`drinks`:     items.Drink,
+		`scrolls`:    items.Scroll,
+		`grenades`:   items.Grenade,
+		`keys`:       items.Key,
+		`gemstones`:  items.Gemstone,
+		`bags`:       items.Container,
+		`containers`: items.Container,
+		`ammo`:       items.Ammo,
+		`arrows`:     items.Ammo,
 	}
 
 	subtypeSearchTerms := map[string]items.ItemSubType{
@@ -124,6 +130,14 @@ func Inventory(rest string, user *users.UserRecord, room *rooms.Room, flags even
 				iNameFormatted = fmt.Sprintf(`%s <ansi fg="uses-left">(%d)</ansi>`, iNameFormatted, item.Uses) // Display uses left
 			}
 		}
+		if item.GetQuantity() > 1 {
+			iName = fmt.Sprintf(`%s (x%d)`, iName, item.GetQuantity())
+			iNameFormatted = fmt.Sprintf(`%s <ansi fg="uses-left">(x%d)</ansi>`, iNameFormatted, item.GetQuantity())
+		}
+		if iSpec.Type == items.Container {
+			iName = fmt.Sprintf(`%s [%d/%d]`, iName, len(item.Contents), iSpec.Capacity)
+			iNameFormatted = fmt.Sprintf(`%s <ansi fg="uses-left">[%d/%d]</ansi>`, iNameFormatted, len(item.Contents), iSpec.Capacity)
+		}
 		itemNames = append(itemNames, iName)
 		itemNamesFormatted = append(itemNamesFormatted, iNameFormatted)
 	}
@@ -146,6 +160,14 @@ func Inventory(rest string, user *users.UserRecord, room *rooms.Room, flags even
 		`Count`:              fmt.Sprintf(`(%d/%d)`, len(itemList), user.Character.CarryCapacity()),
 	}
 
+	if configs.GetGamePlayConfig().Encumbrance.Enabled {
+		weightStr := fmt.Sprintf(`%d/%d`, user.Character.CarryWeight(), user.Character.MaxCarryWeight())
+		if encumbrance := user.Character.GetEncumbrance(); encumbrance != characters.Unencumbered {
+			weightStr += fmt.Sprintf(` <ansi fg="encumbrance-%s">(%s)</ansi>`, encumbrance, encumbrance)
+		}
+		invData[`Weight`] = weightStr
+	}
+
 	tplTxt, _ := templates.Process("character/inventory", invData, user.UserId)
 	user.SendText(tplTxt)
 
@@ -7,6 +7,7 @@ import (
 
 	"github.com/GoMudEngine/GoMud/internal/configs"
 	"github.com/GoMudEngine/GoMud/internal/events"
+	"github.com/GoMudEngine/GoMud/internal/items"
 	"github.com/GoMudEngine/GoMud/internal/rooms"
 	"github.com/GoMudEngine/GoMud/internal/templates"
 	"github.com/GoMudEngine/GoMud/internal/users"
@@ -80,6 +81,17 @@ func KeyRing(rest string, user *users.UserRecord, room *rooms.Room, flags events
 
 		row = append(row, keyType)
 
+		// Keys for containers that can be carried, like a strongbox
+		if itemIdStr, ok := strings.CutPrefix(lockId, `item-`); ok {
+			itemId, _ := strconv.Atoi(itemIdStr)
+			if iSpec := items.GetItemSpec(itemId); iSpec != nil && keyType == `Key` {
+				row = append(row, `-`, iSpec.Name, `-`)
+				keyRows = append(keyRows, row)
+				keyFormatting = append(keyFormatting, containerKeyFormatting)
+			}
+			continue
+		}
+
 		roomIdStr := strings.Split(lockId, `-`)[0]
 		lockId = lockId[len(roomIdStr)+1:]
 
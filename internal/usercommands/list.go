@@ -41,6 +41,7 @@ func List(rest string, user *users.UserRecord, room *rooms.Room, flags events.Ev
 		mercsAvailable := characters.Shop{}
 		buffsAvailable := characters.Shop{}
 		petsAvailable := characters.Shop{}
+		servicesAvailable := characters.Shop{}
 
 		for _, saleItem := range mob.Character.Shop.GetInstock() {
 
@@ -62,9 +63,13 @@ func List(rest string, user *users.UserRecord, room *rooms.Room, flags events.Ev
 				petsAvailable = append(petsAvailable, saleItem)
 			}
 
+			if saleItem.Service != `` {
+				servicesAvailable = append(servicesAvailable, saleItem)
+			}
+
 		}
 
-		if len(itemsAvailable) == 0 && len(mercsAvailable) == 0 && len(buffsAvailable) == 0 && len(petsAvailable) == 0 {
+		if len(itemsAvailable) == 0 && len(mercsAvailable) == 0 && len(buffsAvailable) == 0 && len(petsAvailable) == 0 && len(servicesAvailable) == 0 {
 			mob.Command(`say I have nothing to sell right now, but check again later.`)
 			continue
 		}
@@ -380,6 +385,31 @@ func List(rest string, user *users.UserRecord, room *rooms.Room, flags events.Ev
 			user.SendText(tplTxt)
 			user.SendText(fmt.Sprintf(`To buy a pet, type: <ansi fg="command">buy [name]</ansi>%s`, term.CRLFStr))
 		}
+
+		if len(servicesAvailable) > 0 {
+
+			headers := []string{"Service", "Price"}
+
+			rows := [][]string{}
+
+			for _, stockService := range servicesAvailable {
+
+				priceStr := `Varies`
+				if stockService.Price > 0 {
+					priceStr = strconv.Itoa(stockService.Price)
+				}
+
+				rows = append(rows, []string{
+					stockService.Service,
+					priceStr,
+				})
+			}
+
+			saleItemsData := templates.GetTable(fmt.Sprintf(`%s by <ansi fg="mobname">%s</ansi>`, colorpatterns.ApplyColorPattern(`Services`, `cyan`), mob.Character.Name), headers, rows)
+			tplTxt, _ := templates.Process("tables/shoplist", saleItemsData, user.UserId, user.UserId)
+			user.SendText(tplTxt)
+			user.SendText(fmt.Sprintf(`To repair something, type: <ansi fg="command">repair [name]</ansi>%s`, term.CRLFStr))
+		}
 	}
 
 	for _, uid := range room.GetPlayers(rooms.FindMerchant) {
@@ -137,7 +137,70 @@ func Lock(rest string, user *users.UserRecord, room *rooms.Room, flags events.Ev
 
 	}
 
+	if bag, found := user.Character.FindContainerInBackpack(args[0]); found {
+		return keyCarriedContainer(bag, true, user, room)
+	}
+
 	user.SendText("There is no such exit or container.")
 	return true, nil
 
 }
+
+// Locks or unlocks a bag, chest, etc. the user is carrying
+func keyCarriedContainer(bag items.Item, lock bool, user *users.UserRecord, room *rooms.Room) (bool, error) {
+
+	if !bag.HasLock() {
+		user.SendText(fmt.Sprintf(`The <ansi fg="itemname">%s</ansi> has no lock.`, bag.DisplayName()))
+		return true, nil
+	}
+
+	if lock == bag.Lock.IsLocked() {
+		if lock {
+			user.SendText("That's already locked.")
+		} else {
+			user.SendText("That's not locked.")
+		}
+		return true, nil
+	}
+
+	lockId := bag.LockId()
+	hasKey, _ := user.Character.HasKey(lockId, int(bag.Lock.Difficulty))
+
+	if !hasKey {
+
+		backpackKeyItm, hasBackpackKey := user.Character.FindKeyInBackpack(lockId)
+		if !hasBackpackKey {
+			user.SendText(`You do not have the key for that.`)
+			return true, nil
+		}
+
+		// Key entries look like:
+		// "key-item-<itemid>": "<itemid>"
+		user.Character.SetKey(`key-`+lockId, fmt.Sprintf(`%d`, backpackKeyItm.ItemId))
+		user.Character.RemoveItem(backpackKeyItm)
+
+		events.AddToQueue(events.ItemOwnership{
+			UserId: user.UserId,
+			Item:   backpackKeyItm,
+			Gained: false,
+		})
+
+		user.SendText(fmt.Sprintf(`You add your <ansi fg="item">%s</ansi> to your key ring for the future.`, backpackKeyItm.GetSpec().Name))
+	}
+
+	action := `unlock`
+	if lock {
+		action = `lock`
+		bag.Lock.SetLocked()
+	} else {
+		bag.Lock.SetUnlocked()
+	}
+	user.Character.UpdateItem(bag, bag)
+
+	room.PlaySound(`change`, `other`)
+
+	user.SendText(fmt.Sprintf(`You use a key to %s your <ansi fg="itemname">%s</ansi>.`, action, bag.DisplayName()))
+	room.SendText(fmt.Sprintf(`<ansi fg="username">%s</ansi> uses a key to %s their <ansi fg="itemname">%s</ansi>.`, user.Character.Name, action, bag.DisplayName()), user.UserId)
+
+	return true, nil
+}
@@ -173,7 +173,7 @@ func Look(rest string, user *users.UserRecord, room *rooms.Room, flags events.Ev
 			}
 
 			itemNames = append(itemNames, item.Name())
-			itemNamesFormatted = append(itemNamesFormatted, fmt.Sprintf(`<ansi fg="itemname">%s</ansi>`, item.DisplayName()))
+			itemNamesFormatted = append(itemNamesFormatted, fmt.Sprintf(`<ansi fg="itemname">%s</ansi>`, item.QuantityDisplayName()))
 		}
 
 		if len(container.Recipes) > 0 {
@@ -573,7 +573,7 @@ func lookRoom(user *users.UserRecord, roomId int, secretLook bool) {
 			room.RemoveItem(item, false)
 			continue
 		}
-		groundStuff = append(groundStuff, item.DisplayName())
+		groundStuff = append(groundStuff, item.QuantityDisplayName())
 	}
 
 	// Find stashed items
@@ -584,7 +584,7 @@ func lookRoom(user *users.UserRecord, roomId int, secretLook bool) {
 		if item.StashedBy != user.UserId {
 			continue
 		}
-		name := item.DisplayName() + ` <ansi fg="item-stashed">(stashed)</ansi>`
+		name := item.QuantityDisplayName() + ` <ansi fg="item-stashed">(stashed)</ansi>`
 		groundStuff = append(groundStuff, name)
 	}
 
@@ -0,0 +1,452 @@
+package usercommands
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/GoMudEngine/GoMud/internal/events"
+	"github.com/GoMudEngine/GoMud/internal/items"
+	"github.com/GoMudEngine/GoMud/internal/rooms"
+	"github.com/GoMudEngine/GoMud/internal/templates"
+	"github.com/GoMudEngine/GoMud/internal/term"
+	"github.com/GoMudEngine/GoMud/internal/users"
+)
+
+func Mail(rest string, user *users.UserRecord, room *rooms.Room, flags events.EventFlag) (bool, error) {
+
+	args := strings.Fields(rest)
+
+	mailCommand := `list`
+	if len(args) > 0 {
+		mailCommand = strings.ToLower(args[0])
+		args = args[1:]
+	}
+
+	if mailCommand == `list` {
+
+		headers := []string{`#`, `From`, `Sent`, `Attached`, `Status`}
+		rows := [][]string{}
+
+		for idx, msg := range user.Inbox {
+
+			attached := []string{}
+			if msg.Gold > 0 {
+				attached = append(attached, fmt.Sprintf(`%d gold`, msg.Gold))
+			}
+			if msg.Item != nil {
+				attached = append(attached, msg.Item.NameSimple())
+			}
+			if msg.COD > 0 && msg.HasAttachments() {
+				attached = append(attached, fmt.Sprintf(`COD %d gold`, msg.COD))
+			}
+			if len(attached) == 0 {
+				attached = append(attached, `-`)
+			}
+
+			status := `read`
+			if !msg.Read {
+				status = `NEW`
+			}
+
+			rows = append(rows, []string{strconv.Itoa(idx + 1), msg.FromName, msg.DateString(), strings.Join(attached, `, `), status})
+		}
+
+		if len(rows) == 0 {
+			rows = append(rows, []string{`-`, `No mail`, `-`, `-`, `-`})
+		}
+
+		formatting := []string{
+			`<ansi fg="red">%s</ansi>`,
+			`<ansi fg="username">%s</ansi>`,
+			`<ansi fg="mail-date">%s</ansi>`,
+			`<ansi fg="gold">%s</ansi>`,
+			`<ansi fg="alert-5">%s</ansi>`,
+		}
+
+		mailTable := templates.GetTable(`Mail`, headers, rows, formatting)
+		tplTxt, _ := templates.Process("tables/generic", mailTable, user.UserId)
+		user.SendText(tplTxt)
+
+		user.SendText(`Type <ansi fg="command">mail read [#]</ansi> to read a message, or <ansi fg="command">help mail</ansi> for more options.`)
+
+		return true, nil
+	}
+
+	if mailCommand == `read` {
+
+		idx, msg, ok := getMailMessage(args, user)
+		if !ok {
+			return true, nil
+		}
+
+		border := `<ansi fg="mail-border">` + strings.Repeat(`_`, 80) + `</ansi>`
+
+		user.SendText(border)
+		tplTxt, _ := templates.Process("mail/message", msg, user.UserId)
+		user.SendText(tplTxt)
+		user.SendText(border)
+
+		if !msg.Read {
+			deliverAttachments(user, msg)
+			user.Inbox[idx].Read = true
+		}
+
+		if msg.IsPlayerMail() {
+			user.SendText(fmt.Sprintf(`Type <ansi fg="command">mail reply %d</ansi> to reply.`, idx+1))
+			if msg.HasAttachments() {
+				user.SendText(fmt.Sprintf(`Type <ansi fg="command">mail take %d</ansi> at a post office to collect the attachments.`, idx+1))
+			}
+		}
+
+		return true, nil
+	}
+
+	if mailCommand == `delete` {
+
+		idx, msg, ok := getMailMessage(args, user)
+		if !ok {
+			return true, nil
+		}
+
+		// Uncollected attachments go back to whoever sent them
+		if msg.IsPlayerMail() && msg.HasAttachments() {
+
+			returnMsg := users.Message{
+				FromUserId: user.UserId,
+				FromName:   user.Character.Name,
+				Message:    `Returned to sender: ` + msg.Message,
+				Gold:       msg.Gold,
+				Item:       msg.Item,
+			}
+
+			if err := users.DeliverMail(msg.FromUserId, returnMsg); err != nil {
+				user.SendText(`That message can't be returned to the sender right now, so it can't be deleted.`)
+				return true, nil
+			}
+
+			user.SendText(fmt.Sprintf(`The attachments were returned to <ansi fg="username">%s</ansi>.`, msg.FromName))
+		}
+
+		// System mail attachments are only delivered on reading, so claim them before they are lost
+		if !msg.IsPlayerMail() && !msg.Read && msg.HasAttachments() {
+
+			deliverAttachments(user, msg)
+
+			if msg.Gold > 0 {
+				user.SendText(fmt.Sprintf(`<ansi fg="gold">%d gold</ansi> from the message was added to your bank.`, msg.Gold))
+			}
+			if msg.Item != nil {
+				user.SendText(fmt.Sprintf(`You take the <ansi fg="itemname">%s</ansi> attached to the message.`, msg.Item.DisplayName()))
+			}
+		}
+
+		user.Inbox.Remove(idx)
+		user.SendText(fmt.Sprintf(`Message %d deleted.`, idx+1))
+
+		return true, nil
+	}
+
+	//
+	// Everything else requires a post office
+	//
+	if mailCommand != `send` && mailCommand != `reply` && mailCommand != `take` {
+		user.SendText(`Type <ansi fg="command">help mail</ansi> to see how to use mail.`)
+		return true, nil
+	}
+
+	if !room.IsPostOffice {
+		user.SendText(`You must be at a post office to do that.`)
+		return true, nil
+	}
+
+	if mailCommand == `take` {
+
+		idx, msg, ok := getMailMessage(args, user)
+		if !ok {
+			return true, nil
+		}
+
+		if !msg.HasAttachments() {
+			user.SendText(`That message has nothing attached.`)
+			return true, nil
+		}
+
+		if msg.Item != nil && !user.Character.CanCarry(*msg.Item) {
+			user.SendText(`You can't carry any more (<ansi fg="command">help encumbrance</ansi>).`)
+			return true, nil
+		}
+
+		if msg.COD > 0 {
+
+			if user.Character.Gold < msg.COD {
+				user.SendText(fmt.Sprintf(`You need <ansi fg="gold">%d gold</ansi> on hand to pay for that.`, msg.COD))
+				return true, nil
+			}
+
+			paymentMsg := users.Message{
+				FromUserId: user.UserId,
+				FromName:   user.Character.Name,
+				Message:    `Payment for the mail you sent me.`,
+				Gold:       msg.COD,
+			}
+
+			if err := users.DeliverMail(msg.FromUserId, paymentMsg); err != nil {
+				user.SendText(`The payment can't be sent right now. Try again later.`)
+				return true, nil
+			}
+
+			user.Character.Gold -= msg.COD
+
+			events.AddToQueue(events.EquipmentChange{
+				UserId:     user.UserId,
+				GoldChange: -msg.COD,
+			})
+
+			user.SendText(fmt.Sprintf(`You pay <ansi fg="gold">%d gold</ansi> to <ansi fg="username">%s</ansi>.`, msg.COD, msg.FromName))
+		}
+
+		if msg.Gold > 0 {
+			user.Character.Gold += msg.Gold
+
+			events.AddToQueue(events.EquipmentChange{
+				UserId:     user.UserId,
+				GoldChange: msg.Gold,
+			})
+
+			user.SendText(fmt.Sprintf(`You collect <ansi fg="gold">%d gold</ansi>.`, msg.Gold))
+		}
+
+		if msg.Item != nil {
+			user.Character.StoreItem(*msg.Item)
+
+			events.AddToQueue(events.ItemOwnership{
+				UserId: user.UserId,
+				Item:   *msg.Item,
+				Gained: true,
+			})
+
+			user.SendText(fmt.Sprintf(`You collect the <ansi fg="itemname">%s</ansi>.`, msg.Item.DisplayName()))
+		}
+
+		user.Inbox[idx].Gold = 0
+		user.Inbox[idx].Item = nil
+		user.Inbox[idx].COD = 0
+		user.Inbox[idx].Read = true
+
+		return true, nil
+	}
+
+	//
+	// Sending and replying
+	//
+	toUserId := 0
+	toName := ``
+
+	if mailCommand == `reply` {
+
+		_, msg, ok := getMailMessage(args, user)
+		if !ok {
+			return true, nil
+		}
+
+		if !msg.IsPlayerMail() {
+			user.SendText(`You can't reply to that message.`)
+			return true, nil
+		}
+
+		toUserId, toName = msg.FromUserId, msg.FromName
+
+	} else {
+
+		if len(args) == 0 {
+			user.SendText(`Send mail to whom?`)
+			return true, nil
+		}
+
+		toUserId, toName = findMailRecipient(strings.Join(args, ` `))
+		if toUserId == 0 {
+			user.SendText(fmt.Sprintf(`There is nobody named "%s".`, strings.Join(args, ` `)))
+			return true, nil
+		}
+	}
+
+	if toUserId == user.UserId {
+		user.SendText(`You can't send mail to yourself.`)
+		return true, nil
+	}
+
+	cmdPrompt, isNew := user.StartPrompt(`mail`, rest)
+	if isNew {
+		user.SendText(fmt.Sprintf(`Writing a letter to <ansi fg="username">%s</ansi>...%s`, toName, term.CRLFStr))
+	}
+
+	msg := users.Message{
+		FromUserId: user.UserId,
+		FromName:   user.Character.Name,
+		DateSent:   time.Now(),
+	}
+
+	//
+	// Message?
+	//
+	question := cmdPrompt.Ask(`Message?`, []string{})
+	if !question.Done {
+		return true, nil
+	}
+
+	if question.Response == `` {
+		user.ClearPrompt()
+		user.SendText(`Okay! Cancelling your letter.`)
+		return true, nil
+	}
+
+	msg.Message = question.Response
+
+	//
+	// Gold?
+	//
+	question = cmdPrompt.Ask(`Attach how much gold?`, []string{}, `0`)
+	if !question.Done {
+		return true, nil
+	}
+
+	msg.Gold, _ = strconv.Atoi(question.Response)
+	if msg.Gold < 0 || msg.Gold > user.Character.Gold {
+		user.SendText(fmt.Sprintf(`You only have <ansi fg="gold">%d gold</ansi> on hand.`, user.Character.Gold))
+		question.RejectResponse()
+		return true, nil
+	}
+
+	//
+	// Attach item?
+	//
+	question = cmdPrompt.Ask(`Item name (or "none") to attach from your backpack?`, []string{}, `none`)
+	if !question.Done {
+		return true, nil
+	}
+
+	var attachedItem items.Item
+	if question.Response != `none` {
+		itm, found := user.Character.FindInBackpack(question.Response)
+		if !found {
+			user.SendText(`Could not find item: ` + question.Response)
+			question.RejectResponse()
+			return true, nil
+		}
+		attachedItem = itm
+		msg.Item = &attachedItem
+	}
+
+	//
+	// Cash on delivery?
+	//
+	if msg.HasAttachments() {
+
+		question = cmdPrompt.Ask(`Gold to charge on delivery (0 for none)?`, []string{}, `0`)
+		if !question.Done {
+			return true, nil
+		}
+
+		msg.COD, _ = strconv.Atoi(question.Response)
+		if msg.COD < 0 {
+			question.RejectResponse()
+			return true, nil
+		}
+	}
+
+	//
+	// Display preview?
+	//
+	question = cmdPrompt.Ask(`Send this letter to `+toName+`?`, []string{`Yes`, `No`}, `Yes`)
+	if !question.Done {
+
+		tplTxt, _ := templates.Process("mail/message", msg, user.UserId)
+		user.SendText(tplTxt)
+
+		return true, nil
+	}
+
+	user.ClearPrompt()
+
+	if question.Response[0:1] != `Y` {
+		user.SendText(`Okay! Cancelling your letter.`)
+		return true, nil
+	}
+
+	// Make sure nothing changed while writing
+	if msg.Gold > user.Character.Gold {
+		user.SendText(`You no longer have that much gold.`)
+		return true, nil
+	}
+
+	if msg.Item != nil {
+		if !user.Character.RemoveItem(*msg.Item) {
+			user.SendText(`You no longer have that item.`)
+			return true, nil
+		}
+	}
+
+	if err := users.DeliverMail(toUserId, msg); err != nil {
+		// Put the item back
+		if msg.Item != nil {
+			user.Character.StoreItem(*msg.Item)
+		}
+		user.SendText(fmt.Sprintf(`Your letter could not be delivered: %s`, err.Error()))
+		return true, nil
+	}
+
+	if msg.Gold > 0 {
+		user.Character.Gold -= msg.Gold
+
+		events.AddToQueue(events.EquipmentChange{
+			UserId:     user.UserId,
+			GoldChange: -msg.Gold,
+		})
+	}
+
+	if msg.Item != nil {
+		events.AddToQueue(events.ItemOwnership{
+			UserId: user.UserId,
+			Item:   *msg.Item,
+			Gained: false,
+		})
+	}
+
+	user.SendText(fmt.Sprintf(`<ansi fg="alert-5">Your letter to <ansi fg="username">%s</ansi> was sent!</ansi>`, toName))
+
+	return true, nil
+}
+
+// Gets a message by its number in the inbox (starting at 1)
+func getMailMessage(args []string, user *users.UserRecord) (int, users.Message, bool) {
+
+	if len(args) == 0 {
+		user.SendText(`Which message? Type <ansi fg="command">mail</ansi> to see your messages.`)
+		return 0, users.Message{}, false
+	}
+
+	num, _ := strconv.Atoi(args[0])
+	if num < 1 || num > len(user.Inbox) {
+		user.SendText(fmt.Sprintf(`There is no message #%s.`, args[0]))
+		return 0, users.Message{}, false
+	}
+
+	return num - 1, user.Inbox[num-1], true
+}
+
+// Finds the user id and character name of who mail should go to.
+// Online characters are checked first, then offline characters.
+func findMailRecipient(name string) (int, string) {
+
+	if u := users.GetByCharacterName(name); u != nil && strings.EqualFold(u.Character.Name, name) {
+		return u.UserId, u.Character.Name
+	}
+
+	if userId, _ := users.CharacterNameSearch(name); userId > 0 {
+		return userId, name
+	}
+
+	return 0, ``
+}
@@ -38,8 +38,7 @@ func Put(rest string, user *users.UserRecord, room *rooms.Room, flags events.Eve
 	}
 
 	if containerName == `` {
-		user.SendText(`No container found by that name`)
-		return true, nil
+		return putInCarriedContainer(args, user, room)
 	}
 
 	container := room.Containers[containerName]
@@ -70,10 +69,7 @@ func Put(rest string, user *users.UserRecord, room *rooms.Room, flags events.Eve
 
 	} else {
 
-		item, itemFound = user.Character.FindInBackpack(strings.Join(args, ` `))
-		if !itemFound && len(args) > 1 {
-			item, itemFound = user.Character.FindInBackpack(args[0])
-		}
+		item, itemFound = findQuantityInBackpack(args, user)
 
 	}
 
@@ -111,8 +107,8 @@ func Put(rest string, user *users.UserRecord, room *rooms.Room, flags events.Eve
 			Gained: false,
 		})
 
-		user.SendText(fmt.Sprintf(`You place your <ansi fg="itemname">%s</ansi> into the <ansi fg="container">%s</ansi>`, item.DisplayName(), containerName))
-		room.SendText(fmt.Sprintf(`<ansi fg="username">%s</ansi> places their <ansi fg="itemname">%s</ansi> into the <ansi fg="container">%s</ansi>`, user.Character.Name, item.DisplayName(), containerName), user.UserId)
+		user.SendText(fmt.Sprintf(`You place your <ansi fg="itemname">%s</ansi> into the <ansi fg="container">%s</ansi>`, item.QuantityDisplayName(), containerName))
+		room.SendText(fmt.Sprintf(`<ansi fg="username">%s</ansi> places their <ansi fg="itemname">%s</ansi> into the <ansi fg="container">%s</ansi>`, user.Character.Name, item.QuantityDisplayName(), containerName), user.UserId)
 
 		// Enforce container size limits
 
@@ -140,3 +136,82 @@ func Put(rest string, user *users.UserRecord, room *rooms.Room, flags events.Eve
 
 	return true, nil
 }
+
+// Handles putting items into a bag, quiver, etc. the user is carrying
+func putInCarriedContainer(args []string, user *users.UserRecord, room *rooms.Room) (bool, error) {
+
+	var bag items.Item
+	bagFound := false
+
+	nameSearch := ``
+	for i := len(args) - 1; i >= 1; i-- {
+		if len(nameSearch) > 0 {
+			nameSearch = ` ` + nameSearch
+		}
+		nameSearch = args[i] + nameSearch
+
+		if bag, bagFound = user.Character.FindContainerInBackpack(nameSearch); bagFound {
+			args = args[:i]
+			break
+		}
+	}
+
+	if !bagFound {
+		user.SendText(`No container found by that name`)
+		return true, nil
+	}
+
+	if len(args) > 1 && (args[len(args)-1] == `in` || args[len(args)-1] == `into`) {
+		args = args[:len(args)-1]
+	}
+
+	if args[len(args)-1] == `gold` {
+		user.SendText(fmt.Sprintf(`You can't put gold in the <ansi fg="itemname">%s</ansi>.`, bag.DisplayName()))
+		return true, nil
+	}
+
+	item, itemFound := findQuantityInBackpack(args, user)
+
+	if !itemFound || item.Equals(bag) {
+		user.SendText(`You don't seem to be carrying that.`)
+		return true, nil
+	}
+
+	if err := bag.AddContent(item); err != nil {
+		user.SendText(fmt.Sprintf(`You can't put the <ansi fg="itemname">%s</ansi> in the <ansi fg="itemname">%s</ansi>, %s.`, item.DisplayName(), bag.DisplayName(), err))
+		return true, nil
+	}
+
+	user.Character.RemoveItem(item)
+	user.Character.UpdateItem(bag, bag)
+
+	events.AddToQueue(events.ItemOwnership{
+		UserId:      user.UserId,
+		Item:        item,
+		Gained:      false,
+		InContainer: true,
+	})
+
+	user.SendText(fmt.Sprintf(`You place your <ansi fg="itemname">%s</ansi> into your <ansi fg="itemname">%s</ansi>.`, item.QuantityDisplayName(), bag.DisplayName()))
+	room.SendText(fmt.Sprintf(`<ansi fg="username">%s</ansi> places their <ansi fg="itemname">%s</ansi> into their <ansi fg="itemname">%s</ansi>.`, user.Character.Name, item.QuantityDisplayName(), bag.DisplayName()), user.UserId)
+
+	return true, nil
+}
+
+// Finds the item to put away, taking as many from the stack as asked for: "put 5 arrows in quiver"
+func findQuantityInBackpack(args []string, user *users.UserRecord) (items.Item, bool) {
+
+	quantity, itemName := util.SplitQuantity(strings.Join(args, ` `))
+
+	item, itemFound := user.Character.FindInBackpack(itemName)
+	if !itemFound && len(args) > 1 {
+		quantity = 1
+		item, itemFound = user.Character.FindInBackpack(args[0])
+	}
+
+	if itemFound {
+		item.TakeQuantity(quantity, user.Character.CountInBackpack(item))
+	}
+
+	return item, itemFound
+}
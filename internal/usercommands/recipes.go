@@ -0,0 +1,141 @@
+package usercommands
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/GoMudEngine/GoMud/internal/events"
+	"github.com/GoMudEngine/GoMud/internal/items"
+	"github.com/GoMudEngine/GoMud/internal/recipes"
+	"github.com/GoMudEngine/GoMud/internal/rooms"
+	"github.com/GoMudEngine/GoMud/internal/templates"
+	"github.com/GoMudEngine/GoMud/internal/users"
+)
+
+func Recipes(rest string, user *users.UserRecord, room *rooms.Room, flags events.EventFlag) (bool, error) {
+
+	knownRecipeIds := recipes.GetKnownRecipeIds(user.Character.Recipes)
+
+	// Details of a single recipe
+	if rest != `` {
+
+		recipe := recipes.FindRecipe(rest, knownRecipeIds)
+		if recipe == nil {
+			user.SendText(fmt.Sprintf(`You don't know a recipe called "%s".`, rest))
+			return true, nil
+		}
+
+		user.SendText(``)
+		user.SendText(fmt.Sprintf(`<ansi fg="yellow-bold">%s</ansi>`, recipe.Name))
+		if recipe.Description != `` {
+			user.SendText(fmt.Sprintf(`  %s`, recipe.Description))
+		}
+		user.SendText(``)
+		user.SendText(fmt.Sprintf(`  <ansi fg="white">Makes:</ansi>   %s`, recipeOutputText(recipe)))
+		user.SendText(fmt.Sprintf(`  <ansi fg="white">Needs:</ansi>   %s`, recipeInputText(recipe)))
+
+		if len(recipe.Tools) > 0 {
+			toolNames := []string{}
+			for _, tool := range recipe.Tools {
+				toolNames = append(toolNames, fmt.Sprintf(`<ansi fg="itemname">%s</ansi>`, tool.Name()))
+			}
+			user.SendText(fmt.Sprintf(`  <ansi fg="white">Tools:</ansi>   %s`, strings.Join(toolNames, `, `)))
+		}
+
+		if recipe.Station != `` {
+			user.SendText(fmt.Sprintf(`  <ansi fg="white">Station:</ansi> %s`, recipe.Station))
+		}
+
+		if len(recipe.Skills) > 0 {
+			user.SendText(fmt.Sprintf(`  <ansi fg="white">Skills:</ansi>  %s`, recipeSkillText(recipe)))
+		}
+
+		user.SendText(``)
+
+		return true, nil
+	}
+
+	headers := []string{`Recipe`, `Makes`, `Needs`, `Station`, `Skills`}
+	rows := [][]string{}
+
+	for _, recipeId := range knownRecipeIds {
+
+		recipe := recipes.GetRecipe(recipeId)
+		if recipe == nil {
+			continue
+		}
+
+		station := recipe.Station
+		if station == `` {
+			station = `-`
+		}
+
+		skillText := recipeSkillText(recipe)
+		if skillText == `` {
+			skillText = `-`
+		}
+
+		rows = append(rows, []string{recipe.Name, recipeOutputText(recipe), recipeInputText(recipe), station, skillText})
+	}
+
+	if len(rows) == 0 {
+		rows = append(rows, []string{`None`, `-`, `-`, `-`, `-`})
+	}
+
+	formatting := []string{
+		`<ansi fg="yellow-bold">%s</ansi>`,
+		`%s`,
+		`%s`,
+		`<ansi fg="magenta">%s</ansi>`,
+		`<ansi fg="skill">%s</ansi>`,
+	}
+
+	recipeTable := templates.GetTable(`Known Recipes`, headers, rows, formatting)
+	tplTxt, _ := templates.Process("tables/generic", recipeTable, user.UserId)
+	user.SendText(tplTxt)
+
+	if len(room.CraftStations) > 0 {
+		user.SendText(fmt.Sprintf(`This room has the following crafting stations: <ansi fg="magenta">%s</ansi>`, strings.Join(room.CraftStations, `, `)))
+	}
+
+	user.SendText(`Type <ansi fg="command">recipes [name]</ansi> for details, or <ansi fg="command">craft [name]</ansi> to make something.`)
+
+	return true, nil
+}
+
+func recipeOutputText(recipe *recipes.RecipeSpec) string {
+	name := `unknown`
+	if itemSpec := items.GetItemSpec(recipe.Output.ItemId); itemSpec != nil {
+		name = itemSpec.Name
+	}
+	if recipe.Output.Overrides != nil {
+		if overrideName, ok := recipe.Output.Overrides[`name`].(string); ok {
+			name = overrideName
+		}
+	}
+	if recipe.Output.Quantity > 1 {
+		return fmt.Sprintf(`%dx <ansi fg="itemname">%s</ansi>`, recipe.Output.Quantity, name)
+	}
+	return fmt.Sprintf(`<ansi fg="itemname">%s</ansi>`, name)
+}
+
+func recipeInputText(recipe *recipes.RecipeSpec) string {
+	inputs := []string{}
+	for _, input := range recipe.Inputs {
+		if itemSpec := items.GetItemSpec(input.ItemId); itemSpec != nil {
+			inputs = append(inputs, fmt.Sprintf(`%dx <ansi fg="itemname">%s</ansi>`, input.Quantity, itemSpec.Name))
+		}
+	}
+	return strings.Join(inputs, `, `)
+}
+
+func recipeSkillText(recipe *recipes.RecipeSpec) string {
+	skillNames := []string{}
+	for skillName, level := range recipe.Skills {
+		skillNames = append(skillNames, skillName+` `+strconv.Itoa(level))
+	}
+	sort.Strings(skillNames)
+	return strings.Join(skillNames, `, `)
+}
@@ -0,0 +1,173 @@
+package usercommands
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/GoMudEngine/GoMud/internal/characters"
+	"github.com/GoMudEngine/GoMud/internal/events"
+	"github.com/GoMudEngine/GoMud/internal/items"
+	"github.com/GoMudEngine/GoMud/internal/mobs"
+	"github.com/GoMudEngine/GoMud/internal/rooms"
+	"github.com/GoMudEngine/GoMud/internal/skills"
+	"github.com/GoMudEngine/GoMud/internal/users"
+)
+
+/*
+Repair Skill
+Level 1 - Repair items up to worn condition.
+Level 2 - Repair items up to good condition.
+Level 3 - Repair items to pristine condition.
+Level 4 - Mend broken items.
+*/
+func Repair(rest string, user *users.UserRecord, room *rooms.Room, flags events.EventFlag) (bool, error) {
+
+	// Shops that offer repairs take priority over doing it yourself
+	for _, mobId := range room.GetMobs(rooms.FindMerchant) {
+
+		mob := mobs.GetInstance(mobId)
+		if mob == nil {
+			continue
+		}
+
+		service, ok := mob.Character.Shop.GetService(characters.ServiceRepair)
+		if !ok {
+			continue
+		}
+
+		return repairAtShop(rest, user, room, mob, service)
+	}
+
+	skillLevel := user.Character.GetSkillLevel(skills.Repair)
+
+	if skillLevel == 0 {
+		user.SendText(`You don't know how to repair things. Find a shop that offers repairs.`)
+		return true, fmt.Errorf("you don't know how to repair")
+	}
+
+	if len(rest) == 0 {
+		user.SendText(`Type <ansi fg="command">help repair</ansi> for more information on the repair skill.`)
+		return true, nil
+	}
+
+	matchItem, found := user.Character.FindInBackpack(rest)
+	if !found {
+		user.SendText(fmt.Sprintf("You don't have a %s to repair. Is it still worn, perhaps?", rest))
+		return true, nil
+	}
+
+	if !matchItem.HasCondition() {
+		user.SendText(`Repair only works on weapons and armor.`)
+		return true, nil
+	}
+
+	if !matchItem.IsDamaged() {
+		user.SendText(fmt.Sprintf(`Your <ansi fg="itemname">%s</ansi> doesn't need any repairs.`, matchItem.DisplayName()))
+		return true, nil
+	}
+
+	if matchItem.IsBroken() && skillLevel < 4 {
+		user.SendText(`Your skills are not good enough to mend broken items. Type <ansi fg="command">help repair</ansi> for more information on the repair skill.`)
+		return true, nil
+	}
+
+	maxCondition := items.ConditionMax
+	if skillLevel == 1 {
+		maxCondition = 50
+	} else if skillLevel == 2 {
+		maxCondition = 75
+	}
+
+	if matchItem.Condition() >= maxCondition {
+		user.SendText(fmt.Sprintf(`Your skills are not good enough to improve the <ansi fg="itemname">%s</ansi> any further.`, matchItem.DisplayName()))
+		return true, nil
+	}
+
+	if !user.Character.TryCooldown(skills.Repair.String(), "10 rounds") {
+		user.SendText(
+			fmt.Sprintf("You need to wait %d more rounds to use that skill again.", user.Character.GetCooldown(skills.Repair.String())),
+		)
+		return true, errors.New(`you're doing that too often`)
+	}
+
+	user.Character.RemoveItem(matchItem)
+	matchItem.Repair(items.ConditionMax, maxCondition)
+	user.Character.StoreItem(matchItem)
+
+	user.SendText(fmt.Sprintf(`You repair the <ansi fg="itemname">%s</ansi>. It now looks <ansi fg="%s">%s</ansi>.`, matchItem.DisplayName(), matchItem.ConditionColor(), matchItem.ConditionString()))
+	room.SendText(fmt.Sprintf(`<ansi fg="username">%s</ansi> carefully repairs their <ansi fg="itemname">%s</ansi>.`, user.Character.Name, matchItem.DisplayName()), user.UserId)
+
+	return true, nil
+}
+
+func repairAtShop(rest string, user *users.UserRecord, room *rooms.Room, mob *mobs.Mob, service characters.ShopItem) (bool, error) {
+
+	repairCost := func(itm items.Item) int {
+		if service.Price > 0 {
+			return service.Price
+		}
+		return itm.RepairCost()
+	}
+
+	if rest == `` {
+
+		damaged := []items.Item{}
+		for _, itm := range user.Character.GetAllBackpackItems() {
+			if itm.IsDamaged() {
+				damaged = append(damaged, itm)
+			}
+		}
+
+		if len(damaged) == 0 {
+			mob.Command(`say Bring me anything that needs fixing and I'll make it good as new.`)
+			return true, nil
+		}
+
+		for _, itm := range damaged {
+			user.SendText(fmt.Sprintf(`  <ansi fg="itemname">%s</ansi> (<ansi fg="%s">%s</ansi>) - %d gold`, itm.DisplayName(), itm.ConditionColor(), itm.ConditionString(), repairCost(itm)))
+		}
+		user.SendText(`To repair something, type: <ansi fg="command">repair [name]</ansi>`)
+
+		return true, nil
+	}
+
+	matchItem, found := user.Character.FindInBackpack(rest)
+	if !found {
+		user.SendText(fmt.Sprintf("You don't have a %s to repair. Is it still worn, perhaps?", rest))
+		return true, nil
+	}
+
+	if !matchItem.HasCondition() {
+		mob.Command(`say I only repair weapons and armor.`)
+		return true, nil
+	}
+
+	if !matchItem.IsDamaged() {
+		mob.Command(`say That doesn't need any repairs.`)
+		return true, nil
+	}
+
+	price := repairCost(matchItem)
+
+	if price > user.Character.Gold {
+		mob.Command(fmt.Sprintf("say That costs %d gold to repair, which you don't seem to have.", price))
+		return true, nil
+	}
+
+	user.Character.Gold -= price
+	mob.Character.Gold += price
+
+	events.AddToQueue(events.EquipmentChange{
+		UserId:     user.UserId,
+		GoldChange: -price,
+	})
+
+	user.Character.RemoveItem(matchItem)
+	matchItem.Repair(items.ConditionMax, items.ConditionMax)
+	user.Character.StoreItem(matchItem)
+
+	user.SendText(fmt.Sprintf(`You give <ansi fg="mobname">%s</ansi> %d gold to repair <ansi fg="itemname">%s</ansi>. It looks good as new.`, mob.Character.Name, price, matchItem.DisplayName()))
+	room.SendText(fmt.Sprintf(`<ansi fg="mobname">%s</ansi> repairs <ansi fg="username">%s</ansi>'s <ansi fg="itemname">%s</ansi>.`, mob.Character.Name, user.Character.Name, matchItem.DisplayName()), user.UserId)
+
+	return true, nil
+}
@@ -8,11 +8,15 @@ import (
 	"github.com/GoMudEngine/GoMud/internal/mobs"
 	"github.com/GoMudEngine/GoMud/internal/rooms"
 	"github.com/GoMudEngine/GoMud/internal/users"
+	"github.com/GoMudEngine/GoMud/internal/util"
 )
 
 func Sell(rest string, user *users.UserRecord, room *rooms.Room, flags events.EventFlag) (bool, error) {
 
-	item, found := user.Character.FindInBackpack(rest)
+	// "sell 5 arrows", "sell all potion"
+	quantity, itemName := util.SplitQuantity(rest)
+
+	item, found := user.Character.FindInBackpack(itemName)
 
 	if !found {
 		user.SendText("You don't have that item.")
@@ -25,6 +29,11 @@ func Sell(rest string, user *users.UserRecord, room *rooms.Room, flags events.Ev
 		return true, nil
 	}
 
+	if len(item.Contents) > 0 {
+		user.SendText(fmt.Sprintf(`You should empty the <ansi fg="itemname">%s</ansi> before selling it.`, item.DisplayName()))
+		return true, nil
+	}
+
 	if itemSpec.QuestToken != `` {
 		user.SendText("Quest items cannot be sold!")
 		return true, nil
@@ -46,13 +55,28 @@ func Sell(rest string, user *users.UserRecord, room *rooms.Room, flags events.Ev
 			continue
 		}
 
-		sellValue := mob.GetSellPrice(item)
+		// Each one sold lowers what the shop will pay for the next
+		item.TakeQuantity(quantity, user.Character.CountInBackpack(item))
+
+		sellValue := 0
+		soldCount := 0
+		for soldCount < item.GetQuantity() {
+			unitValue := mob.GetSellPrice(item.Single())
+			if unitValue <= 0 {
+				break
+			}
+			sellValue += unitValue
+			soldCount++
+			mob.Character.Shop.StockItem(item.ItemId)
+		}
 
-		if sellValue <= 0 {
+		if soldCount == 0 {
 			mob.Command(`say I'm not interested in that.`)
 			continue
 		}
 
+		item.SetQuantity(soldCount)
+
 		user.Character.Gold += sellValue
 		user.Character.RemoveItem(item)
 
@@ -67,15 +91,13 @@ func Sell(rest string, user *users.UserRecord, room *rooms.Room, flags events.Ev
 			GoldChange: sellValue,
 		})
 
-		mob.Character.Shop.StockItem(item.ItemId)
-
-		user.EventLog.Add(`shop`, fmt.Sprintf(`Sold your <ansi fg="itemname">%s</ansi> to <ansi fg="mobname">%s</ansi> for <ansi fg="gold">%d gold</ansi>`, item.DisplayName(), mob.Character.Name, sellValue))
+		user.EventLog.Add(`shop`, fmt.Sprintf(`Sold your <ansi fg="itemname">%s</ansi> to <ansi fg="mobname">%s</ansi> for <ansi fg="gold">%d gold</ansi>`, item.QuantityDisplayName(), mob.Character.Name, sellValue))
 
 		user.SendText(
-			fmt.Sprintf(`You sell a <ansi fg="itemname">%s</ansi> for <ansi fg="gold">%d gold</ansi>.`, item.DisplayName(), sellValue),
+			fmt.Sprintf(`You sell a <ansi fg="itemname">%s</ansi> for <ansi fg="gold">%d gold</ansi>.`, item.QuantityDisplayName(), sellValue),
 		)
 		room.SendText(
-			fmt.Sprintf(`<ansi fg="username">%s</ansi> sells a <ansi fg="itemname">%s</ansi>.`, user.Character.Name, item.DisplayName()),
+			fmt.Sprintf(`<ansi fg="username">%s</ansi> sells a <ansi fg="itemname">%s</ansi>.`, user.Character.Name, item.QuantityDisplayName()),
 			user.UserId,
 		)
 
@@ -61,7 +61,7 @@ func Disarm(rest string, user *users.UserRecord, room *rooms.Room, flags events.
 				chanceIn100 = 0
 			}
 			chanceIn100 += 5
-			roll := util.Rand(100)
+			roll := util.GetRNG(util.RNGSkills).Rand(100)
 
 			util.LogRoll(`Disarm`, roll, chanceIn100)
 
@@ -108,7 +108,7 @@ func Disarm(rest string, user *users.UserRecord, room *rooms.Room, flags events.
 				chanceIn100 = 0
 			}
 			chanceIn100 += 5
-			roll := util.Rand(100)
+			roll := util.GetRNG(util.RNGSkills).Rand(100)
 
 			util.LogRoll(`Disarm`, roll, chanceIn100)
 
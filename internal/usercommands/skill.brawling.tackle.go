@@ -48,7 +48,7 @@ func Tackle(rest string, user *users.UserRecord, room *rooms.Room, flags events.
 				chanceIn100 = 0
 			}
 			chanceIn100 += 10
-			roll := util.Rand(100)
+			roll := util.GetRNG(util.RNGSkills).Rand(100)
 
 			util.LogRoll(`Tackle`, roll, chanceIn100)
 
@@ -95,7 +95,7 @@ func Tackle(rest string, user *users.UserRecord, room *rooms.Room, flags events.
 				chanceIn100 = 0
 			}
 			chanceIn100 += 10
-			roll := util.Rand(100)
+			roll := util.GetRNG(util.RNGSkills).Rand(100)
 
 			util.LogRoll(`Tackle`, roll, chanceIn100)
 
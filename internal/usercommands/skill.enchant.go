@@ -183,12 +183,12 @@ func Enchant(rest string, user *users.UserRecord, room *rooms.Room, flags events
 			bonusCt := skillLevel - 1 //
 			for i := 0; i < bonusCt; i++ {
 				// select a random stat
-				chosenStat := allStats[util.Rand(len(allStats))]
+				chosenStat := allStats[util.GetRNG(util.RNGSkills).Rand(len(allStats))]
 				statBonus[chosenStat] = int(math.Ceil(math.Sqrt(float64(user.Character.Stats.Mysticism.ValueAdj))))
 			}
 		}
 
-		roll := util.Rand(100)
+		roll := util.GetRNG(util.RNGSkills).Rand(100)
 
 		util.LogRoll(`Enchant->Cursed`, roll, 25)
 
@@ -198,7 +198,7 @@ func Enchant(rest string, user *users.UserRecord, room *rooms.Room, flags events
 
 		user.Character.RemoveItem(matchItem)
 
-		roll = util.Rand(100)
+		roll = util.GetRNG(util.RNGSkills).Rand(100)
 
 		util.LogRoll(`Enchant->Destroy`, roll, chanceToDestroy)
 
@@ -46,7 +46,7 @@ func Pray(rest string, user *users.UserRecord, room *rooms.Room, flags events.Ev
 	}
 
 	possibleBuffIds := []int{4, 11, 14, 16, 17, 18}
-	totalBuffCount := 1 + int(float64(user.Character.Stats.Mysticism.ValueAdj)/15) + util.Rand(2)
+	totalBuffCount := 1 + int(float64(user.Character.Stats.Mysticism.ValueAdj)/15) + util.GetRNG(util.RNGSkills).Rand(2)
 
 	if totalBuffCount > len(possibleBuffIds) {
 		totalBuffCount = len(possibleBuffIds)
@@ -65,7 +65,7 @@ func Pray(rest string, user *users.UserRecord, room *rooms.Room, flags events.Ev
 		}
 
 		for i := 0; i < totalBuffCount; i++ {
-			randBuffIndex := util.Rand(len(possibleBuffIds))
+			randBuffIndex := util.GetRNG(util.RNGSkills).Rand(len(possibleBuffIds))
 
 			events.AddToQueue(events.Buff{
 				UserId:        prayPlayerId,
@@ -84,7 +84,7 @@ func Pray(rest string, user *users.UserRecord, room *rooms.Room, flags events.Ev
 			room.SendText(fmt.Sprintf(`<ansi fg="username">%s</ansi> puts his hand over <ansi fg="mobname">%s</ansi> and begins to pray.`, user.Character.Name, mob.Character.Name), user.UserId)
 
 			for i := 0; i < totalBuffCount; i++ {
-				randBuffIndex := util.Rand(len(possibleBuffIds))
+				randBuffIndex := util.GetRNG(util.RNGSkills).Rand(len(possibleBuffIds))
 
 				events.AddToQueue(events.Buff{
 					UserId:        0,
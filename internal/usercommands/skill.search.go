@@ -56,7 +56,7 @@ func Search(rest string, user *users.UserRecord, room *rooms.Room, flags events.
 	for exit, exitInfo := range room.Exits {
 		if exitInfo.Secret {
 
-			roll := util.Rand(100)
+			roll := util.GetRNG(util.RNGSkills).Rand(100)
 
 			util.LogRoll(`Secret Exit`, roll, searchOddsIn100)
 
@@ -85,7 +85,7 @@ func Search(rest string, user *users.UserRecord, room *rooms.Room, flags events.
 			}
 			if p := users.GetByUserId(pId); p != nil {
 
-				roll := util.Rand(100)
+				roll := util.GetRNG(util.RNGSkills).Rand(100)
 
 				util.LogRoll(`Hidden Player`, roll, searchOddsIn100)
 
@@ -122,7 +122,7 @@ func Search(rest string, user *users.UserRecord, room *rooms.Room, flags events.
 		for _, mId := range room.GetMobs() {
 			if m := users.GetByUserId(mId); m != nil {
 
-				roll := util.Rand(100)
+				roll := util.GetRNG(util.RNGSkills).Rand(100)
 
 				util.LogRoll(`Hidden Mob`, roll, searchOddsIn100)
 
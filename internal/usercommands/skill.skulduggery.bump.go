@@ -74,14 +74,14 @@ func Bump(rest string, user *users.UserRecord, room *rooms.Room, flags events.Ev
 				chanceIn100 = 1
 			}
 
-			roll := util.Rand(100)
+			roll := util.GetRNG(util.RNGSkills).Rand(100)
 
 			util.LogRoll(`Bump`, roll, chanceIn100)
 
 			if roll < chanceIn100 {
 
 				if m.Character.Gold > 0 {
-					goldDropped = util.Rand(m.Character.Gold >> 2)
+					goldDropped = util.GetRNG(util.RNGSkills).Rand(m.Character.Gold >> 2)
 					if goldDropped > 0 {
 						m.Character.Gold -= goldDropped
 					}
@@ -119,14 +119,14 @@ func Bump(rest string, user *users.UserRecord, room *rooms.Room, flags events.Ev
 				chanceIn100 = 1
 			}
 
-			roll := util.Rand(100)
+			roll := util.GetRNG(util.RNGSkills).Rand(100)
 
 			util.LogRoll(`Bump`, roll, chanceIn100)
 
 			if roll < chanceIn100 {
 
 				if p.Character.Gold > 0 {
-					goldDropped = util.Rand(p.Character.Gold >> 2)
+					goldDropped = util.GetRNG(util.RNGSkills).Rand(p.Character.Gold >> 2)
 					if goldDropped > 0 {
 						p.Character.Gold -= goldDropped
 
@@ -72,7 +72,7 @@ func Pickpocket(rest string, user *users.UserRecord, room *rooms.Room, flags eve
 				chanceIn100 += 15
 			}
 
-			roll := util.Rand(100)
+			roll := util.GetRNG(util.RNGSkills).Rand(100)
 
 			util.LogRoll(`Pickpocket`, roll, chanceIn100)
 
@@ -83,7 +83,7 @@ func Pickpocket(rest string, user *users.UserRecord, room *rooms.Room, flags eve
 				if m.Character.Gold > 0 {
 					halfGold := m.Character.Gold >> 2
 					minGold := m.Character.Gold - halfGold
-					goldStolen := util.Rand(halfGold) + minGold
+					goldStolen := util.GetRNG(util.RNGSkills).Rand(halfGold) + minGold
 					if goldStolen > 0 {
 						m.Character.Gold -= goldStolen
 						user.Character.Gold += goldStolen
@@ -169,7 +169,7 @@ func Pickpocket(rest string, user *users.UserRecord, room *rooms.Room, flags eve
 				chanceIn100 += 15
 			}
 
-			roll := util.Rand(100)
+			roll := util.GetRNG(util.RNGSkills).Rand(100)
 
 			util.LogRoll(`Pickpocket`, roll, chanceIn100)
 
@@ -180,7 +180,7 @@ func Pickpocket(rest string, user *users.UserRecord, room *rooms.Room, flags eve
 				if p.Character.Gold > 0 {
 					halfGold := p.Character.Gold >> 2
 					minGold := p.Character.Gold - halfGold
-					goldStolen := util.Rand(halfGold) + minGold
+					goldStolen := util.GetRNG(util.RNGSkills).Rand(halfGold) + minGold
 					if goldStolen > 0 {
 						p.Character.Gold -= goldStolen
 						user.Character.Gold += goldStolen
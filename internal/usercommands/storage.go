@@ -73,7 +73,7 @@ func Storage(rest string, user *users.UserRecord, room *rooms.Room, flags events
 		if itemName == `all` {
 
 			for _, itm := range user.Character.GetAllBackpackItems() {
-				Storage(fmt.Sprintf(`add !%d`, itm.ItemId), user, room, flags)
+				Storage(`add all `+itm.ShorthandId(), user, room, flags)
 
 				spaceLeft--
 				if spaceLeft < 0 {
@@ -84,6 +84,8 @@ func Storage(rest string, user *users.UserRecord, room *rooms.Room, flags events
 			return true, nil
 		}
 
+		quantity, itemName := util.SplitQuantity(itemName)
+
 		itm, found := user.Character.FindInBackpack(itemName)
 
 		if !found {
@@ -91,6 +93,8 @@ func Storage(rest string, user *users.UserRecord, room *rooms.Room, flags events
 			return true, nil
 		}
 
+		itm.TakeQuantity(quantity, user.Character.CountInBackpack(itm))
+
 		user.Character.RemoveItem(itm)
 		user.ItemStorage.AddItem(itm)
 
@@ -100,19 +104,21 @@ func Storage(rest string, user *users.UserRecord, room *rooms.Room, flags events
 			Gained: false,
 		})
 
-		user.SendText(fmt.Sprintf(`You placed the <ansi fg="itemname">%s</ansi> into storage.`, itm.DisplayName()))
+		user.SendText(fmt.Sprintf(`You placed the <ansi fg="itemname">%s</ansi> into storage.`, itm.QuantityDisplayName()))
 
 	} else if action == `remove` {
 
 		if itemName == `all` {
 
 			for _, itm := range user.ItemStorage.GetItems() {
-				Storage(fmt.Sprintf(`remove !%d`, itm.ItemId), user, room, flags)
+				Storage(`remove all `+itm.ShorthandId(), user, room, flags)
 			}
 
 			return true, nil
 		}
 
+		quantity, itemName := util.SplitQuantity(itemName)
+
 		var itm items.Item
 		var found bool = false
 		itmIdx, _ := strconv.Atoi(itemName)
@@ -129,6 +135,7 @@ func Storage(rest string, user *users.UserRecord, room *rooms.Room, flags events
 
 		} else {
 			itm, found = user.ItemStorage.FindItem(itemName)
+			itm.TakeQuantity(quantity, user.ItemStorage.Count(itm))
 		}
 
 		if !found {
@@ -146,7 +153,7 @@ func Storage(rest string, user *users.UserRecord, room *rooms.Room, flags events
 
 			user.ItemStorage.RemoveItem(itm)
 
-			user.SendText(fmt.Sprintf(`You removed the <ansi fg="itemname">%s</ansi> from storage.`, itm.DisplayName()))
+			user.SendText(fmt.Sprintf(`You removed the <ansi fg="itemname">%s</ansi> from storage.`, itm.QuantityDisplayName()))
 
 		} else {
 			user.SendText(`You can't carry that!`)
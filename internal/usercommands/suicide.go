@@ -138,7 +138,7 @@ func Suicide(rest string, user *users.UserRecord, room *rooms.Room, flags events
 		if config.Death.EquipmentDropChance >= 0 {
 			chanceInt := int(config.Death.EquipmentDropChance * 100)
 			for _, itm := range user.Character.GetAllWornItems() {
-				if util.Rand(100) < chanceInt {
+				if util.GetRNG(util.RNGLoot).Rand(100) < chanceInt {
 
 					Remove(itm.Name(), user, room, flags)
 
@@ -161,7 +161,7 @@ func Suicide(rest string, user *users.UserRecord, room *rooms.Room, flags events
 		} else if config.Death.EquipmentDropChance >= 0 {
 			chanceInt := int(config.Death.EquipmentDropChance * 100)
 			for _, itm := range user.Character.GetAllBackpackItems() {
-				if util.Rand(100) < chanceInt {
+				if util.GetRNG(util.RNGLoot).Rand(100) < chanceInt {
 					Drop(itm.Name(), user, room, flags)
 					user.EventLog.Add(`death`, fmt.Sprintf(`Dropped your <ansi fg="itemname">%s</ansi> on death`, itm.Name()))
 				}
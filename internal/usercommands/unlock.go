@@ -137,6 +137,10 @@ func Unlock(rest string, user *users.UserRecord, room *rooms.Room, flags events.
 
 	}
 
+	if bag, found := user.Character.FindContainerInBackpack(args[0]); found {
+		return keyCarriedContainer(bag, false, user, room)
+	}
+
 	user.SendText("There is no such exit or container.")
 	return true, nil
 
@@ -6,6 +6,7 @@ import (
 	"time"
 
 	"github.com/GoMudEngine/GoMud/internal/buffs"
+	"github.com/GoMudEngine/GoMud/internal/channels"
 	"github.com/GoMudEngine/GoMud/internal/events"
 	"github.com/GoMudEngine/GoMud/internal/keywords"
 	"github.com/GoMudEngine/GoMud/internal/mudlog"
@@ -45,14 +46,18 @@ var (
 	userCommands map[string]CommandAccess = map[string]CommandAccess{
 		`aid`:         {Aid, false, false},
 		`alias`:       {Alias, true, false},
+		`apitoken`:    {ApiToken, true, true}, // Admin only
 		`appraise`:    {Appraise, false, false},
 		`ask`:         {Ask, false, false},
 		`attack`:      {Attack, false, false},
 		`backstab`:    {Backstab, false, false},
 		`badcommands`: {BadCommands, true, true}, // Admin only
+		`ban`:         {Ban, true, true},         // Admin only
 		`biome`:       {Biome, true, false},
 		`broadcast`:   {Broadcast, true, false},
+		`channel`:     {Channel, true, false},
 		`character`:   {Character, true, false},
+		`clan`:        {Clan, true, false},
 		`tackle`:      {Tackle, false, false},
 		`bank`:        {Bank, false, false},
 		`break`:       {Break, false, false},
@@ -62,6 +67,7 @@ var (
 		`buy`:         {Buy, false, false},
 		`cast`:        {Cast, false, false},
 		`cooldowns`:   {Cooldowns, true, false},
+		`craft`:       {Craft, false, false},
 		`command`:     {Command, false, true}, // Admin only
 		`conditions`:  {Conditions, true, false},
 		`consider`:    {Consider, true, false},
@@ -92,6 +98,7 @@ var (
 		`jobs`:        {Jobs, true, false},
 		`list`:        {List, false, false},
 		`locate`:      {Locate, true, true}, // Admin only
+		`mail`:        {Mail, true, false},
 		`lock`:        {Lock, false, false},
 		`look`:        {Look, true, false},
 		`map`:         {Map, false, false},
@@ -123,15 +130,18 @@ var (
 		`questtoken`:  {QuestToken, false, true}, // Admin only
 		`rank`:        {Rank, false, false},
 		`read`:        {Read, false, false},
+		`recipes`:     {Recipes, true, false},
 		`recover`:     {Recover, false, false},
 		`reload`:      {Reload, true, true}, // Admin only
 		`remove`:      {Remove, false, false},
+		`repair`:      {Repair, false, false},
 		`rename`:      {Rename, false, true},     // Admin only
 		`redescribe`:  {Redescribe, false, true}, // Admin only
 		`room`:        {Room, false, true},       // Admin only
 		`save`:        {Save, true, false},
 		`say`:         {Say, true, false},
 		`scribe`:      {Scribe, false, false},
+		`script`:      {Script, true, true}, // Admin only
 		`search`:      {Search, false, false},
 		`sell`:        {Sell, false, false},
 		`server`:      {Server, false, true}, // Admin only
@@ -391,6 +401,11 @@ func TryCommand(cmd string, rest string, userId int, flags events.EventFlag) (bo
 		return handled, err
 	}
 
+	// Channel ids double as commands to talk on them (e.g. "ooc hello")
+	if c := channels.GetChannel(cmd); c != nil && user.CanUseChannel(c) {
+		return sendToChannel(c, rest, user)
+	}
+
 	if user.Character.HasSpell(cmd) {
 		castCmd := cmd
 		if len(rest) > 0 {
@@ -0,0 +1,140 @@
+package users
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+//
+// API token format:
+//   gomud_<userId>_<tokenId>_<secret>
+//
+// Only a sha256 of the secret is kept on the user record.
+// The full token is shown once, when it is created.
+//
+
+const (
+	apiTokenPrefix     = `gomud`
+	apiTokenIdSize     = 4
+	apiTokenSecretSize = 32
+	apiTokenMaxCount   = 10
+)
+
+var (
+	ErrInvalidApiToken  = errors.New(`invalid api token`)
+	ErrTooManyApiTokens = fmt.Errorf(`a user may only have %d api tokens`, apiTokenMaxCount)
+)
+
+type ApiToken struct {
+	TokenId    string    `yaml:"tokenid"`
+	Name       string    `yaml:"name"`
+	Scopes     []string  `yaml:"scopes"` // Role permission ids such as room.edit
+	SecretHash string    `yaml:"secrethash"`
+	Created    time.Time `yaml:"created"`
+}
+
+// Whether the token scopes cover a permission id.
+// Scopes prefix permissions the same way roles do: room permits room.edit (but not roomfoo)
+func (t ApiToken) Permits(permissionId string) bool {
+	for _, scope := range t.Scopes {
+		if scope == `*` || scope == permissionId {
+			return true
+		}
+		if rest, ok := strings.CutPrefix(permissionId, scope); ok && (rest[0] == '.' || rest[0] == '/') {
+			return true
+		}
+	}
+	return false
+}
+
+// Creates a new token for the user and returns the full token string.
+// The caller is responsible for saving the user.
+func (u *UserRecord) CreateApiToken(name string, scopes []string) (string, error) {
+
+	if len(u.ApiTokens) >= apiTokenMaxCount {
+		return ``, ErrTooManyApiTokens
+	}
+
+	idBytes := make([]byte, apiTokenIdSize)
+	if _, err := rand.Read(idBytes); err != nil {
+		return ``, err
+	}
+
+	secretBytes := make([]byte, apiTokenSecretSize)
+	if _, err := rand.Read(secretBytes); err != nil {
+		return ``, err
+	}
+
+	tokenId := hex.EncodeToString(idBytes)
+	secret := hex.EncodeToString(secretBytes)
+
+	u.ApiTokens = append(u.ApiTokens, ApiToken{
+		TokenId:    tokenId,
+		Name:       name,
+		Scopes:     scopes,
+		SecretHash: hashApiTokenSecret(secret),
+		Created:    time.Now(),
+	})
+
+	return fmt.Sprintf(`%s_%d_%s_%s`, apiTokenPrefix, u.UserId, tokenId, secret), nil
+}
+
+// Removes a token by its id. Returns false if it wasn't found.
+// The caller is responsible for saving the user.
+func (u *UserRecord) RevokeApiToken(tokenId string) bool {
+	for i, t := range u.ApiTokens {
+		if t.TokenId == tokenId {
+			u.ApiTokens = append(u.ApiTokens[:i], u.ApiTokens[i+1:]...)
+			return true
+		}
+	}
+	return false
+}
+
+// Finds the token matching the id and secret, or nil
+func (u *UserRecord) GetApiToken(tokenId string, secret string) *ApiToken {
+
+	secretHash := []byte(hashApiTokenSecret(secret))
+
+	for i, t := range u.ApiTokens {
+		if t.TokenId != tokenId {
+			continue
+		}
+		if subtle.ConstantTimeCompare([]byte(t.SecretHash), secretHash) == 1 {
+			return &u.ApiTokens[i]
+		}
+	}
+
+	return nil
+}
+
+// Splits a token string into its parts
+func ParseApiToken(token string) (userId int, tokenId string, secret string, err error) {
+
+	parts := strings.Split(token, `_`)
+	if len(parts) != 4 || parts[0] != apiTokenPrefix {
+		return 0, ``, ``, ErrInvalidApiToken
+	}
+
+	if userId, err = strconv.Atoi(parts[1]); err != nil || userId < 1 {
+		return 0, ``, ``, ErrInvalidApiToken
+	}
+
+	if parts[2] == `` || parts[3] == `` {
+		return 0, ``, ``, ErrInvalidApiToken
+	}
+
+	return userId, parts[2], parts[3], nil
+}
+
+func hashApiTokenSecret(secret string) string {
+	sum := sha256.Sum256([]byte(secret))
+	return hex.EncodeToString(sum[:])
+}
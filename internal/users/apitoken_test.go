@@ -0,0 +1,89 @@
+package users
+
+import (
+	"testing"
+)
+
+func TestApiTokenRoundTrip(t *testing.T) {
+
+	u := &UserRecord{UserId: 12}
+
+	token, err := u.CreateApiToken(`tools`, []string{`room.edit`})
+	if err != nil {
+		t.Fatalf("CreateApiToken() error = %v", err)
+	}
+
+	userId, tokenId, secret, err := ParseApiToken(token)
+	if err != nil {
+		t.Fatalf("ParseApiToken(%q) error = %v", token, err)
+	}
+
+	if userId != 12 {
+		t.Errorf("ParseApiToken() userId = %d, want 12", userId)
+	}
+
+	if u.GetApiToken(tokenId, secret) == nil {
+		t.Fatalf("GetApiToken() did not find the new token")
+	}
+
+	if u.GetApiToken(tokenId, secret+`x`) != nil {
+		t.Errorf("GetApiToken() matched a wrong secret")
+	}
+
+	if u.ApiTokens[0].SecretHash == secret {
+		t.Errorf("secret was stored in plain text")
+	}
+
+	if !u.RevokeApiToken(tokenId) {
+		t.Errorf("RevokeApiToken() = false, want true")
+	}
+
+	if u.GetApiToken(tokenId, secret) != nil {
+		t.Errorf("GetApiToken() found a revoked token")
+	}
+}
+
+func TestParseApiTokenInvalid(t *testing.T) {
+
+	tests := []string{
+		``,
+		`gomud_12_abcd`,
+		`other_12_abcd_secret`,
+		`gomud_x_abcd_secret`,
+		`gomud_0_abcd_secret`,
+		`gomud_12__secret`,
+	}
+
+	for _, token := range tests {
+		if _, _, _, err := ParseApiToken(token); err == nil {
+			t.Errorf("ParseApiToken(%q) expected an error", token)
+		}
+	}
+}
+
+func TestApiTokenPermits(t *testing.T) {
+
+	tests := []struct {
+		scopes     []string
+		permission string
+		want       bool
+	}{
+		{[]string{`room`}, `room.edit`, true},
+		{[]string{`room.edit`}, `room.edit`, true},
+		{[]string{`room.edit`}, `room.info`, false},
+		{[]string{`room`}, `roomfoo`, false},
+		{[]string{`room`}, `roomfoo.edit`, false},
+		{[]string{`api`}, `api/rooms`, true},
+		{[]string{`room.ed`}, `room.edit`, false},
+		{[]string{`item`, `mob.info`}, `mob.info`, true},
+		{[]string{`*`}, `quest.delete`, true},
+		{nil, `room.info`, false},
+	}
+
+	for _, tt := range tests {
+		token := ApiToken{Scopes: tt.scopes}
+		if got := token.Permits(tt.permission); got != tt.want {
+			t.Errorf("ApiToken{Scopes: %v}.Permits(%q) = %v, want %v", tt.scopes, tt.permission, got, tt.want)
+		}
+	}
+}
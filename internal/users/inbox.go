@@ -1,12 +1,23 @@
 package users
 
 import (
+	"errors"
 	"time"
 
 	"github.com/GoMudEngine/GoMud/internal/configs"
+	"github.com/GoMudEngine/GoMud/internal/events"
 	"github.com/GoMudEngine/GoMud/internal/items"
 )
 
+const (
+	// Player mail isn't delivered to inboxes with this many messages
+	MaxInboxSize = 50
+)
+
+var (
+	ErrInboxFull = errors.New(`Their inbox is full.`)
+)
+
 type Inbox []Message
 
 type Message struct {
@@ -15,6 +26,7 @@ type Message struct {
 	Message    string
 	Item       *items.Item
 	Gold       int
+	COD        int `yaml:"cod,omitempty"` // Gold the recipient must pay to take the attachments (cash on delivery)
 	Read       bool
 	DateSent   time.Time
 }
@@ -52,6 +64,19 @@ func (i *Inbox) CountUnread() int {
 	return ct
 }
 
+// Removes and returns the message at a position in the inbox
+func (i *Inbox) Remove(idx int) (Message, bool) {
+
+	if idx < 0 || idx >= len(*i) {
+		return Message{}, false
+	}
+
+	msg := (*i)[idx]
+	(*i) = append((*i)[:idx], (*i)[idx+1:]...)
+
+	return msg, true
+}
+
 func (i *Inbox) Empty() {
 	(*i) = Inbox{}
 }
@@ -60,3 +85,49 @@ func (m Message) DateString() string {
 	tFormat := string(configs.GetConfig().TextFormats.Time)
 	return m.DateSent.Format(tFormat)
 }
+
+// Whether the message still has gold or an item that hasn't been taken
+func (m Message) HasAttachments() bool {
+	return m.Gold > 0 || m.Item != nil
+}
+
+// Mail from players must have its attachments collected at a post office.
+// Everything else (auctions, admin mail) is delivered when read.
+func (m Message) IsPlayerMail() bool {
+	return m.FromUserId > 0
+}
+
+// Delivers a message to a users inbox, whether they are online or not.
+// Online users are notified with a MailReceived event.
+func DeliverMail(toUserId int, msg Message) error {
+
+	if u := GetByUserId(toUserId); u != nil {
+
+		if msg.IsPlayerMail() && len(u.Inbox) >= MaxInboxSize {
+			return ErrInboxFull
+		}
+
+		u.Inbox.Add(msg)
+
+		events.AddToQueue(events.MailReceived{
+			UserId:     toUserId,
+			FromUserId: msg.FromUserId,
+			FromName:   msg.FromName,
+		})
+
+		return nil
+	}
+
+	u, err := LoadUserById(toUserId, true)
+	if err != nil {
+		return err
+	}
+
+	if msg.IsPlayerMail() && len(u.Inbox) >= MaxInboxSize {
+		return ErrInboxFull
+	}
+
+	u.Inbox.Add(msg)
+
+	return SaveUser(*u)
+}
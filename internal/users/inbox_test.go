@@ -0,0 +1,40 @@
+package users
+
+import (
+	"testing"
+
+	"github.com/GoMudEngine/GoMud/internal/items"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestInboxRemove(t *testing.T) {
+
+	inbox := Inbox{}
+	inbox.Add(Message{Message: `first`})
+	inbox.Add(Message{Message: `second`})
+	inbox.Add(Message{Message: `third`})
+
+	msg, ok := inbox.Remove(1)
+	assert.True(t, ok)
+	assert.Equal(t, `second`, msg.Message)
+	assert.Len(t, inbox, 2)
+	assert.Equal(t, `third`, inbox[0].Message)
+	assert.Equal(t, `first`, inbox[1].Message)
+
+	_, ok = inbox.Remove(2)
+	assert.False(t, ok)
+	_, ok = inbox.Remove(-1)
+	assert.False(t, ok)
+	assert.Len(t, inbox, 2)
+}
+
+func TestMessageAttachments(t *testing.T) {
+
+	assert.False(t, Message{}.HasAttachments())
+	assert.False(t, Message{COD: 10}.HasAttachments())
+	assert.True(t, Message{Gold: 1}.HasAttachments())
+	assert.True(t, Message{Item: &items.Item{ItemId: 1}}.HasAttachments())
+
+	assert.False(t, Message{FromName: `Auction House`}.IsPlayerMail())
+	assert.True(t, Message{FromUserId: 3, FromName: `Bobbo`}.IsPlayerMail())
+}
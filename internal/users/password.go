@@ -0,0 +1,177 @@
+package users
+
+import (
+	"crypto/pbkdf2"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/GoMudEngine/GoMud/internal/util"
+)
+
+//
+// Stored password format:
+//   $pbkdf2-sha256$<iterations>$<base64 salt>$<base64 key>
+//
+// Anything not in this format is considered a legacy password,
+// either an unsalted sha256 hex string or (after a manual reset) plaintext.
+//
+
+const (
+	passwordScheme     = `pbkdf2-sha256`
+	passwordSaltSize   = 16
+	passwordKeySize    = 32
+	passwordIterations = 600000
+)
+
+var (
+	ErrInvalidPasswordHash = errors.New(`invalid password hash format`)
+
+	// Can be lowered by tests to keep them fast
+	hashIterations = passwordIterations
+)
+
+type PasswordScheme int
+
+const (
+	PasswordSchemeCurrent   PasswordScheme = iota // $pbkdf2-sha256$ with current iteration count
+	PasswordSchemeOutdated                        // $pbkdf2-sha256$ with a lower iteration count
+	PasswordSchemeSha256                          // Legacy unsalted sha256 hex
+	PasswordSchemePlaintext                       // Legacy plaintext (manually reset passwords)
+)
+
+func (s PasswordScheme) String() string {
+	switch s {
+	case PasswordSchemeCurrent:
+		return `current`
+	case PasswordSchemeOutdated:
+		return `outdated`
+	case PasswordSchemeSha256:
+		return `sha256`
+	}
+	return `plaintext`
+}
+
+// Returns true if the scheme should be replaced with a fresh hash
+func (s PasswordScheme) NeedsRehash() bool {
+	return s != PasswordSchemeCurrent
+}
+
+// HashPassword returns a salted, self describing hash of the password
+func HashPassword(pw string) (string, error) {
+
+	salt := make([]byte, passwordSaltSize)
+	if _, err := rand.Read(salt); err != nil {
+		return ``, err
+	}
+
+	return hashPasswordWithSalt(pw, salt, hashIterations)
+}
+
+func hashPasswordWithSalt(pw string, salt []byte, iterations int) (string, error) {
+
+	key, err := pbkdf2.Key(sha256.New, pw, salt, iterations, passwordKeySize)
+	if err != nil {
+		return ``, err
+	}
+
+	return fmt.Sprintf(`$%s$%d$%s$%s`,
+		passwordScheme,
+		iterations,
+		base64.RawStdEncoding.EncodeToString(salt),
+		base64.RawStdEncoding.EncodeToString(key),
+	), nil
+}
+
+// GetPasswordScheme identifies how a stored password was written
+func GetPasswordScheme(stored string) PasswordScheme {
+
+	if iterations, _, _, err := parsePasswordHash(stored); err == nil {
+		if iterations < hashIterations {
+			return PasswordSchemeOutdated
+		}
+		return PasswordSchemeCurrent
+	}
+
+	if isSha256Hex(stored) {
+		return PasswordSchemeSha256
+	}
+
+	return PasswordSchemePlaintext
+}
+
+// VerifyPassword checks input against a stored password of any known scheme.
+// The returned scheme tells the caller whether the stored value should be upgraded.
+func VerifyPassword(stored string, input string) (bool, PasswordScheme) {
+
+	scheme := GetPasswordScheme(stored)
+
+	switch scheme {
+
+	case PasswordSchemeCurrent, PasswordSchemeOutdated:
+
+		iterations, salt, key, _ := parsePasswordHash(stored)
+
+		inputKey, err := pbkdf2.Key(sha256.New, input, salt, iterations, len(key))
+		if err != nil {
+			return false, scheme
+		}
+
+		return subtle.ConstantTimeCompare(inputKey, key) == 1, scheme
+
+	case PasswordSchemeSha256:
+
+		return subtle.ConstantTimeCompare([]byte(util.Hash(input)), []byte(strings.ToLower(stored))) == 1, scheme
+
+	}
+
+	// Empty passwords never match
+	if stored == `` {
+		return false, scheme
+	}
+
+	return subtle.ConstantTimeCompare([]byte(input), []byte(stored)) == 1, scheme
+}
+
+func parsePasswordHash(stored string) (iterations int, salt []byte, key []byte, err error) {
+
+	// "", scheme, iterations, salt, key
+	parts := strings.Split(stored, `$`)
+	if len(parts) != 5 || parts[0] != `` || parts[1] != passwordScheme {
+		return 0, nil, nil, ErrInvalidPasswordHash
+	}
+
+	if iterations, err = strconv.Atoi(parts[2]); err != nil || iterations < 1 {
+		return 0, nil, nil, ErrInvalidPasswordHash
+	}
+
+	if salt, err = base64.RawStdEncoding.DecodeString(parts[3]); err != nil {
+		return 0, nil, nil, ErrInvalidPasswordHash
+	}
+
+	if key, err = base64.RawStdEncoding.DecodeString(parts[4]); err != nil || len(key) == 0 {
+		return 0, nil, nil, ErrInvalidPasswordHash
+	}
+
+	return iterations, salt, key, nil
+}
+
+func isSha256Hex(s string) bool {
+
+	if len(s) != sha256.Size*2 {
+		return false
+	}
+
+	for _, r := range s {
+		if (r < '0' || r > '9') && (r < 'a' || r > 'f') && (r < 'A' || r > 'F') {
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,73 @@
+package users
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/GoMudEngine/GoMud/internal/util"
+)
+
+func TestHashPassword(t *testing.T) {
+
+	hashIterations = 1000
+	defer func() { hashIterations = passwordIterations }()
+
+	hash1, err := HashPassword(`hunter2`)
+	if err != nil {
+		t.Fatalf("HashPassword() error: %v", err)
+	}
+
+	hash2, _ := HashPassword(`hunter2`)
+
+	if !strings.HasPrefix(hash1, `$pbkdf2-sha256$1000$`) {
+		t.Errorf("HashPassword() = %q, unexpected format", hash1)
+	}
+
+	if hash1 == hash2 {
+		t.Errorf("HashPassword() produced identical hashes, salt is not being applied")
+	}
+
+	if scheme := GetPasswordScheme(hash1); scheme != PasswordSchemeCurrent {
+		t.Errorf("GetPasswordScheme() = %s, want %s", scheme, PasswordSchemeCurrent)
+	}
+}
+
+func TestVerifyPassword(t *testing.T) {
+
+	hashIterations = 1000
+	defer func() { hashIterations = passwordIterations }()
+
+	current, _ := HashPassword(`hunter2`)
+	outdated, _ := hashPasswordWithSalt(`hunter2`, []byte(`0123456789abcdef`), 10)
+
+	tests := []struct {
+		name       string
+		stored     string
+		input      string
+		wantMatch  bool
+		wantScheme PasswordScheme
+	}{
+		{"current match", current, `hunter2`, true, PasswordSchemeCurrent},
+		{"current mismatch", current, `hunter3`, false, PasswordSchemeCurrent},
+		{"outdated match", outdated, `hunter2`, true, PasswordSchemeOutdated},
+		{"sha256 match", util.Hash(`hunter2`), `hunter2`, true, PasswordSchemeSha256},
+		{"sha256 mismatch", util.Hash(`hunter2`), `hunter3`, false, PasswordSchemeSha256},
+		{"sha256 no pass-the-hash", util.Hash(`hunter2`), util.Hash(`hunter2`), false, PasswordSchemeSha256},
+		{"plaintext match", `hunter2`, `hunter2`, true, PasswordSchemePlaintext},
+		{"plaintext mismatch", `hunter2`, `Hunter2`, false, PasswordSchemePlaintext},
+		{"empty never matches", ``, ``, false, PasswordSchemePlaintext},
+		{"malformed hash", `$pbkdf2-sha256$abc$$`, `hunter2`, false, PasswordSchemePlaintext},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			match, scheme := VerifyPassword(tt.stored, tt.input)
+			if match != tt.wantMatch {
+				t.Errorf("VerifyPassword() match = %v, want %v", match, tt.wantMatch)
+			}
+			if scheme != tt.wantScheme {
+				t.Errorf("VerifyPassword() scheme = %s, want %s", scheme, tt.wantScheme)
+			}
+		})
+	}
+}
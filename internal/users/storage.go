@@ -19,11 +19,11 @@ func (s *Storage) FindItem(itemName string) (items.Item, bool) {
 	closeMatchItem, matchItem := items.FindMatchIn(itemName, s.Items...)
 
 	if matchItem.ItemId != 0 {
-		return matchItem, true
+		return matchItem.Single(), true
 	}
 
 	if closeMatchItem.ItemId != 0 {
-		return closeMatchItem, true
+		return closeMatchItem.Single(), true
 	}
 
 	return items.Item{}, false
@@ -33,16 +33,18 @@ func (s *Storage) AddItem(i items.Item) bool {
 	if i.ItemId < 1 {
 		return false
 	}
-	s.Items = append(s.Items, i)
+	s.Items = items.AddToStack(s.Items, i)
 	return true
 }
 
+// Removes the item, or as many as its quantity from a stack
 func (s *Storage) RemoveItem(i items.Item) bool {
-	for j := len(s.Items) - 1; j >= 0; j-- {
-		if s.Items[j].Equals(i) {
-			s.Items = append(s.Items[:j], s.Items[j+1:]...)
-			return true
-		}
-	}
-	return false
+	var removed bool
+	s.Items, removed = items.RemoveFromStack(s.Items, i)
+	return removed
+}
+
+// How many of an item found in storage are in its stack
+func (s *Storage) Count(i items.Item) int {
+	return items.QuantityIn(i, s.Items...)
 }
@@ -0,0 +1,41 @@
+package users
+
+import (
+	"github.com/GoMudEngine/GoMud/internal/channels"
+)
+
+//
+// This file contains receiver methods for the UserRecord struct dealing with communication channels.
+//
+
+// Whether the user is allowed to use the channel at all
+func (u *UserRecord) CanUseChannel(c *channels.ChannelSpec) bool {
+	// false keeps this from being logged like an admin command check
+	return c.CanUse(u.Character.Level, func(permissionId string) bool {
+		return u.HasRolePermission(permissionId, false)
+	})
+}
+
+func (u *UserRecord) GetChannelStatus(channelId string) channels.Status {
+	if u.Channels == nil {
+		return ``
+	}
+	return channels.Status(u.Channels[channelId])
+}
+
+func (u *UserRecord) SetChannelStatus(channelId string, status channels.Status) {
+	if u.Channels == nil {
+		u.Channels = map[string]string{}
+	}
+	u.Channels[channelId] = string(status)
+}
+
+// Whether the user is in the channel and allowed to use it
+func (u *UserRecord) InChannel(c *channels.ChannelSpec) bool {
+	return c.IsMember(u.GetChannelStatus(c.ChannelId)) && u.CanUseChannel(c)
+}
+
+// Whether messages on the channel should be shown to the user
+func (u *UserRecord) HearsChannel(c *channels.ChannelSpec) bool {
+	return u.InChannel(c) && u.GetChannelStatus(c.ChannelId) != channels.StatusMuted
+}
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"math"
 	"math/big"
+	"slices"
 	"strings"
 	"time"
 
@@ -45,6 +46,8 @@ type UserRecord struct {
 	ScreenReader   bool                  `yaml:"screenreader,omitempty"` // Are they using a screen reader? (We should remove excess symbols)
 	EmailAddress   string                `yaml:"emailaddress,omitempty"` // Email address (if provided)
 	TipsComplete   map[string]bool       `yaml:"tipscomplete,omitempty"` // Tips the user has followed/completed so they can be quiet
+	ApiTokens      []ApiToken            `yaml:"apitokens,omitempty"`    // Tokens for the web admin api
+	Channels       map[string]string     `yaml:"channels,omitempty"`     // channelId => joined/left/muted. Channels not listed use their defaults
 	EventLog       UserLog               `yaml:"-"`                      // Do not retain in user file (for now)
 	LastMusic      string                `yaml:"-"`                      // Keeps track of the last music that was played
 	connectionId   uint64
@@ -88,22 +91,45 @@ func (u *UserRecord) ClientSettings() connections.ClientSettings {
 	return connections.GetClientSettings(u.connectionId)
 }
 
+// Checks input against the stored password.
+// If the stored password uses a legacy scheme (sha256/plaintext) it is
+// re-hashed with the current scheme and the user record is saved.
 func (u *UserRecord) PasswordMatches(input string) bool {
 
-	if input == u.Password {
-		return true
+	match, scheme := VerifyPassword(u.Password, input)
+	if !match {
+		return false
 	}
 
-	if u.Password == util.Hash(input) {
-		return true
-	}
+	if scheme.NeedsRehash() {
 
-	// In case we reset the password to a plaintext string
-	if input == util.Hash(u.Password) {
-		return true
+		newHash, err := HashPassword(input)
+		if err != nil {
+			mudlog.Error("PasswordMatches()", "username", u.Username, "error", err)
+			return true
+		}
+
+		u.Password = newHash
+
+		// If this is a copy of an online user, keep the online record in sync
+		// so it doesn't write the old hash back on the next save.
+		if onlineUser := GetByUserId(u.UserId); onlineUser != nil && onlineUser != u {
+			onlineUser.Password = newHash
+		}
+
+		if err := SaveUser(*u); err != nil {
+			mudlog.Error("PasswordMatches()", "username", u.Username, "error", err)
+		}
+
+		mudlog.Info("PasswordMatches()", "username", u.Username, "message", "upgraded password hash", "from", scheme.String())
 	}
 
-	return false
+	return true
+}
+
+// Returns true if the users role may only log in over a secure connection
+func (u *UserRecord) RequiresSecureConnection() bool {
+	return slices.Contains(configs.GetNetworkConfig().SecureRoles, u.Role)
 }
 
 func (u *UserRecord) AddCommandAlias(input string, output string) (addedAlias string, deletedAlias string) {
@@ -559,7 +585,12 @@ func (u *UserRecord) SetPassword(pw string) error {
 		return fmt.Errorf("password must be between %d and %d characters long", validation.PasswordSizeMin, validation.PasswordSizeMax)
 	}
 
-	u.Password = util.Hash(pw)
+	hash, err := HashPassword(pw)
+	if err != nil {
+		return err
+	}
+
+	u.Password = hash
 	return nil
 }
 
@@ -13,6 +13,7 @@ import (
 	"github.com/GoMudEngine/GoMud/internal/characters"
 	"github.com/GoMudEngine/GoMud/internal/configs"
 	"github.com/GoMudEngine/GoMud/internal/connections"
+	"github.com/GoMudEngine/GoMud/internal/metrics"
 	"github.com/GoMudEngine/GoMud/internal/mobs"
 	"github.com/GoMudEngine/GoMud/internal/mudlog"
 	"github.com/GoMudEngine/GoMud/internal/util"
@@ -23,6 +24,8 @@ import (
 
 var (
 	userManager *ActiveUsers = newUserManager()
+
+	saveAllSeconds = metrics.NewHistogram(`gomud_save_duration_seconds`, `Time taken to save everything of a kind.`, nil, `what`, `users`)
 )
 
 type ActiveUsers struct {
@@ -256,6 +259,8 @@ func SetZombieUser(userId int) {
 
 func SaveAllUsers(isAutoSave ...bool) {
 
+	defer saveAllSeconds.ObserveSince(time.Now())
+
 	for _, u := range userManager.Users {
 		if err := SaveUser(*u, isAutoSave...); err != nil {
 			mudlog.Error("SaveAllUsers()", "error", err.Error())
@@ -348,6 +353,18 @@ func LoadUser(username string, skipValidation ...bool) (*UserRecord, error) {
 	return loadedUser, nil
 }
 
+func LoadUserById(userId int, skipValidation ...bool) (*UserRecord, error) {
+
+	idx := NewUserIndex()
+	username, found := idx.FindByUserId(int64(userId))
+
+	if !found {
+		return nil, errors.New("user doesn't exist")
+	}
+
+	return LoadUser(username, skipValidation...)
+}
+
 // Loads all user recvords and runs against a function.
 // Stops searching if false is returned.
 func SearchOfflineUsers(searchFunc func(u *UserRecord) bool) {
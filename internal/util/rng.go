@@ -0,0 +1,141 @@
+package util
+
+import (
+	"hash/fnv"
+	"math/rand"
+	"sync"
+	"time"
+)
+
+// Subsystems that draw from their own random number generator.
+// Keeping them apart means a roll in one system can't shift the results of another,
+// so a fight can be replayed from its seed no matter what else happened that round.
+const (
+	RNGCombat = `combat`
+	RNGLoot   = `loot`
+	RNGSpawn  = `spawn`
+	RNGSkills = `skills`
+	RNGIdle   = `idle`
+)
+
+// A source of random numbers that can be seeded for repeatable results.
+type RNG interface {
+	// Returns a number from 0 to maxInt-1, or 0 if maxInt is less than 1
+	Rand(maxInt int) int
+	Seed(seed int64)
+}
+
+type seededRNG struct {
+	lock sync.Mutex
+	src  *rand.Rand
+}
+
+func NewRNG(seed int64) RNG {
+	return &seededRNG{src: rand.New(rand.NewSource(seed))}
+}
+
+func (r *seededRNG) Rand(maxInt int) int {
+	if maxInt < 1 {
+		return 0
+	}
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	return r.src.Intn(maxInt)
+}
+
+func (r *seededRNG) Seed(seed int64) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	r.src.Seed(seed)
+}
+
+var (
+	rngLock       sync.RWMutex
+	rngBaseSeed   int64 = time.Now().UnixNano()
+	rngSeed       int64 = rngBaseSeed
+	rngGlobal     RNG   = NewRNG(rngSeed)
+	rngSubsystems       = map[string]RNG{}
+)
+
+// Reseeds the global generator and every subsystem generator.
+func SeedRand(seed int64) {
+	rngLock.Lock()
+	defer rngLock.Unlock()
+
+	rngBaseSeed = seed
+	reseed(seed)
+}
+
+// Reseeds everything with a seed derived from the base seed and round number.
+// Returns the seed used, which can be passed to SeedRand to replay the round.
+func SeedRoundRand(roundNumber uint64) int64 {
+	rngLock.Lock()
+	defer rngLock.Unlock()
+
+	// splitmix64, so that neighboring rounds get unrelated seeds
+	z := uint64(rngBaseSeed) + roundNumber*0x9e3779b97f4a7c15
+	z = (z ^ (z >> 30)) * 0xbf58476d1ce4e5b9
+	z = (z ^ (z >> 27)) * 0x94d049bb133111eb
+	roundSeed := int64(z ^ (z >> 31))
+
+	reseed(roundSeed)
+
+	return roundSeed
+}
+
+// The seed set at startup, or by the last call to SeedRand()
+func GetRandSeed() int64 {
+	rngLock.RLock()
+	defer rngLock.RUnlock()
+	return rngBaseSeed
+}
+
+// Gets the generator for a subsystem such as RNGCombat.
+// If none has been set, one is created and seeded from the global seed.
+func GetRNG(name string) RNG {
+	rngLock.RLock()
+	rng, ok := rngSubsystems[name]
+	rngLock.RUnlock()
+
+	if ok {
+		return rng
+	}
+
+	rngLock.Lock()
+	defer rngLock.Unlock()
+
+	if rng, ok = rngSubsystems[name]; !ok {
+		rng = NewRNG(subsystemSeed(rngSeed, name))
+		rngSubsystems[name] = rng
+	}
+
+	return rng
+}
+
+// Replaces the generator for a subsystem, such as with a fixed sequence in tests.
+// An empty name replaces the global generator used by Rand()
+func SetRNG(name string, rng RNG) {
+	rngLock.Lock()
+	defer rngLock.Unlock()
+
+	if name == `` {
+		rngGlobal = rng
+		return
+	}
+	rngSubsystems[name] = rng
+}
+
+// Expects rngLock to be held
+func reseed(seed int64) {
+	rngSeed = seed
+	rngGlobal.Seed(seed)
+	for name, rng := range rngSubsystems {
+		rng.Seed(subsystemSeed(seed, name))
+	}
+}
+
+func subsystemSeed(seed int64, name string) int64 {
+	h := fnv.New64a()
+	h.Write([]byte(name))
+	return seed ^ int64(h.Sum64())
+}
@@ -0,0 +1,90 @@
+package util
+
+import (
+	"testing"
+)
+
+func rollSequence(rng RNG, count int) []int {
+	rolls := make([]int, count)
+	for i := range rolls {
+		rolls[i] = rng.Rand(100)
+	}
+	return rolls
+}
+
+func TestSeedRand(t *testing.T) {
+
+	SeedRand(1234)
+	combatRolls := rollSequence(GetRNG(RNGCombat), 20)
+	lootRolls := rollSequence(GetRNG(RNGLoot), 20)
+
+	SeedRand(1234)
+	// Rolling loot first should not change the combat results
+	if got := rollSequence(GetRNG(RNGLoot), 20); !equalInts(got, lootRolls) {
+		t.Errorf("loot rolls = %v, want %v", got, lootRolls)
+	}
+	if got := rollSequence(GetRNG(RNGCombat), 20); !equalInts(got, combatRolls) {
+		t.Errorf("combat rolls = %v, want %v", got, combatRolls)
+	}
+
+	if equalInts(combatRolls, lootRolls) {
+		t.Errorf("Subsystems should not share a sequence")
+	}
+}
+
+func TestSeedRoundRand(t *testing.T) {
+
+	SeedRand(99)
+	roundSeed := SeedRoundRand(5)
+	want := rollSequence(GetRNG(RNGCombat), 10)
+	wantDice := RollDiceWith(GetRNG(RNGCombat), 2, 6)
+
+	// Replaying from the logged seed gives the same round
+	SeedRand(roundSeed)
+	if got := rollSequence(GetRNG(RNGCombat), 10); !equalInts(got, want) {
+		t.Errorf("replayed rolls = %v, want %v", got, want)
+	}
+	if got := RollDiceWith(GetRNG(RNGCombat), 2, 6); got != wantDice {
+		t.Errorf("replayed dice = %d, want %d", got, wantDice)
+	}
+}
+
+type fixedRNG struct {
+	value int
+}
+
+func (f *fixedRNG) Rand(maxInt int) int {
+	if f.value >= maxInt {
+		return maxInt - 1
+	}
+	return f.value
+}
+
+func (f *fixedRNG) Seed(seed int64) {}
+
+func TestSetRNG(t *testing.T) {
+
+	original := GetRNG(RNGSkills)
+	defer SetRNG(RNGSkills, original)
+
+	SetRNG(RNGSkills, &fixedRNG{value: 3})
+
+	if v := GetRNG(RNGSkills).Rand(100); v != 3 {
+		t.Errorf("Rand(100) = %d, want 3", v)
+	}
+	if v := RollDiceWith(GetRNG(RNGSkills), 2, 6); v != 8 {
+		t.Errorf("RollDiceWith(2, 6) = %d, want 8", v)
+	}
+}
+
+func equalInts(a []int, b []int) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
@@ -9,7 +9,6 @@ import (
 	"fmt"
 	"io"
 	"math"
-	"math/rand"
 	"net/http"
 	"os"
 	"path/filepath"
@@ -69,6 +68,8 @@ const (
 	// delta comparisons and to allow for date adjustments.
 	RoundCountMinimum  = 1314000
 	RoundCountFilename = `.roundcount`
+
+	QuantityAll = -1 // Returned by SplitQuantity for "all"
 )
 
 // Mutex lock intended for synchronizing at a high level between
@@ -171,10 +172,10 @@ func (t *Accumulator) Record(nextValue float64) {
 }
 
 func Rand(maxInt int) int {
-	if maxInt < 1 {
-		return 0
-	}
-	return rand.Intn(maxInt)
+	rngLock.RLock()
+	rng := rngGlobal
+	rngLock.RUnlock()
+	return rng.Rand(maxInt)
 }
 
 func LogRoll(name string, rollResult int, targetNumber int) {
@@ -275,6 +276,28 @@ func SplitButRespectQuotes(s string) []string {
 	return finalMatches
 }
 
+// Splits a leading amount off of input such as "5 arrows" or "all potion".
+// Returns QuantityAll for "all", or 1 if no amount was given.
+func SplitQuantity(input string) (int, string) {
+	input = strings.TrimSpace(input)
+
+	amount, remainder, found := strings.Cut(input, ` `)
+	if !found || strings.TrimSpace(remainder) == `` {
+		return 1, input
+	}
+	remainder = strings.TrimSpace(remainder)
+
+	if strings.ToLower(amount) == `all` {
+		return QuantityAll, remainder
+	}
+
+	if qty, err := strconv.Atoi(amount); err == nil && qty > 0 {
+		return qty, remainder
+	}
+
+	return 1, input
+}
+
 // accepts an input and splits it along a # if any.
 // By default returns the full string and 1 as the number.
 func GetMatchNumber(input string) (string, int) {
@@ -521,6 +544,14 @@ func ProgressBar(complete float64, maxBarSize int, barParts ...string) (fullBar
 
 // Returns X dice rolled with Y sides
 func RollDice(dice int, sides int) int {
+	rngLock.RLock()
+	rng := rngGlobal
+	rngLock.RUnlock()
+	return RollDiceWith(rng, dice, sides)
+}
+
+// Returns X dice rolled with Y sides, using a specific generator such as GetRNG(RNGCombat)
+func RollDiceWith(rng RNG, dice int, sides int) int {
 	var total int
 
 	invert := dice < 0
@@ -534,7 +565,7 @@ func RollDice(dice int, sides int) int {
 	}
 
 	for i := 0; i < dice; i++ {
-		total += Rand(sides) + 1
+		total += rng.Rand(sides) + 1
 	}
 
 	if invert {
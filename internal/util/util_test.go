@@ -513,6 +513,29 @@ func TestGetMatchNumber(t *testing.T) {
 	}
 }
 
+func TestSplitQuantity(t *testing.T) {
+	tests := []struct {
+		input string
+		qty   int
+		rest  string
+	}{
+		{"arrows", 1, "arrows"},
+		{"5 arrows", 5, "arrows"},
+		{"all healing potion", QuantityAll, "healing potion"},
+		{"all", 1, "all"},
+		{"0 arrows", 1, "0 arrows"},
+		{"10", 1, "10"},
+	}
+
+	for _, tt := range tests {
+		gotQty, gotRest := SplitQuantity(tt.input)
+		if gotQty != tt.qty || gotRest != tt.rest {
+			t.Errorf("SplitQuantity(%q) got (%d, %q), want (%d, %q)",
+				tt.input, gotQty, gotRest, tt.qty, tt.rest)
+		}
+	}
+}
+
 // TestFindMatchIn checks the behavior of partial and full matches in a slice.
 func TestFindMatchIn(t *testing.T) {
 	items := []string{"SWORD", "SHINING SWORD", "SHIELD", "BIG HELM", "HELMET", "GEM"}
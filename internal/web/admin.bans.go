@@ -0,0 +1,31 @@
+package web
+
+import (
+	"net/http"
+	"text/template"
+
+	"github.com/GoMudEngine/GoMud/internal/bans"
+	"github.com/GoMudEngine/GoMud/internal/configs"
+	"github.com/GoMudEngine/GoMud/internal/mudlog"
+)
+
+func bansIndex(w http.ResponseWriter, r *http.Request) {
+
+	tmpl, err := template.New("index.html").Funcs(funcMap).ParseFiles(configs.GetFilePathsConfig().AdminHtml.String()+"/_header.html", configs.GetFilePathsConfig().AdminHtml.String()+"/bans/index.html", configs.GetFilePathsConfig().AdminHtml.String()+"/_footer.html")
+	if err != nil {
+		mudlog.Error("HTML Template", "error", err)
+	}
+
+	banIndexData := struct {
+		Bans     []bans.Ban
+		Lockouts []bans.Lockout
+	}{
+		bans.GetBans(),
+		bans.GetLockouts(),
+	}
+
+	if err := tmpl.Execute(w, banIndexData); err != nil {
+		mudlog.Error("HTML Execute", "error", err)
+	}
+
+}
@@ -110,6 +110,7 @@ func mobData(w http.ResponseWriter, r *http.Request) {
 		`Buffs`:       {},
 		`Mercenaries`: {},
 		`Pets`:        {},
+		`Services`:    {},
 	}
 
 	for _, shopItm := range mobInfo.Character.Shop {
@@ -133,6 +134,11 @@ func mobData(w http.ResponseWriter, r *http.Request) {
 			shopData[`Pets`] = append(shopData[`Pets`], shopItm)
 			continue
 		}
+
+		if shopItm.Service != `` {
+			shopData[`Services`] = append(shopData[`Services`], shopItm)
+			continue
+		}
 	}
 	tplData[`mobShop`] = shopData
 
@@ -0,0 +1,1062 @@
+package web
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"sort"
+	"strconv"
+
+	"github.com/GoMudEngine/GoMud/internal/buffs"
+	"github.com/GoMudEngine/GoMud/internal/items"
+	"github.com/GoMudEngine/GoMud/internal/mobs"
+	"github.com/GoMudEngine/GoMud/internal/mudlog"
+	"github.com/GoMudEngine/GoMud/internal/mutators"
+	"github.com/GoMudEngine/GoMud/internal/quests"
+	"github.com/GoMudEngine/GoMud/internal/races"
+	"github.com/GoMudEngine/GoMud/internal/rooms"
+	"github.com/GoMudEngine/GoMud/internal/spells"
+)
+
+//
+// JSON API for builders
+//
+// GET    /api/v1/{resource}/      - list everything
+// GET    /api/v1/{resource}/{id}  - get one
+// POST   /api/v1/{resource}/      - create (body is the full object)
+// PUT    /api/v1/{resource}/{id}  - replace (body is the full object)
+// DELETE /api/v1/{resource}/{id}  - delete
+//
+// All requests require a session or api token with the matching permission,
+// such as room.info, room.create, room.edit or room.delete,
+// and run with the game locked so the live world stays consistent.
+//
+
+const (
+	apiMaxBodySize     = 1 << 20
+	apiDefaultPageSize = 100
+	apiMaxPageSize     = 1000
+)
+
+type apiError struct {
+	Status  int
+	Message string
+}
+
+func (e apiError) Error() string {
+	return e.Message
+}
+
+func apiNotFound(resource string, id string) error {
+	return apiError{http.StatusNotFound, fmt.Sprintf(`%s %s not found`, resource, id)}
+}
+
+func apiBadRequest(err error) error {
+	return apiError{http.StatusBadRequest, err.Error()}
+}
+
+func apiConflict(resource string, id string) error {
+	return apiError{http.StatusConflict, fmt.Sprintf(`%s %s already exists`, resource, id)}
+}
+
+type apiResource struct {
+	permission string // Role permission prefix such as "room"
+	list       func(r *http.Request) any
+	get        func(id string) (any, error)
+	create     func(body []byte) (any, error)
+	update     func(id string, body []byte) (any, error)
+	remove     func(id string) error
+}
+
+var apiResources = map[string]apiResource{
+	`rooms`:    roomsApi(),
+	`items`:    itemsApi(),
+	`mobs`:     mobsApi(),
+	`races`:    racesApi(),
+	`mutators`: mutatorsApi(),
+	`buffs`:    buffsApi(),
+	`spells`:   spellsApi(),
+	`quests`:   questsApi(),
+}
+
+func registerApiHandlers() {
+
+	http.HandleFunc("GET /api/v1/{resource}/", RunWithMUDLocked(
+		doAuth(``, apiList),
+	))
+	http.HandleFunc("GET /api/v1/{resource}/{id}", RunWithMUDLocked(
+		doAuth(``, apiGet),
+	))
+	http.HandleFunc("POST /api/v1/{resource}/", RunWithMUDLocked(
+		doAuth(``, apiCreate),
+	))
+	http.HandleFunc("PUT /api/v1/{resource}/{id}", RunWithMUDLocked(
+		doAuth(``, apiUpdate),
+	))
+	http.HandleFunc("DELETE /api/v1/{resource}/{id}", RunWithMUDLocked(
+		doAuth(``, apiDelete),
+	))
+}
+
+func apiList(w http.ResponseWriter, r *http.Request) {
+
+	res, ok := apiResources[r.PathValue(`resource`)]
+	if !ok {
+		writeApiError(w, r, apiNotFound(`resource`, r.PathValue(`resource`)))
+		return
+	}
+
+	if !requireApiPermission(w, r, res.permission+`.info`) {
+		return
+	}
+
+	writeApiJson(w, http.StatusOK, res.list(r))
+}
+
+func apiGet(w http.ResponseWriter, r *http.Request) {
+
+	res, ok := apiResources[r.PathValue(`resource`)]
+	if !ok {
+		writeApiError(w, r, apiNotFound(`resource`, r.PathValue(`resource`)))
+		return
+	}
+
+	if !requireApiPermission(w, r, res.permission+`.info`) {
+		return
+	}
+
+	result, err := res.get(r.PathValue(`id`))
+	if err != nil {
+		writeApiError(w, r, err)
+		return
+	}
+
+	writeApiJson(w, http.StatusOK, result)
+}
+
+func apiCreate(w http.ResponseWriter, r *http.Request) {
+
+	res, ok := apiResources[r.PathValue(`resource`)]
+	if !ok {
+		writeApiError(w, r, apiNotFound(`resource`, r.PathValue(`resource`)))
+		return
+	}
+
+	if !requireApiPermission(w, r, res.permission+`.create`) {
+		return
+	}
+
+	body, err := readApiBody(r)
+	if err != nil {
+		writeApiError(w, r, err)
+		return
+	}
+
+	result, err := res.create(body)
+	if err != nil {
+		writeApiError(w, r, err)
+		return
+	}
+
+	mudlog.Warn("API", "ip", r.RemoteAddr, "action", "create", "resource", r.PathValue(`resource`))
+
+	writeApiJson(w, http.StatusCreated, result)
+}
+
+func apiUpdate(w http.ResponseWriter, r *http.Request) {
+
+	res, ok := apiResources[r.PathValue(`resource`)]
+	if !ok {
+		writeApiError(w, r, apiNotFound(`resource`, r.PathValue(`resource`)))
+		return
+	}
+
+	if !requireApiPermission(w, r, res.permission+`.edit`) {
+		return
+	}
+
+	body, err := readApiBody(r)
+	if err != nil {
+		writeApiError(w, r, err)
+		return
+	}
+
+	result, err := res.update(r.PathValue(`id`), body)
+	if err != nil {
+		writeApiError(w, r, err)
+		return
+	}
+
+	mudlog.Warn("API", "ip", r.RemoteAddr, "action", "update", "resource", r.PathValue(`resource`), "id", r.PathValue(`id`))
+
+	writeApiJson(w, http.StatusOK, result)
+}
+
+func apiDelete(w http.ResponseWriter, r *http.Request) {
+
+	res, ok := apiResources[r.PathValue(`resource`)]
+	if !ok {
+		writeApiError(w, r, apiNotFound(`resource`, r.PathValue(`resource`)))
+		return
+	}
+
+	if !requireApiPermission(w, r, res.permission+`.delete`) {
+		return
+	}
+
+	if err := res.remove(r.PathValue(`id`)); err != nil {
+		writeApiError(w, r, err)
+		return
+	}
+
+	mudlog.Warn("API", "ip", r.RemoteAddr, "action", "delete", "resource", r.PathValue(`resource`), "id", r.PathValue(`id`))
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func requireApiPermission(w http.ResponseWriter, r *http.Request, permissionId string) bool {
+
+	if auth, ok := getWebAuth(r); ok && auth.HasPermission(permissionId) {
+		return true
+	}
+
+	writeApiError(w, r, apiError{http.StatusForbidden, `missing permission ` + permissionId})
+	return false
+}
+
+func readApiBody(r *http.Request) ([]byte, error) {
+
+	body, err := io.ReadAll(io.LimitReader(r.Body, apiMaxBodySize+1))
+	if err != nil {
+		return nil, apiBadRequest(err)
+	}
+
+	if len(body) > apiMaxBodySize {
+		return nil, apiError{http.StatusRequestEntityTooLarge, `request body too large`}
+	}
+
+	return body, nil
+}
+
+// Unknown fields are rejected so that typos don't silently get dropped
+func decodeApiBody[T any](body []byte) (T, error) {
+
+	var result T
+
+	dec := json.NewDecoder(bytes.NewReader(body))
+	dec.DisallowUnknownFields()
+
+	if err := dec.Decode(&result); err != nil {
+		return result, apiBadRequest(err)
+	}
+
+	return result, nil
+}
+
+// Reads ?offset= and ?limit= for lists that are paged
+func parseApiPage(r *http.Request) (offset int, limit int) {
+
+	offset, _ = strconv.Atoi(r.URL.Query().Get(`offset`))
+	if offset < 0 {
+		offset = 0
+	}
+
+	limit, _ = strconv.Atoi(r.URL.Query().Get(`limit`))
+	if limit < 1 || limit > apiMaxPageSize {
+		limit = apiDefaultPageSize
+	}
+
+	return offset, limit
+}
+
+func parseApiIntId(resource string, id string) (int, error) {
+	idInt, err := strconv.Atoi(id)
+	if err != nil {
+		return 0, apiNotFound(resource, id)
+	}
+	return idInt, nil
+}
+
+func writeApiJson(w http.ResponseWriter, status int, data any) {
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+
+	if err := json.NewEncoder(w).Encode(data); err != nil {
+		mudlog.Error("API", "action", "Encode", "error", err)
+	}
+}
+
+func writeApiError(w http.ResponseWriter, r *http.Request, err error) {
+
+	status := http.StatusInternalServerError
+
+	var aErr apiError
+	if errors.As(err, &aErr) {
+		status = aErr.Status
+	}
+
+	if status == http.StatusInternalServerError {
+		mudlog.Error("API", "ip", r.RemoteAddr, "method", r.Method, "path", r.URL.Path, "error", err)
+	}
+
+	writeApiJson(w, status, map[string]string{`error`: err.Error()})
+}
+
+//
+// Rooms
+//
+
+func roomsApi() apiResource {
+
+	getRoom := func(id string) (*rooms.Room, error) {
+		roomId, err := parseApiIntId(`room`, id)
+		if err != nil {
+			return nil, err
+		}
+		roomTpl := rooms.LoadRoomTemplate(roomId)
+		if roomTpl == nil {
+			return nil, apiNotFound(`room`, id)
+		}
+		return roomTpl, nil
+	}
+
+	return apiResource{
+		permission: `room`,
+		list: func(r *http.Request) any {
+
+			type shortRoomInfo struct {
+				RoomId int
+				Zone   string
+				Title  string
+			}
+
+			zoneNames := rooms.GetAllZoneNames()
+			if zoneFilter := r.URL.Query().Get(`zone`); zoneFilter != `` {
+				zoneNames = []string{zoneFilter}
+			}
+
+			// The zone index says which rooms exist without loading any of them
+			allRooms := []shortRoomInfo{}
+			for _, zoneName := range zoneNames {
+				for _, roomId := range rooms.GetAllZoneRoomsIds(zoneName) {
+					allRooms = append(allRooms, shortRoomInfo{RoomId: roomId, Zone: zoneName})
+				}
+			}
+
+			sort.SliceStable(allRooms, func(i, j int) bool {
+				return allRooms[i].RoomId < allRooms[j].RoomId
+			})
+
+			offset, limit := parseApiPage(r)
+			if offset > len(allRooms) {
+				offset = len(allRooms)
+			}
+			allRooms = allRooms[offset:min(offset+limit, len(allRooms))]
+
+			// Titles come from the templates on disk, so listing doesn't load rooms (and their spawns) into the world
+			result := make([]shortRoomInfo, 0, len(allRooms))
+			for _, info := range allRooms {
+				if roomTpl := rooms.LoadRoomTemplate(info.RoomId); roomTpl != nil {
+					info.Title = roomTpl.Title
+					result = append(result, info)
+				}
+			}
+
+			return result
+		},
+		get: func(id string) (any, error) {
+			return getRoom(id)
+		},
+		create: func(body []byte) (any, error) {
+
+			roomTpl, err := decodeApiBody[rooms.Room](body)
+			if err != nil {
+				return nil, err
+			}
+
+			if rooms.GetZoneConfig(roomTpl.Zone) == nil {
+				return nil, apiBadRequest(fmt.Errorf(`zone %s does not exist`, roomTpl.Zone))
+			}
+
+			if err := roomTpl.Validate(); err != nil {
+				return nil, apiBadRequest(err)
+			}
+
+			roomId, err := rooms.CreateRoom(roomTpl)
+			if err != nil {
+				return nil, err
+			}
+
+			return rooms.LoadRoomTemplate(roomId), nil
+		},
+		update: func(id string, body []byte) (any, error) {
+
+			oldRoomTpl, err := getRoom(id)
+			if err != nil {
+				return nil, err
+			}
+
+			roomTpl, err := decodeApiBody[rooms.Room](body)
+			if err != nil {
+				return nil, err
+			}
+			roomTpl.RoomId = oldRoomTpl.RoomId
+
+			if err := roomTpl.Validate(); err != nil {
+				return nil, apiBadRequest(err)
+			}
+
+			if roomTpl.Zone != oldRoomTpl.Zone {
+				if err := rooms.MoveToZone(roomTpl.RoomId, roomTpl.Zone); err != nil {
+					return nil, apiBadRequest(err)
+				}
+			}
+
+			// SaveRoomTemplate carries over the live state of the room, so it must be loaded
+			rooms.LoadRoom(roomTpl.RoomId)
+
+			if err := rooms.SaveRoomTemplate(roomTpl); err != nil {
+				return nil, err
+			}
+
+			return rooms.LoadRoomTemplate(roomTpl.RoomId), nil
+		},
+		remove: func(id string) error {
+
+			roomTpl, err := getRoom(id)
+			if err != nil {
+				return err
+			}
+
+			if err := rooms.DeleteRoom(roomTpl.RoomId); err != nil {
+				return apiBadRequest(err)
+			}
+
+			return nil
+		},
+	}
+}
+
+//
+// Items
+//
+
+func itemsApi() apiResource {
+
+	getItem := func(id string) (*items.ItemSpec, error) {
+		itemId, err := parseApiIntId(`item`, id)
+		if err != nil {
+			return nil, err
+		}
+		itemSpec := items.GetItemSpec(itemId)
+		if itemSpec == nil {
+			return nil, apiNotFound(`item`, id)
+		}
+		return itemSpec, nil
+	}
+
+	return apiResource{
+		permission: `item`,
+		list: func(r *http.Request) any {
+			result := items.GetAllItemSpecs()
+			sort.SliceStable(result, func(i, j int) bool {
+				return result[i].ItemId < result[j].ItemId
+			})
+			return result
+		},
+		get: func(id string) (any, error) {
+			return getItem(id)
+		},
+		create: func(body []byte) (any, error) {
+
+			itemSpec, err := decodeApiBody[items.ItemSpec](body)
+			if err != nil {
+				return nil, err
+			}
+
+			// The item id is assigned based on the item type
+			itemId, err := items.CreateNewItemFile(itemSpec)
+			if err != nil {
+				return nil, apiBadRequest(err)
+			}
+
+			return items.GetItemSpec(itemId), nil
+		},
+		update: func(id string, body []byte) (any, error) {
+
+			oldItemSpec, err := getItem(id)
+			if err != nil {
+				return nil, err
+			}
+
+			itemSpec, err := decodeApiBody[items.ItemSpec](body)
+			if err != nil {
+				return nil, err
+			}
+			itemSpec.ItemId = oldItemSpec.ItemId
+
+			if err := itemSpec.Validate(); err != nil {
+				return nil, apiBadRequest(err)
+			}
+
+			if err := items.SaveItemSpec(itemSpec); err != nil {
+				return nil, err
+			}
+
+			return items.GetItemSpec(itemSpec.ItemId), nil
+		},
+		remove: func(id string) error {
+
+			itemSpec, err := getItem(id)
+			if err != nil {
+				return err
+			}
+
+			return items.DeleteItemSpec(itemSpec.ItemId)
+		},
+	}
+}
+
+//
+// Mobs
+//
+
+func mobsApi() apiResource {
+
+	getMob := func(id string) (*mobs.Mob, error) {
+		mobId, err := parseApiIntId(`mob`, id)
+		if err != nil {
+			return nil, err
+		}
+		mobSpec := mobs.GetMobSpec(mobs.MobId(mobId))
+		if mobSpec == nil {
+			return nil, apiNotFound(`mob`, id)
+		}
+		return mobSpec, nil
+	}
+
+	return apiResource{
+		permission: `mob`,
+		list: func(r *http.Request) any {
+			result := mobs.GetAllMobInfo()
+			sort.SliceStable(result, func(i, j int) bool {
+				return result[i].MobId < result[j].MobId
+			})
+			return result
+		},
+		get: func(id string) (any, error) {
+			return getMob(id)
+		},
+		create: func(body []byte) (any, error) {
+
+			mobSpec, err := decodeApiBody[mobs.Mob](body)
+			if err != nil {
+				return nil, err
+			}
+
+			mobId, err := mobs.CreateNewMobFile(mobSpec, ``)
+			if err != nil {
+				return nil, apiBadRequest(err)
+			}
+
+			return mobs.GetMobSpec(mobId), nil
+		},
+		update: func(id string, body []byte) (any, error) {
+
+			oldMobSpec, err := getMob(id)
+			if err != nil {
+				return nil, err
+			}
+
+			mobSpec, err := decodeApiBody[mobs.Mob](body)
+			if err != nil {
+				return nil, err
+			}
+			mobSpec.MobId = oldMobSpec.MobId
+
+			if err := mobSpec.Validate(); err != nil {
+				return nil, apiBadRequest(err)
+			}
+
+			if err := mobs.SaveMobSpec(mobSpec); err != nil {
+				return nil, err
+			}
+
+			return mobs.GetMobSpec(mobSpec.MobId), nil
+		},
+		remove: func(id string) error {
+
+			mobSpec, err := getMob(id)
+			if err != nil {
+				return err
+			}
+
+			return mobs.DeleteMobSpec(mobSpec.MobId)
+		},
+	}
+}
+
+//
+// Races
+//
+
+func racesApi() apiResource {
+
+	getRace := func(id string) (*races.Race, error) {
+		raceId, err := parseApiIntId(`race`, id)
+		if err != nil {
+			return nil, err
+		}
+		race := races.GetRace(raceId)
+		if race == nil {
+			return nil, apiNotFound(`race`, id)
+		}
+		return race, nil
+	}
+
+	return apiResource{
+		permission: `race`,
+		list: func(r *http.Request) any {
+			result := races.GetRaces()
+			sort.SliceStable(result, func(i, j int) bool {
+				return result[i].RaceId < result[j].RaceId
+			})
+			return result
+		},
+		get: func(id string) (any, error) {
+			return getRace(id)
+		},
+		create: func(body []byte) (any, error) {
+
+			race, err := decodeApiBody[races.Race](body)
+			if err != nil {
+				return nil, err
+			}
+
+			if race.RaceId == 0 {
+				for _, r := range races.GetRaces() {
+					if r.RaceId > race.RaceId {
+						race.RaceId = r.RaceId
+					}
+				}
+				race.RaceId++
+			} else if races.GetRace(race.RaceId) != nil {
+				return nil, apiConflict(`race`, strconv.Itoa(race.RaceId))
+			}
+
+			if err := race.Validate(); err != nil {
+				return nil, apiBadRequest(err)
+			}
+
+			if err := races.SaveRace(race); err != nil {
+				return nil, err
+			}
+
+			return races.GetRace(race.RaceId), nil
+		},
+		update: func(id string, body []byte) (any, error) {
+
+			oldRace, err := getRace(id)
+			if err != nil {
+				return nil, err
+			}
+
+			race, err := decodeApiBody[races.Race](body)
+			if err != nil {
+				return nil, err
+			}
+			race.RaceId = oldRace.RaceId
+
+			if err := race.Validate(); err != nil {
+				return nil, apiBadRequest(err)
+			}
+
+			if err := races.SaveRace(race); err != nil {
+				return nil, err
+			}
+
+			return races.GetRace(race.RaceId), nil
+		},
+		remove: func(id string) error {
+
+			race, err := getRace(id)
+			if err != nil {
+				return err
+			}
+
+			return races.DeleteRace(race.RaceId)
+		},
+	}
+}
+
+//
+// Mutators
+//
+
+func mutatorsApi() apiResource {
+
+	getMutator := func(id string) (*mutators.MutatorSpec, error) {
+		mutSpec := mutators.GetMutatorSpec(id)
+		if mutSpec == nil {
+			return nil, apiNotFound(`mutator`, id)
+		}
+		return mutSpec, nil
+	}
+
+	return apiResource{
+		permission: `mutator`,
+		list: func(r *http.Request) any {
+			result := mutators.GetAllMutatorSpecs()
+			sort.SliceStable(result, func(i, j int) bool {
+				return result[i].MutatorId < result[j].MutatorId
+			})
+			return result
+		},
+		get: func(id string) (any, error) {
+			return getMutator(id)
+		},
+		create: func(body []byte) (any, error) {
+
+			mutSpec, err := decodeApiBody[mutators.MutatorSpec](body)
+			if err != nil {
+				return nil, err
+			}
+
+			if mutSpec.MutatorId == `` {
+				return nil, apiBadRequest(errors.New(`MutatorId is required`))
+			}
+
+			if mutators.GetMutatorSpec(mutSpec.MutatorId) != nil {
+				return nil, apiConflict(`mutator`, mutSpec.MutatorId)
+			}
+
+			if err := mutSpec.Validate(); err != nil {
+				return nil, apiBadRequest(err)
+			}
+
+			if err := mutators.SaveMutatorSpec(mutSpec); err != nil {
+				return nil, err
+			}
+
+			return mutators.GetMutatorSpec(mutSpec.MutatorId), nil
+		},
+		update: func(id string, body []byte) (any, error) {
+
+			oldMutSpec, err := getMutator(id)
+			if err != nil {
+				return nil, err
+			}
+
+			mutSpec, err := decodeApiBody[mutators.MutatorSpec](body)
+			if err != nil {
+				return nil, err
+			}
+			mutSpec.MutatorId = oldMutSpec.MutatorId
+
+			if err := mutSpec.Validate(); err != nil {
+				return nil, apiBadRequest(err)
+			}
+
+			if err := mutators.SaveMutatorSpec(mutSpec); err != nil {
+				return nil, err
+			}
+
+			return mutators.GetMutatorSpec(mutSpec.MutatorId), nil
+		},
+		remove: func(id string) error {
+
+			mutSpec, err := getMutator(id)
+			if err != nil {
+				return err
+			}
+
+			return mutators.DeleteMutatorSpec(mutSpec.MutatorId)
+		},
+	}
+}
+
+//
+// Buffs
+//
+
+func buffsApi() apiResource {
+
+	getBuff := func(id string) (*buffs.BuffSpec, error) {
+		buffId, err := parseApiIntId(`buff`, id)
+		if err != nil || buffId < 0 {
+			return nil, apiNotFound(`buff`, id)
+		}
+		buffSpec := buffs.GetBuffSpec(buffId)
+		if buffSpec == nil {
+			return nil, apiNotFound(`buff`, id)
+		}
+		return buffSpec, nil
+	}
+
+	return apiResource{
+		permission: `buff`,
+		list: func(r *http.Request) any {
+			result := []buffs.BuffSpec{}
+			for _, buffId := range buffs.GetAllBuffIds() {
+				if b := buffs.GetBuffSpec(buffId); b != nil {
+					result = append(result, *b)
+				}
+			}
+			sort.SliceStable(result, func(i, j int) bool {
+				return result[i].BuffId < result[j].BuffId
+			})
+			return result
+		},
+		get: func(id string) (any, error) {
+			return getBuff(id)
+		},
+		create: func(body []byte) (any, error) {
+
+			buffSpec, err := decodeApiBody[buffs.BuffSpec](body)
+			if err != nil {
+				return nil, err
+			}
+
+			// Buff 0 is reserved, so zero means "pick the next id"
+			if buffSpec.BuffId == 0 {
+				for _, buffId := range buffs.GetAllBuffIds() {
+					if buffId > buffSpec.BuffId {
+						buffSpec.BuffId = buffId
+					}
+				}
+				buffSpec.BuffId++
+			} else if buffs.GetBuffSpec(buffSpec.BuffId) != nil {
+				return nil, apiConflict(`buff`, strconv.Itoa(buffSpec.BuffId))
+			}
+
+			if err := buffSpec.Validate(); err != nil {
+				return nil, apiBadRequest(err)
+			}
+
+			if err := buffs.SaveBuffSpec(buffSpec); err != nil {
+				return nil, apiBadRequest(err)
+			}
+
+			return buffs.GetBuffSpec(buffSpec.BuffId), nil
+		},
+		update: func(id string, body []byte) (any, error) {
+
+			oldBuffSpec, err := getBuff(id)
+			if err != nil {
+				return nil, err
+			}
+
+			buffSpec, err := decodeApiBody[buffs.BuffSpec](body)
+			if err != nil {
+				return nil, err
+			}
+			buffSpec.BuffId = oldBuffSpec.BuffId
+
+			if err := buffSpec.Validate(); err != nil {
+				return nil, apiBadRequest(err)
+			}
+
+			if err := buffs.SaveBuffSpec(buffSpec); err != nil {
+				return nil, err
+			}
+
+			return buffs.GetBuffSpec(buffSpec.BuffId), nil
+		},
+		remove: func(id string) error {
+
+			buffSpec, err := getBuff(id)
+			if err != nil {
+				return err
+			}
+
+			if buffSpec.BuffId == 0 {
+				return apiBadRequest(errors.New(`buff 0 is reserved and cannot be deleted`))
+			}
+
+			return buffs.DeleteBuffSpec(buffSpec.BuffId)
+		},
+	}
+}
+
+//
+// Spells
+//
+
+func spellsApi() apiResource {
+
+	getSpell := func(id string) (*spells.SpellData, error) {
+		spellData := spells.GetSpell(id)
+		if spellData == nil {
+			return nil, apiNotFound(`spell`, id)
+		}
+		return spellData, nil
+	}
+
+	return apiResource{
+		permission: `spell`,
+		list: func(r *http.Request) any {
+			result := []spells.SpellData{}
+			for _, spellData := range spells.GetAllSpells() {
+				result = append(result, *spellData)
+			}
+			sort.SliceStable(result, func(i, j int) bool {
+				return result[i].SpellId < result[j].SpellId
+			})
+			return result
+		},
+		get: func(id string) (any, error) {
+			return getSpell(id)
+		},
+		create: func(body []byte) (any, error) {
+
+			spellData, err := decodeApiBody[spells.SpellData](body)
+			if err != nil {
+				return nil, err
+			}
+
+			if spellData.SpellId == `` {
+				return nil, apiBadRequest(errors.New(`SpellId is required`))
+			}
+
+			if spells.GetSpell(spellData.SpellId) != nil {
+				return nil, apiConflict(`spell`, spellData.SpellId)
+			}
+
+			spellId, err := spells.CreateNewSpellFile(spellData)
+			if err != nil {
+				return nil, apiBadRequest(err)
+			}
+
+			return spells.GetSpell(spellId), nil
+		},
+		update: func(id string, body []byte) (any, error) {
+
+			oldSpellData, err := getSpell(id)
+			if err != nil {
+				return nil, err
+			}
+
+			spellData, err := decodeApiBody[spells.SpellData](body)
+			if err != nil {
+				return nil, err
+			}
+			spellData.SpellId = oldSpellData.SpellId
+
+			if err := spellData.Validate(); err != nil {
+				return nil, apiBadRequest(err)
+			}
+
+			if err := spells.SaveSpell(spellData); err != nil {
+				return nil, err
+			}
+
+			return spells.GetSpell(spellData.SpellId), nil
+		},
+		remove: func(id string) error {
+
+			spellData, err := getSpell(id)
+			if err != nil {
+				return err
+			}
+
+			return spells.DeleteSpell(spellData.SpellId)
+		},
+	}
+}
+
+//
+// Quests
+//
+
+func questsApi() apiResource {
+
+	getQuest := func(id string) (*quests.Quest, error) {
+		questId, err := parseApiIntId(`quest`, id)
+		if err != nil {
+			return nil, err
+		}
+		quest := quests.GetQuest(quests.PartsToToken(questId, `all+`))
+		if quest == nil {
+			return nil, apiNotFound(`quest`, id)
+		}
+		return quest, nil
+	}
+
+	return apiResource{
+		permission: `quest`,
+		list: func(r *http.Request) any {
+			result := quests.GetAllQuests()
+			sort.SliceStable(result, func(i, j int) bool {
+				return result[i].QuestId < result[j].QuestId
+			})
+			return result
+		},
+		get: func(id string) (any, error) {
+			return getQuest(id)
+		},
+		create: func(body []byte) (any, error) {
+
+			quest, err := decodeApiBody[quests.Quest](body)
+			if err != nil {
+				return nil, err
+			}
+
+			if quest.QuestId == 0 {
+				for _, q := range quests.GetAllQuests() {
+					if q.QuestId > quest.QuestId {
+						quest.QuestId = q.QuestId
+					}
+				}
+				quest.QuestId++
+			} else if quests.GetQuest(quests.PartsToToken(quest.QuestId, `all+`)) != nil {
+				return nil, apiConflict(`quest`, strconv.Itoa(quest.QuestId))
+			}
+
+			if err := quest.Validate(); err != nil {
+				return nil, apiBadRequest(err)
+			}
+
+			if err := quests.SaveQuest(quest); err != nil {
+				return nil, apiBadRequest(err)
+			}
+
+			return quests.GetQuest(quests.PartsToToken(quest.QuestId, `all+`)), nil
+		},
+		update: func(id string, body []byte) (any, error) {
+
+			oldQuest, err := getQuest(id)
+			if err != nil {
+				return nil, err
+			}
+
+			quest, err := decodeApiBody[quests.Quest](body)
+			if err != nil {
+				return nil, err
+			}
+			quest.QuestId = oldQuest.QuestId
+
+			if err := quest.Validate(); err != nil {
+				return nil, apiBadRequest(err)
+			}
+
+			if err := quests.SaveQuest(quest); err != nil {
+				return nil, err
+			}
+
+			return quests.GetQuest(quests.PartsToToken(quest.QuestId, `all+`)), nil
+		},
+		remove: func(id string) error {
+
+			quest, err := getQuest(id)
+			if err != nil {
+				return err
+			}
+
+			return quests.DeleteQuest(quest.QuestId)
+		},
+	}
+}
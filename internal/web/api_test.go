@@ -0,0 +1,52 @@
+package web
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/GoMudEngine/GoMud/internal/configs"
+	"github.com/GoMudEngine/GoMud/internal/mudlog"
+	"github.com/GoMudEngine/GoMud/internal/users"
+	"github.com/stretchr/testify/assert"
+)
+
+// Sends a request straight to an api handler as an admin
+func doApiRequest(handler http.HandlerFunc, method string, resource string, body string) *httptest.ResponseRecorder {
+
+	r := httptest.NewRequest(method, `/api/v1/`+resource+`/`, strings.NewReader(body))
+	r.SetPathValue(`resource`, resource)
+	r = r.WithContext(context.WithValue(r.Context(), authContextKey{}, webAuth{
+		User: &users.UserRecord{UserId: 1, Role: users.RoleAdmin},
+	}))
+
+	w := httptest.NewRecorder()
+	handler(w, r)
+	return w
+}
+
+func TestApiCreate_SpellIdTraversal(t *testing.T) {
+
+	mudlog.SetupLogger(nil, `ERROR`, ``, false)
+
+	dataFiles := t.TempDir()
+	configs.AddOverlayOverrides(map[string]any{
+		`FilePaths.DataFiles`: filepath.Join(dataFiles, `world`),
+	})
+
+	for _, spellId := range []string{`../users/1`, `..`, `a/b`, `a\b`, `Heal`, ``} {
+
+		w := doApiRequest(apiCreate, http.MethodPost, `spells`, `{"SpellId":"`+strings.ReplaceAll(spellId, `\`, `\\`)+`","Name":"Sneaky","Type":"neutral"}`)
+		assert.Equal(t, http.StatusBadRequest, w.Code, "SpellId %q", spellId)
+	}
+
+	// Nothing should have been written anywhere under the data folder
+	_, err := os.Stat(filepath.Join(dataFiles, `users`))
+	assert.True(t, os.IsNotExist(err))
+	_, err = os.Stat(filepath.Join(dataFiles, `world`))
+	assert.True(t, os.IsNotExist(err))
+}
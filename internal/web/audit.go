@@ -0,0 +1,81 @@
+package web
+
+import (
+	"encoding/json"
+	"net/http"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/GoMudEngine/GoMud/internal/configs"
+	"github.com/GoMudEngine/GoMud/internal/mudlog"
+)
+
+var (
+	auditLock sync.Mutex
+)
+
+// One line of the audit file (JSON lines)
+type auditEntry struct {
+	Time     time.Time `json:"time"`
+	Username string    `json:"username"`
+	Auth     string    `json:"auth,omitempty"`
+	Ip       string    `json:"ip"`
+	Method   string    `json:"method"`
+	Path     string    `json:"path"`
+	Action   string    `json:"action,omitempty"`
+	Status   int       `json:"status"`
+}
+
+// Captures the status code written by a handler
+type statusRecorder struct {
+	http.ResponseWriter
+	status int
+}
+
+func (s *statusRecorder) WriteHeader(status int) {
+	s.status = status
+	s.ResponseWriter.WriteHeader(status)
+}
+
+func writeAuditLog(r *http.Request, username string, authMethod string, action string, status int) {
+
+	auditFile := configs.GetFilePathsConfig().AuditLogFile.String()
+	if auditFile == `` {
+		return
+	}
+
+	entry := auditEntry{
+		Time:     time.Now(),
+		Username: username,
+		Auth:     authMethod,
+		Ip:       r.RemoteAddr,
+		Method:   r.Method,
+		Path:     r.URL.RequestURI(),
+		Action:   action,
+		Status:   status,
+	}
+
+	line, err := json.Marshal(entry)
+	if err != nil {
+		mudlog.Error("AUDIT", "error", err)
+		return
+	}
+
+	auditLock.Lock()
+	defer auditLock.Unlock()
+
+	os.MkdirAll(filepath.Dir(auditFile), os.ModePerm)
+
+	f, err := os.OpenFile(auditFile, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0600)
+	if err != nil {
+		mudlog.Error("AUDIT", "file", auditFile, "error", err)
+		return
+	}
+	defer f.Close()
+
+	if _, err := f.Write(append(line, '\n')); err != nil {
+		mudlog.Error("AUDIT", "file", auditFile, "error", err)
+	}
+}
@@ -1,78 +1,459 @@
 package web
 
 import (
+	"context"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"errors"
+	"fmt"
 	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"text/template"
 	"time"
 
+	"github.com/GoMudEngine/GoMud/internal/bans"
+	"github.com/GoMudEngine/GoMud/internal/configs"
 	"github.com/GoMudEngine/GoMud/internal/mudlog"
 	"github.com/GoMudEngine/GoMud/internal/users"
 )
 
+//
+// Web admin authentication
+//
+// Browsers log in through /admin/login and receive a signed session cookie.
+// Scripts and tools send an api token minted in-game (see the "apitoken" command):
+//   Authorization: Bearer gomud_<userId>_<tokenId>_<secret>
+//
+// Sessions have every permission of the user's role.
+// Tokens are limited to their scopes AND the user's role.
+//
+
+const (
+	sessionCookieName = `gomud_admin`
+	sessionDuration   = 12 * time.Hour
+	userCacheDuration = time.Minute
+)
+
 var (
-	authCache = map[string]time.Time{}
+	// Signs session cookies. Regenerated every start, which ends all sessions.
+	sessionKey = newSessionKey()
+
+	// Guards revokedSessions and userCache, since login runs without the MUD lock
+	authLock = sync.Mutex{}
+
+	// Logged out sessions that haven't expired yet. nonce => expiration
+	revokedSessions = map[string]time.Time{}
+
+	// Recently loaded offline users, so every request doesn't hit the disk
+	userCache = map[int]cachedUser{}
+
+	errNotAuthenticated = errors.New(`not authenticated`)
 )
 
-func handlerToHandlerFunc(h http.Handler) http.HandlerFunc {
-	return func(w http.ResponseWriter, r *http.Request) {
-		h.ServeHTTP(w, r)
+type cachedUser struct {
+	user    *users.UserRecord
+	expires time.Time
+}
+
+type authContextKey struct{}
+
+type webAuth struct {
+	User  *users.UserRecord
+	Token *users.ApiToken // nil for browser sessions
+}
+
+// Description used in logs
+func (a webAuth) Method() string {
+	if a.Token != nil {
+		return `token:` + a.Token.TokenId
 	}
+	return `session`
+}
+
+func (a webAuth) HasPermission(permissionId string) bool {
+
+	if a.Token != nil && !a.Token.Permits(permissionId) {
+		return false
+	}
+
+	return a.User.HasRolePermission(permissionId)
+}
+
+func getWebAuth(r *http.Request) (webAuth, bool) {
+	a, ok := r.Context().Value(authContextKey{}).(webAuth)
+	return a, ok
 }
 
-func doBasicAuth(next http.HandlerFunc) http.HandlerFunc {
+func newSessionKey() []byte {
+	key := make([]byte, 32)
+	if _, err := rand.Read(key); err != nil {
+		panic(err)
+	}
+	return key
+}
+
+// Requires a logged in (non "user" role) user with permissionId.
+// An empty permissionId only requires being logged in.
+func doAuth(permissionId string, next http.HandlerFunc) http.HandlerFunc {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 
-		authHeader := r.Header.Get("Authorization")
+		auth, err := authenticate(r)
+
+		// Every api request ends up in the audit log
+		if isApiRequest(r) {
+			rec := &statusRecorder{ResponseWriter: w, status: http.StatusOK}
+			w = rec
+			defer func() {
+				if auth.User != nil {
+					writeAuditLog(r, auth.User.Username, auth.Method(), ``, rec.status)
+				} else {
+					writeAuditLog(r, ``, ``, ``, rec.status)
+				}
+			}()
+		}
+
+		if err != nil {
+
+			if err != errNotAuthenticated {
+				mudlog.Error("ADMIN AUTH", "ip", r.RemoteAddr, "path", r.URL.Path, "error", err)
+			}
+
+			if isApiRequest(r) {
+				writeApiError(w, r, apiError{http.StatusUnauthorized, `unauthorized`})
+				return
+			}
+
+			http.Redirect(w, r, `/admin/login?next=`+url.QueryEscape(r.URL.RequestURI()), http.StatusSeeOther)
+			return
+		}
 
-		if t, ok := authCache[authHeader]; ok {
+		if permissionId != `` && !auth.HasPermission(permissionId) {
 
-			if t.After(time.Now()) {
-				next.ServeHTTP(w, r)
+			mudlog.Warn("ADMIN AUTH", "username", auth.User.Username, "auth", auth.Method(), "path", r.URL.Path, "error", `missing permission `+permissionId)
+
+			if isApiRequest(r) {
+				writeApiError(w, r, apiError{http.StatusForbidden, `missing permission ` + permissionId})
 				return
 			}
 
-			delete(authCache, authHeader)
+			http.Error(w, "Forbidden", http.StatusForbidden)
+			return
+		}
+
+		next.ServeHTTP(w, r.WithContext(context.WithValue(r.Context(), authContextKey{}, auth)))
+	})
+}
+
+func isApiRequest(r *http.Request) bool {
+	return strings.HasPrefix(r.URL.Path, `/api/`)
+}
+
+func authenticate(r *http.Request) (webAuth, error) {
+
+	if authHeader := r.Header.Get("Authorization"); authHeader != `` {
+
+		token, ok := strings.CutPrefix(authHeader, `Bearer `)
+		if !ok {
+			return webAuth{}, errors.New(`unsupported authorization type`)
+		}
+
+		userId, tokenId, secret, err := users.ParseApiToken(strings.TrimSpace(token))
+		if err != nil {
+			return webAuth{}, err
+		}
+
+		u, err := getAuthUser(userId)
+		if err != nil {
+			return webAuth{}, err
+		}
+
+		apiToken := u.GetApiToken(tokenId, secret)
+		if apiToken == nil {
+			return webAuth{}, users.ErrInvalidApiToken
+		}
+
+		return checkAuthUser(r, webAuth{User: u, Token: apiToken})
+	}
+
+	cookie, err := r.Cookie(sessionCookieName)
+	if err != nil {
+		return webAuth{}, errNotAuthenticated
+	}
+
+	userId, _, err := parseSessionValue(cookie.Value)
+	if err != nil {
+		return webAuth{}, errNotAuthenticated
+	}
+
+	u, err := getAuthUser(userId)
+	if err != nil {
+		return webAuth{}, err
+	}
+
+	return checkAuthUser(r, webAuth{User: u})
+}
+
+// Checks things that may have changed since the session or token was issued
+func checkAuthUser(r *http.Request, auth webAuth) (webAuth, error) {
+
+	if ban := bans.GetIPBan(r.RemoteAddr); ban != nil {
+		return webAuth{}, fmt.Errorf(`ip %s is banned (ban %d)`, r.RemoteAddr, ban.BanId)
+	}
+
+	if ban := bans.GetUsernameBan(auth.User.Username); ban != nil {
+		return webAuth{}, fmt.Errorf(`user %s is banned (ban %d)`, auth.User.Username, ban.BanId)
+	}
+
+	if auth.User.Role == users.RoleUser {
+		return webAuth{}, fmt.Errorf(`user %s has no admin role`, auth.User.Username)
+	}
+
+	if auth.User.RequiresSecureConnection() && r.TLS == nil {
+		return webAuth{}, fmt.Errorf(`user %s requires a secure connection`, auth.User.Username)
+	}
+
+	return auth, nil
+}
+
+// Online users always reflect the latest role and tokens.
+// Offline users are cached briefly.
+func getAuthUser(userId int) (*users.UserRecord, error) {
+
+	if u := users.GetByUserId(userId); u != nil {
+		return u, nil
+	}
+
+	now := time.Now()
+
+	authLock.Lock()
+	for id, c := range userCache {
+		if c.expires.Before(now) {
+			delete(userCache, id)
+		}
+	}
+	c, ok := userCache[userId]
+	authLock.Unlock()
+
+	if ok {
+		return c.user, nil
+	}
+
+	u, err := users.LoadUserById(userId, true)
+	if err != nil {
+		return nil, err
+	}
+
+	authLock.Lock()
+	userCache[userId] = cachedUser{user: u, expires: now.Add(userCacheDuration)}
+	authLock.Unlock()
+
+	return u, nil
+}
+
+//
+// Session cookies
+// Value: base64(userId|expires|nonce).base64(hmac)
+//
+
+func newSessionValue(userId int) (string, time.Time, error) {
+
+	nonce := make([]byte, 16)
+	if _, err := rand.Read(nonce); err != nil {
+		return ``, time.Time{}, err
+	}
+
+	expires := time.Now().Add(sessionDuration)
+
+	payload := fmt.Sprintf(`%d|%d|%s`, userId, expires.Unix(), base64.RawURLEncoding.EncodeToString(nonce))
+
+	return base64.RawURLEncoding.EncodeToString([]byte(payload)) + `.` + signSessionPayload(payload), expires, nil
+}
+
+func parseSessionValue(value string) (userId int, nonce string, err error) {
+
+	encodedPayload, signature, ok := strings.Cut(value, `.`)
+	if !ok {
+		return 0, ``, errNotAuthenticated
+	}
+
+	payloadBytes, err := base64.RawURLEncoding.DecodeString(encodedPayload)
+	if err != nil {
+		return 0, ``, errNotAuthenticated
+	}
+	payload := string(payloadBytes)
+
+	if !hmac.Equal([]byte(signature), []byte(signSessionPayload(payload))) {
+		return 0, ``, errNotAuthenticated
+	}
+
+	parts := strings.Split(payload, `|`)
+	if len(parts) != 3 {
+		return 0, ``, errNotAuthenticated
+	}
+
+	if userId, err = strconv.Atoi(parts[0]); err != nil {
+		return 0, ``, errNotAuthenticated
+	}
+
+	expires, err := strconv.ParseInt(parts[1], 10, 64)
+	if err != nil || time.Now().Unix() > expires {
+		return 0, ``, errNotAuthenticated
+	}
+
+	nonce = parts[2]
+
+	authLock.Lock()
+	_, revoked := revokedSessions[nonce]
+	authLock.Unlock()
+
+	if revoked {
+		return 0, ``, errNotAuthenticated
+	}
+
+	return userId, nonce, nil
+}
+
+func signSessionPayload(payload string) string {
+	mac := hmac.New(sha256.New, sessionKey)
+	mac.Write([]byte(payload))
+	return base64.RawURLEncoding.EncodeToString(mac.Sum(nil))
+}
+
+//
+// Login/Logout pages
+//
+
+// Not run with the MUD lock, since loading the user and checking the password hash are slow.
+// Only the user file on disk is used, never the online user.
+func adminLogin(w http.ResponseWriter, r *http.Request) {
+
+	nextPath := r.FormValue(`next`)
+	// Only allow redirects within the admin area
+	if !strings.HasPrefix(nextPath, `/admin/`) || strings.HasPrefix(nextPath, `//`) {
+		nextPath = `/admin/`
+	}
+
+	tplData := map[string]any{
+		`Next`:  nextPath,
+		`Error`: ``,
+	}
+
+	if r.Method == http.MethodPost {
+
+		username := r.PostFormValue(`username`)
+		password := r.PostFormValue(`password`)
+
+		if lockedFor := bans.LoginLockedFor(r.RemoteAddr, username); lockedFor > 0 {
+
+			mudlog.Warn("ADMIN LOGIN", "username", username, "success", false, "error", `too many failed logins`)
+			writeAuditLog(r, username, ``, `login locked out`, http.StatusTooManyRequests)
+
+			tplData[`Error`] = fmt.Sprintf(`Too many failed logins. Try again in %s.`, lockedFor.Round(time.Second))
+			w.Header().Set(`Retry-After`, strconv.Itoa(int(lockedFor.Seconds())+1))
+			w.WriteHeader(http.StatusTooManyRequests)
+			renderLoginPage(w, tplData)
+			return
 		}
 
-		// Extract the username and password from the request
-		// Authorization header. If no Authentication header is present
-		// or the header value is invalid, then the 'ok' return value
-		// will be false.
-		username, password, ok := r.BasicAuth()
-		if ok {
+		u, err := users.LoadUser(username, true)
+		if err == nil && u.PasswordMatches(password) {
+
+			bans.RecordLoginSuccess(r.RemoteAddr, username)
+
+			if _, err = checkAuthUser(r, webAuth{User: u}); err == nil {
+
+				value, expires, err := newSessionValue(u.UserId)
+				if err == nil {
+
+					http.SetCookie(w, &http.Cookie{
+						Name:     sessionCookieName,
+						Value:    value,
+						Path:     `/`,
+						Expires:  expires,
+						HttpOnly: true,
+						Secure:   r.TLS != nil,
+						SameSite: http.SameSiteStrictMode,
+					})
+
+					mudlog.Warn("ADMIN LOGIN", "username", username, "success", true)
+					writeAuditLog(r, u.Username, `session`, `login`, http.StatusSeeOther)
+
+					http.Redirect(w, r, nextPath, http.StatusSeeOther)
+					return
+				}
+			}
+
+		} else {
 
-			// Authorize against actual user record
-			uRecord, err := users.LoadUser(username, true)
 			if err == nil {
+				err = errors.New(`bad password`)
+			}
+
+			bans.RecordLoginFailure(r.RemoteAddr, username)
+		}
+
+		mudlog.Error("ADMIN LOGIN", "username", username, "success", false, "error", err)
+		writeAuditLog(r, username, ``, `login failed`, http.StatusUnauthorized)
+
+		tplData[`Error`] = `Invalid login.`
+		w.WriteHeader(http.StatusUnauthorized)
+	}
 
-				if uRecord.PasswordMatches(password) {
+	renderLoginPage(w, tplData)
+}
 
-					if uRecord.Role != users.RoleUser {
+func renderLoginPage(w http.ResponseWriter, tplData map[string]any) {
 
-						mudlog.Warn("ADMIN LOGIN", "username", username, "success", true)
+	tmpl, err := template.New("login.html").Funcs(funcMap).ParseFiles(configs.GetFilePathsConfig().AdminHtml.String() + "/login.html")
+	if err != nil {
+		mudlog.Error("HTML Template", "error", err)
+		return
+	}
 
-						// Cache auth for 30 minutes to avoid re-auth every load
-						authCache[authHeader] = time.Now().Add(time.Minute * 30)
+	if err := tmpl.Execute(w, tplData); err != nil {
+		mudlog.Error("HTML Execute", "error", err)
+	}
+}
 
-						next.ServeHTTP(w, r)
-						return
+func adminLogout(w http.ResponseWriter, r *http.Request) {
 
-					} else {
+	if cookie, err := r.Cookie(sessionCookieName); err == nil {
 
-						mudlog.Error("ADMIN LOGIN", "username", username, "success", false, "error", `Role=`+uRecord.Role)
+		if userId, nonce, err := parseSessionValue(cookie.Value); err == nil {
 
-					}
+			now := time.Now()
+
+			authLock.Lock()
+			for n, expires := range revokedSessions {
+				if expires.Before(now) {
+					delete(revokedSessions, n)
 				}
+			}
+			revokedSessions[nonce] = now.Add(sessionDuration)
+			authLock.Unlock()
 
-			} else {
-				mudlog.Error("ADMIN LOGIN", "username", username, "success", false, "error", err)
+			username := strconv.Itoa(userId)
+			if u, err := getAuthUser(userId); err == nil {
+				username = u.Username
 			}
+			writeAuditLog(r, username, `session`, `logout`, http.StatusSeeOther)
 		}
+	}
 
-		// If the Authentication header is not present, is invalid, or the
-		// username or password is wrong, then set a WWW-Authenticate
-		// header to inform the client that we expect them to use basic
-		// authentication and send a 401 Unauthorized response.
-		w.Header().Set("WWW-Authenticate", `Basic realm="restricted", charset="UTF-8"`)
-		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+	http.SetCookie(w, &http.Cookie{
+		Name:     sessionCookieName,
+		Value:    ``,
+		Path:     `/`,
+		MaxAge:   -1,
+		HttpOnly: true,
+		Secure:   r.TLS != nil,
+		SameSite: http.SameSiteStrictMode,
 	})
+
+	http.Redirect(w, r, `/admin/login`, http.StatusSeeOther)
 }
@@ -1,14 +1,20 @@
 package web
 
 import (
+	"net/http"
 	"sync"
 
+	"github.com/GoMudEngine/GoMud/internal/clans"
+	"github.com/GoMudEngine/GoMud/internal/metrics"
+	"github.com/GoMudEngine/GoMud/internal/mudlog"
 	"github.com/GoMudEngine/GoMud/internal/users"
 )
 
 type Stats struct {
 	OnlineUsers   []users.OnlineInfo
+	Clans         []clans.ClanSummary
 	TelnetPorts   []int
+	TelnetTLSPort int
 	WebSocketPort int
 }
 
@@ -17,6 +23,7 @@ var (
 	serverStats = Stats{
 		WebSocketPort: 0,
 		OnlineUsers:   []users.OnlineInfo{},
+		Clans:         []clans.ClanSummary{},
 		TelnetPorts:   []int{},
 	}
 )
@@ -40,5 +47,14 @@ func UpdateStats(s Stats) {
 func (s *Stats) Reset() {
 	s.WebSocketPort = 0
 	s.OnlineUsers = []users.OnlineInfo{}
+	s.Clans = []clans.ClanSummary{}
 	s.TelnetPorts = []int{}
+	s.TelnetTLSPort = 0
+}
+
+func serveMetrics(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "text/plain; version=0.0.4; charset=utf-8")
+	if err := metrics.Write(w); err != nil {
+		mudlog.Error("Metrics", "error", err)
+	}
 }
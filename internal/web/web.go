@@ -138,6 +138,7 @@ func serveTemplate(w http.ResponseWriter, r *http.Request) {
 		"NAV": []WebNav{
 			{`Home`, `/`},
 			{`Who's Online`, `/online`},
+			{`Clans`, `/clans`},
 			{`Web Client`, `/webclient`},
 			{`See Configuration`, `/viewconfig`},
 		},
@@ -259,59 +260,72 @@ func Listen(wg *sync.WaitGroup, webSocketHandler func(*websocket.Conn)) {
 		webSocketHandler(conn)
 	})
 
-	http.Handle("GET /admin/static/", RunWithMUDLocked(
-		doBasicAuth(
-			handlerToHandlerFunc(
-				http.StripPrefix("/admin/static/", http.FileServer(http.Dir(configs.GetFilePathsConfig().AdminHtml.String()+"/static"))),
-			),
-		),
-	))
+	// Admin static files are needed by the login page, so they don't require auth
+	http.Handle("GET /admin/static/", http.StripPrefix("/admin/static/", http.FileServer(http.Dir(configs.GetFilePathsConfig().AdminHtml.String()+"/static"))))
+
+	// Admin login
+	http.HandleFunc("GET /admin/login", adminLogin)
+	http.HandleFunc("POST /admin/login", adminLogin)
+	http.HandleFunc("GET /admin/logout", RunWithMUDLocked(adminLogout))
 
 	// Admin tools
 	http.HandleFunc("GET /admin/", RunWithMUDLocked(
-		doBasicAuth(adminIndex),
+		doAuth(``, adminIndex),
 	))
 
 	// Item Admin
 	http.HandleFunc("GET /admin/items/", RunWithMUDLocked(
-		doBasicAuth(itemsIndex),
+		doAuth(`item.info`, itemsIndex),
 	))
 	http.HandleFunc("GET /admin/items/itemdata/", RunWithMUDLocked(
-		doBasicAuth(itemData),
+		doAuth(`item.info`, itemData),
 	))
 
 	// Race Admin
 	http.HandleFunc("GET /admin/races/", RunWithMUDLocked(
-		doBasicAuth(racesIndex)),
+		doAuth(`race.info`, racesIndex)),
 	)
 	http.HandleFunc("GET /admin/races/racedata/", RunWithMUDLocked(
-		doBasicAuth(raceData)),
+		doAuth(`race.info`, raceData)),
 	)
 
 	// Mob Admin
 	http.HandleFunc("GET /admin/mobs/", RunWithMUDLocked(
-		doBasicAuth(mobsIndex),
+		doAuth(`mob.info`, mobsIndex),
 	))
 	http.HandleFunc("GET /admin/mobs/mobdata/", RunWithMUDLocked(
-		doBasicAuth(mobData),
+		doAuth(`mob.info`, mobData),
 	))
 
 	// Mutator Admin
 	http.HandleFunc("GET /admin/mutators/", RunWithMUDLocked(
-		doBasicAuth(mutatorsIndex),
+		doAuth(`mutator.info`, mutatorsIndex),
 	))
 	http.HandleFunc("GET /admin/mutators/mutatordata/", RunWithMUDLocked(
-		doBasicAuth(mutatorData),
+		doAuth(`mutator.info`, mutatorData),
 	))
 
 	// Room Admin
 	http.HandleFunc("GET /admin/rooms/", RunWithMUDLocked(
-		doBasicAuth(roomsIndex),
+		doAuth(`room.info`, roomsIndex),
 	))
 	http.HandleFunc("GET /admin/rooms/roomdata/", RunWithMUDLocked(
-		doBasicAuth(roomData),
+		doAuth(`room.info`, roomData),
+	))
+
+	// Ban Admin
+	http.HandleFunc("GET /admin/bans/", RunWithMUDLocked(
+		doAuth(`ban`, bansIndex),
 	))
 
+	// JSON API
+	registerApiHandlers()
+
+	// Prometheus metrics
+	if networkConfig.MetricsEnabled {
+		http.HandleFunc("GET /metrics", RunWithMUDLocked(serveMetrics))
+	}
+
 	//
 	// Https server start up
 	//
@@ -2,6 +2,7 @@
 package main
 
 import (
+	"crypto/tls"
 	"fmt"
 	"net"
 	"os"
@@ -18,8 +19,11 @@ import (
 	"time"
 
 	"github.com/GoMudEngine/GoMud/internal/audio"
+	"github.com/GoMudEngine/GoMud/internal/bans"
 	"github.com/GoMudEngine/GoMud/internal/buffs"
+	"github.com/GoMudEngine/GoMud/internal/channels"
 	"github.com/GoMudEngine/GoMud/internal/characters"
+	"github.com/GoMudEngine/GoMud/internal/clans"
 	"github.com/GoMudEngine/GoMud/internal/colorpatterns"
 	"github.com/GoMudEngine/GoMud/internal/configs"
 	"github.com/GoMudEngine/GoMud/internal/connections"
@@ -45,6 +49,7 @@ import (
 	"github.com/GoMudEngine/GoMud/internal/plugins"
 	"github.com/GoMudEngine/GoMud/internal/quests"
 	"github.com/GoMudEngine/GoMud/internal/races"
+	"github.com/GoMudEngine/GoMud/internal/recipes"
 	"github.com/GoMudEngine/GoMud/internal/rooms"
 	"github.com/GoMudEngine/GoMud/internal/scripting"
 	"github.com/GoMudEngine/GoMud/internal/spells"
@@ -63,7 +68,7 @@ import (
 // When updating this version:
 // 1. Expect to update the github release version
 // 2. Consider whether any migration code is needed for breaking changes, particularly in datafiles (see internal/migration)
-const VERSION = "0.9.1"
+const VERSION = "0.9.3"
 
 var (
 	sigChan            = make(chan os.Signal, 1)
@@ -102,6 +107,8 @@ func main() {
 
 	flags.HandleFlags(VERSION)
 
+	inputhandlers.SetMSSPServerInfo(VERSION, serverStartTime)
+
 	configs.ReloadConfig()
 	c := configs.GetConfig()
 
@@ -172,6 +179,12 @@ func main() {
 	// System Configurations
 	runtime.GOMAXPROCS(int(c.Server.MaxCPUCores))
 
+	// Seed dice rolls. Logged so that a run can be repeated with RandomSeed.
+	if c.Server.RandomSeed != 0 {
+		util.SeedRand(int64(c.Server.RandomSeed))
+	}
+	mudlog.Info("RNG", "seed", util.GetRandSeed(), "debug", bool(c.Server.RandomDebug))
+
 	// Validate chosen world:
 	if err := util.ValidateWorldFiles(`_datafiles/world/default`, c.FilePaths.DataFiles.String()); err != nil {
 		mudlog.Error("World Validation", "error", err)
@@ -223,6 +236,10 @@ func main() {
 	idx.Rebuild()
 	mudlog.Info("UserIndex", "info", "User index recreated.")
 
+	bans.LoadBans()
+
+	clans.LoadClans()
+
 	// Load the round count from the file
 	if util.LoadRoundCount(c.FilePaths.DataFiles.String()+`/`+util.RoundCountFilename) == util.RoundCountMinimum {
 		gametime.SetToDay(-3)
@@ -241,6 +258,8 @@ func main() {
 
 	web.SetWebPlugin(plugins.GetPluginRegistry())
 
+	registerMetrics()
+
 	//
 	// Capture OS signals to gracefully shutdown the server
 	signal.Notify(sigChan, syscall.SIGINT, syscall.SIGTERM)
@@ -252,6 +271,12 @@ func main() {
 	// Spin up server listeners
 	//
 
+	// Pick up where the last process left off if this is a copyover
+	copyover := loadCopyoverState()
+	if copyover != nil {
+		copyover.restoreConnections()
+	}
+
 	// Set the server to be alive
 	serverAlive.Store(true)
 
@@ -267,13 +292,25 @@ func main() {
 		}
 	}
 
+	if c.Network.TelnetTLSPort > 0 {
+		if s := TelnetTLSListenOnPort(``, int(c.Network.TelnetTLSPort), &wg, int(c.Network.MaxTelnetConnections)); s != nil {
+			allServerListeners = append(allServerListeners, s)
+		}
+	}
+
 	if c.Network.LocalPort > 0 {
 		TelnetListenOnPort(`127.0.0.1`, int(c.Network.LocalPort), &wg, 0)
 	}
 
+	closeInheritedListeners()
+
 	go worldManager.InputWorker(workerShutdownChan, &wg)
 	go worldManager.MainWorker(workerShutdownChan, &wg)
 
+	if copyover != nil {
+		copyover.resume(&wg)
+	}
+
 	mudlog.Info("Server Ready", "Time Taken", time.Since(serverStartTime))
 
 	// block until a signal comes in
@@ -353,6 +390,8 @@ func handleTelnetConnection(connDetails *connections.ConnectionDetails, wg *sync
 	// Consider a macro handler at this point?
 	// Text Processing
 	connDetails.AddInputHandler("CleanserInputHandler", inputhandlers.CleanserInputHandler)
+	// Plain text MSSP requests from MUD listing crawlers
+	connDetails.AddInputHandler("MSSPRequestHandler", inputhandlers.MSSPRequestHandler)
 
 	loginHandler := inputhandlers.GetLoginPromptHandler()           // Get the configured handler func
 	connDetails.AddInputHandler("LoginPromptHandler", loginHandler) // Add it with a unique name
@@ -398,6 +437,31 @@ func handleTelnetConnection(connDetails *connections.ConnectionDetails, wg *sync
 		connDetails.ConnectionId(),
 	)
 
+	// Let MUD listing crawlers know we can report server status
+	connections.SendTo(
+		term.MsspEnable.BytesWithPayload(nil),
+		connDetails.ConnectionId(),
+	)
+
+	// Offer compression (MCCP2: server to client, MCCP3: client to server)
+	if netCfg := configs.GetNetworkConfig(); netCfg.MCCP2Enabled || netCfg.MCCP3Enabled {
+
+		if netCfg.MCCP2Enabled {
+			connections.SendTo(
+				term.Mccp2Enable.BytesWithPayload(nil),
+				connDetails.ConnectionId(),
+			)
+		}
+
+		if netCfg.MCCP3Enabled {
+			connDetails.AllowInputCompression()
+			connections.SendTo(
+				term.Mccp3Enable.BytesWithPayload(nil),
+				connDetails.ConnectionId(),
+			)
+		}
+	}
+
 	clientSetupCommands := "" + //term.AnsiAltModeStart.String() + // alternative mode (No scrollback)
 		//term.AnsiCursorHide.String() + // Hide Cursor (Because we will manually echo back)
 		//term.AnsiCharSetUTF8.String() + // UTF8 mode
@@ -412,9 +476,6 @@ func handleTelnetConnection(connDetails *connections.ConnectionDetails, wg *sync
 
 	plugins.OnNetConnect(connDetails)
 
-	// an input buffer for reading data sent over the network
-	inputBuffer := make([]byte, connections.ReadBufferSize)
-
 	// Describes whatever the client sent us
 	clientInput := &connections.ClientInput{
 		ConnectionId: connDetails.ConnectionId(),
@@ -454,7 +515,35 @@ func handleTelnetConnection(connDetails *connections.ConnectionDetails, wg *sync
 	// 3. Returns false (which we ignore here, as we aren't in the main loop yet).
 	loginHandler(initialTriggerInput, sharedState)
 
-	var userObject *users.UserRecord
+	telnetInputLoop(connDetails, clientInput, sharedState, nil)
+}
+
+// Swaps the login handlers for the ones used while playing
+func addLoggedInHandlers(connDetails *connections.ConnectionDetails, userObject *users.UserRecord) {
+
+	// Regular echo handler, now that there are no more masked prompts
+	connDetails.AddInputHandler("EchoInputHandler", inputhandlers.EchoInputHandler)
+	// Add admin command handler
+	connDetails.AddInputHandler("HistoryInputHandler", inputhandlers.HistoryInputHandler) // Put history tracking after login handling, since login handling aborts input until complete
+
+	if userObject.Role == users.RoleAdmin {
+		connDetails.AddInputHandler("SystemCommandInputHandler", inputhandlers.SystemCommandInputHandler)
+	}
+
+	// Add a signal handler (shortcut ctrl combos) after the AnsiHandler
+	// This captures signals and replaces user input so should happen after AnsiHandler to ensure it happens before other processes.
+	connDetails.AddInputHandler("SignalHandler", inputhandlers.SignalHandler, "AnsiHandler")
+
+	connDetails.SetState(connections.LoggedIn)
+}
+
+// Reads from the connection until it drops.
+// userObject is nil until the login handler completes, unless they were already logged in (copyover)
+func telnetInputLoop(connDetails *connections.ConnectionDetails, clientInput *connections.ClientInput, sharedState map[string]any, userObject *users.UserRecord) {
+
+	// an input buffer for reading data sent over the network
+	inputBuffer := make([]byte, connections.ReadBufferSize)
+
 	var sug suggestions.Suggestions
 	lastInput := time.Now()
 	c := configs.GetConfig()
@@ -605,20 +694,9 @@ func handleTelnetConnection(connDetails *connections.ConnectionDetails, wg *sync
 
 			// Remove the prompt handler (it signaled completion by returning true)
 			connDetails.RemoveInputHandler("LoginPromptHandler")
-			// Replace it with a regular echo handler.
-			connDetails.AddInputHandler("EchoInputHandler", inputhandlers.EchoInputHandler)
-			// Add admin command handler
-			connDetails.AddInputHandler("HistoryInputHandler", inputhandlers.HistoryInputHandler) // Put history tracking after login handling, since login handling aborts input until complete
-
-			if userObject.Role == users.RoleAdmin {
-				connDetails.AddInputHandler("SystemCommandInputHandler", inputhandlers.SystemCommandInputHandler)
-			}
+			connDetails.RemoveInputHandler("MSSPRequestHandler")
 
-			// Add a signal handler (shortcut ctrl combos) after the AnsiHandler
-			// This captures signals and replaces user input so should happen after AnsiHandler to ensure it happens before other processes.
-			connDetails.AddInputHandler("SignalHandler", inputhandlers.SignalHandler, "AnsiHandler")
-
-			connDetails.SetState(connections.LoggedIn)
+			addLoggedInHandlers(connDetails, userObject)
 
 			worldManager.SendEnterWorld(userObject.UserId, userObject.Character.RoomId)
 
@@ -706,6 +784,12 @@ func handleTelnetConnection(connDetails *connections.ConnectionDetails, wg *sync
 
 func HandleWebSocketConnection(conn *websocket.Conn) {
 
+	if ban := bans.GetIPBan(conn.RemoteAddr().String()); ban != nil {
+		mudlog.Warn("Connection refused", "remoteAddr", conn.RemoteAddr().String(), "banId", ban.BanId, "reason", ban.Reason)
+		conn.WriteMessage(websocket.TextMessage, []byte(ban.Message()))
+		return
+	}
+
 	var userObject *users.UserRecord
 	connDetails := connections.Add(nil, conn)
 
@@ -884,12 +968,55 @@ func HandleWebSocketConnection(conn *websocket.Conn) {
 
 func TelnetListenOnPort(hostname string, portNum int, wg *sync.WaitGroup, maxConnections int) net.Listener {
 
-	server, err := net.Listen("tcp", fmt.Sprintf("%s:%d", hostname, portNum))
+	server, err := listenTCP(fmt.Sprintf("%s:%d", hostname, portNum))
+	if err != nil {
+		mudlog.Error("Error creating server", "error", err)
+		return nil
+	}
+
+	acceptTelnetConnections(server, wg, maxConnections)
+
+	return server
+}
+
+// Same as TelnetListenOnPort, but connections are wrapped in TLS using the https cert/key files
+func TelnetTLSListenOnPort(hostname string, portNum int, wg *sync.WaitGroup, maxConnections int) net.Listener {
+
+	filePaths := configs.GetFilePathsConfig()
+
+	if len(filePaths.HttpsCertFile) == 0 || len(filePaths.HttpsKeyFile) == 0 {
+		mudlog.Error("Telnet TLS", "stage", "skipping", "error", "Undefined public/private key files", "Public Cert", filePaths.HttpsCertFile, "Private Key", filePaths.HttpsKeyFile)
+		return nil
+	}
+
+	cert, err := tls.LoadX509KeyPair(string(filePaths.HttpsCertFile), string(filePaths.HttpsKeyFile))
+	if err != nil {
+		mudlog.Error("Telnet TLS", "error", fmt.Errorf("Error loading certificate and key: %w", err))
+		return nil
+	}
+
+	tlsConfig := &tls.Config{
+		Certificates: []tls.Certificate{cert},
+		MinVersion:   tls.VersionTLS12,
+	}
+
+	tcpServer, err := listenTCP(fmt.Sprintf("%s:%d", hostname, portNum))
 	if err != nil {
 		mudlog.Error("Error creating server", "error", err)
 		return nil
 	}
 
+	server := tls.NewListener(tcpServer, tlsConfig)
+
+	mudlog.Info("Telnet TLS", "stage", "Listening", "port", portNum)
+
+	acceptTelnetConnections(server, wg, maxConnections)
+
+	return server
+}
+
+func acceptTelnetConnections(server net.Listener, wg *sync.WaitGroup, maxConnections int) {
+
 	// Start a goroutine to accept incoming connections, so that we can use a signal to stop the server
 	go func() {
 
@@ -907,10 +1034,15 @@ func TelnetListenOnPort(hostname string, portNum int, wg *sync.WaitGroup, maxCon
 				continue
 			}
 
+			if ban := bans.GetIPBan(conn.RemoteAddr().String()); ban != nil {
+				mudlog.Warn("Connection refused", "remoteAddr", conn.RemoteAddr().String(), "banId", ban.BanId, "reason", ban.Reason)
+				go rejectConnection(conn, "\n\n"+ban.Message()+"\n\n")
+				continue
+			}
+
 			if maxConnections > 0 {
 				if connections.ActiveConnectionCount() >= maxConnections {
-					conn.Write([]byte(fmt.Sprintf("\n\n\n!!! Server is full (%d connections). Try again later. !!!\n\n\n", connections.ActiveConnectionCount())))
-					conn.Close()
+					go rejectConnection(conn, fmt.Sprintf("\n\n\n!!! Server is full (%d connections). Try again later. !!!\n\n\n", connections.ActiveConnectionCount()))
 					continue
 				}
 			}
@@ -925,7 +1057,15 @@ func TelnetListenOnPort(hostname string, portNum int, wg *sync.WaitGroup, maxCon
 		}
 	}()
 
-	return server
+}
+
+// Tells a refused connection why, then closes it.
+// This runs on its own goroutine, since on a TLS listener the first write is the handshake,
+// and a client that never finishes it would otherwise stall the accept loop.
+func rejectConnection(conn net.Conn, message string) {
+	conn.SetDeadline(time.Now().Add(5 * time.Second))
+	conn.Write([]byte(message))
+	conn.Close()
 }
 
 func loadAllDataFiles(isReload bool) {
@@ -956,6 +1096,8 @@ func loadAllDataFiles(isReload bool) {
 	templates.LoadAliases(plugins.GetPluginRegistry())
 	keywords.LoadAliases(plugins.GetPluginRegistry())
 	mutators.LoadDataFiles()
+	recipes.LoadDataFiles() // Load after items so recipe items can be validated
+	channels.LoadDataFiles()
 	colorpatterns.LoadColorPatterns()
 	audio.LoadAudioConfig()
 	characters.CompileAdjectiveSwaps() // This should come after loading color patterns.
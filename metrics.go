@@ -0,0 +1,73 @@
+package main
+
+import (
+	"sort"
+
+	"github.com/GoMudEngine/GoMud/internal/connections"
+	"github.com/GoMudEngine/GoMud/internal/events"
+	"github.com/GoMudEngine/GoMud/internal/metrics"
+	"github.com/GoMudEngine/GoMud/internal/mobs"
+	"github.com/GoMudEngine/GoMud/internal/rooms"
+	"github.com/GoMudEngine/GoMud/internal/scripting"
+	"github.com/GoMudEngine/GoMud/internal/users"
+)
+
+// Registers metrics for game state that is read when /metrics is scraped.
+// Scrapes hold the mud lock, so these can read straight from the game.
+func registerMetrics() {
+
+	metrics.GaugeFunc(`gomud_users_online`, `Players currently in the world (not counting zombies).`, func() []metrics.Sample {
+		return []metrics.Sample{{Value: float64(len(users.GetAllActiveUsers()))}}
+	})
+
+	metrics.CounterFunc(`gomud_connections_total`, `Connections accepted since the server started.`, func() []metrics.Sample {
+		connCt, _ := connections.Stats()
+		return []metrics.Sample{{Value: float64(connCt)}}
+	})
+
+	metrics.CounterFunc(`gomud_disconnections_total`, `Connections closed since the server started.`, func() []metrics.Sample {
+		_, disconnCt := connections.Stats()
+		return []metrics.Sample{{Value: float64(disconnCt)}}
+	})
+
+	metrics.GaugeFunc(`gomud_event_queue_depth`, `Events waiting to be processed, by event type.`, func() []metrics.Sample {
+		return mapSamples(`type`, events.QueueDepths())
+	})
+
+	metrics.GaugeFunc(`gomud_rooms_loaded`, `Rooms currently loaded in memory.`, func() []metrics.Sample {
+		return []metrics.Sample{{Value: float64(rooms.GetLoadedRoomCount())}}
+	})
+
+	metrics.GaugeFunc(`gomud_mob_instances`, `Mobs currently alive in the world.`, func() []metrics.Sample {
+		return []metrics.Sample{{Value: float64(len(mobs.GetAllMobInstanceIds()))}}
+	})
+
+	metrics.GaugeFunc(`gomud_script_vms`, `Script VMs currently loaded, by script type.`, func() []metrics.Sample {
+		counts := scripting.GetVMCounts()
+		// Always report every type, so graphs don't have gaps when one drops to zero
+		for _, scriptType := range []string{`room`, `mob`, `item`, `buff`, `spell`, `zone`} {
+			counts[scriptType] += 0
+		}
+		return mapSamples(`type`, counts)
+	})
+}
+
+// Turns a map into samples with the key as a label, sorted for stable output
+func mapSamples(labelName string, values map[string]int) []metrics.Sample {
+
+	keys := make([]string, 0, len(values))
+	for k := range values {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	samples := make([]metrics.Sample, 0, len(keys))
+	for _, k := range keys {
+		samples = append(samples, metrics.Sample{
+			Labels: []string{labelName, k},
+			Value:  float64(values[k]),
+		})
+	}
+
+	return samples
+}
@@ -13,6 +13,7 @@ import (
 	"github.com/GoMudEngine/GoMud/internal/mudlog"
 	"github.com/GoMudEngine/GoMud/internal/plugins"
 	"github.com/GoMudEngine/GoMud/internal/quests"
+	"github.com/GoMudEngine/GoMud/internal/recipes"
 	"github.com/GoMudEngine/GoMud/internal/rooms"
 	"github.com/GoMudEngine/GoMud/internal/skills"
 	"github.com/GoMudEngine/GoMud/internal/users"
@@ -49,6 +50,7 @@ func init() {
 	events.RegisterListener(events.BuffsTriggered{}, g.buffTriggeredHandler)
 
 	events.RegisterListener(events.Quest{}, g.questProgressHandler)
+	events.RegisterListener(events.RecipeLearned{}, g.recipeLearnedHandler)
 
 }
 
@@ -84,6 +86,25 @@ func (g *GMCPCharModule) questProgressHandler(e events.Event) events.ListenerRet
 	return events.Continue
 }
 
+func (g *GMCPCharModule) recipeLearnedHandler(e events.Event) events.ListenerReturn {
+
+	evt, typeOk := e.(events.RecipeLearned)
+	if !typeOk {
+		return events.Continue // Return false to stop halt the event chain for this event
+	}
+
+	if evt.UserId == 0 {
+		return events.Continue
+	}
+
+	events.AddToQueue(GMCPCharUpdate{
+		UserId:     evt.UserId,
+		Identifier: `Char.Recipes`,
+	})
+
+	return events.Continue
+}
+
 func (g *GMCPCharModule) buffTriggeredHandler(e events.Event) events.ListenerReturn {
 
 	evt, typeOk := e.(events.BuffsTriggered)
@@ -421,10 +442,7 @@ func (g *GMCPCharModule) GetCharNode(user *users.UserRecord, gmcpModule string)
 
 		payload.Inventory = &GMCPCharModule_Payload_Inventory{
 			Backpack: &GMCPCharModule_Payload_Inventory_Backpack{
-				Summary: GMCPCharModule_Payload_Inventory_Backpack_Summary{
-					Count: len(user.Character.Items),
-					Max:   user.Character.CarryCapacity(),
-				},
+				Summary: newInventory_Summary(user),
 			},
 		}
 
@@ -436,11 +454,8 @@ func (g *GMCPCharModule) GetCharNode(user *users.UserRecord, gmcpModule string)
 		payload.Inventory = &GMCPCharModule_Payload_Inventory{
 
 			Backpack: &GMCPCharModule_Payload_Inventory_Backpack{
-				Items: []GMCPCharModule_Payload_Inventory_Item{},
-				Summary: GMCPCharModule_Payload_Inventory_Backpack_Summary{
-					Count: len(user.Character.Items),
-					Max:   user.Character.CarryCapacity(),
-				},
+				Items:   []GMCPCharModule_Payload_Inventory_Item{},
+				Summary: newInventory_Summary(user),
 			},
 
 			Worn: &GMCPCharModule_Payload_Inventory_Worn{
@@ -475,12 +490,13 @@ func (g *GMCPCharModule) GetCharNode(user *users.UserRecord, gmcpModule string)
 	if all || g.wantsGMCPPayload(`Char.Stats`, gmcpModule) {
 
 		payload.Stats = &GMCPCharModule_Payload_Stats{
-			Strength:   user.Character.Stats.Strength.ValueAdj,
-			Speed:      user.Character.Stats.Speed.ValueAdj,
-			Smarts:     user.Character.Stats.Smarts.ValueAdj,
-			Vitality:   user.Character.Stats.Vitality.ValueAdj,
-			Mysticism:  user.Character.Stats.Mysticism.ValueAdj,
-			Perception: user.Character.Stats.Perception.ValueAdj,
+			Strength:    user.Character.Stats.Strength.ValueAdj,
+			Speed:       user.Character.Stats.Speed.ValueAdj,
+			Smarts:      user.Character.Stats.Smarts.ValueAdj,
+			Vitality:    user.Character.Stats.Vitality.ValueAdj,
+			Mysticism:   user.Character.Stats.Mysticism.ValueAdj,
+			Perception:  user.Character.Stats.Perception.ValueAdj,
+			Resistances: user.Character.GetResistances(),
 		}
 
 		if !all {
@@ -621,6 +637,49 @@ func (g *GMCPCharModule) GetCharNode(user *users.UserRecord, gmcpModule string)
 		}
 	}
 
+	if all || g.wantsGMCPPayload(`Char.Recipes`, gmcpModule) {
+
+		payload.Recipes = []GMCPCharModule_Payload_Recipe{}
+
+		for _, recipeId := range recipes.GetKnownRecipeIds(user.Character.Recipes) {
+
+			recipeInfo := recipes.GetRecipe(recipeId)
+			if recipeInfo == nil {
+				continue
+			}
+
+			recipePayload := GMCPCharModule_Payload_Recipe{
+				Id:          recipeInfo.RecipeId,
+				Name:        recipeInfo.Name,
+				Description: recipeInfo.Description,
+				Station:     recipeInfo.Station,
+				Inputs:      []string{},
+				Tools:       []string{},
+				Skills:      recipeInfo.Skills,
+			}
+
+			for _, input := range recipeInfo.Inputs {
+				if itemSpec := items.GetItemSpec(input.ItemId); itemSpec != nil {
+					recipePayload.Inputs = append(recipePayload.Inputs, strconv.Itoa(input.Quantity)+`x `+itemSpec.Name)
+				}
+			}
+
+			for _, tool := range recipeInfo.Tools {
+				recipePayload.Tools = append(recipePayload.Tools, tool.Name())
+			}
+
+			if itemSpec := items.GetItemSpec(recipeInfo.Output.ItemId); itemSpec != nil {
+				recipePayload.Output = itemSpec.Name
+			}
+
+			payload.Recipes = append(payload.Recipes, recipePayload)
+		}
+
+		if !all {
+			return payload.Recipes, `Char.Recipes`
+		}
+	}
+
 	// If we reached this point and Char wasn't requested, we have a problem.
 	if !all {
 		mudlog.Error(`gmcp.Char`, `error`, `Bad module requested`, `module`, gmcpModule)
@@ -656,6 +715,7 @@ type GMCPCharModule_Payload struct {
 	Vitals    *GMCPCharModule_Payload_Vitals           `json:"Vitals,omitempty"`
 	Worth     *GMCPCharModule_Payload_Worth            `json:"Worth,omitempty"`
 	Quests    []GMCPCharModule_Payload_Quest           `json:"Quests,omitempty"`
+	Recipes   []GMCPCharModule_Payload_Recipe          `json:"Recipes,omitempty"`
 	Pets      []GMCPCharModule_Payload_Pet             `json:"Pets,omitempty"`
 }
 
@@ -697,8 +757,11 @@ type GMCPCharModule_Payload_Inventory_Backpack struct {
 }
 
 type GMCPCharModule_Payload_Inventory_Backpack_Summary struct {
-	Count int `json:"count,omitempty"`
-	Max   int `json:"max,omitempty"`
+	Count       int    `json:"count,omitempty"`
+	Max         int    `json:"max,omitempty"`
+	Weight      int    `json:"weight,omitempty"`
+	WeightMax   int    `json:"weight_max,omitempty"`
+	Encumbrance string `json:"encumbrance,omitempty"`
 }
 
 type GMCPCharModule_Payload_Inventory_Worn struct {
@@ -715,12 +778,30 @@ type GMCPCharModule_Payload_Inventory_Worn struct {
 }
 
 type GMCPCharModule_Payload_Inventory_Item struct {
-	Id      string   `json:"id"`
-	Name    string   `json:"name"`
-	Type    string   `json:"type"`
-	SubType string   `json:"subtype"`
-	Uses    int      `json:"uses"`
-	Details []string `json:"details"`
+	Id       string                                  `json:"id"`
+	Name     string                                  `json:"name"`
+	Type     string                                  `json:"type"`
+	SubType  string                                  `json:"subtype"`
+	Uses     int                                     `json:"uses"`
+	Details  []string                                `json:"details"`
+	Capacity int                                     `json:"capacity,omitempty"` // Containers only
+	Contents []GMCPCharModule_Payload_Inventory_Item `json:"contents,omitempty"` // Containers only
+}
+
+func newInventory_Summary(user *users.UserRecord) GMCPCharModule_Payload_Inventory_Backpack_Summary {
+
+	s := GMCPCharModule_Payload_Inventory_Backpack_Summary{
+		Count: len(user.Character.Items),
+		Max:   user.Character.CarryCapacity(),
+	}
+
+	if configs.GetGamePlayConfig().Encumbrance.Enabled {
+		s.Weight = user.Character.CarryWeight()
+		s.WeightMax = user.Character.MaxCarryWeight()
+		s.Encumbrance = user.Character.GetEncumbrance().String()
+	}
+
+	return s
 }
 
 func newInventory_Item(itm items.Item) GMCPCharModule_Payload_Inventory_Item {
@@ -743,6 +824,18 @@ func newInventory_Item(itm items.Item) GMCPCharModule_Payload_Inventory_Item {
 		d.Details = append(d.Details, `quest`)
 	}
 
+	if itmSpec.Type == items.Container {
+		d.Capacity = itmSpec.Capacity
+		if itm.Lock.IsLocked() {
+			d.Details = append(d.Details, `locked`)
+		} else {
+			d.Contents = []GMCPCharModule_Payload_Inventory_Item{}
+			for _, content := range itm.Contents {
+				d.Contents = append(d.Contents, newInventory_Item(content))
+			}
+		}
+	}
+
 	return d
 }
 
@@ -750,12 +843,13 @@ func newInventory_Item(itm items.Item) GMCPCharModule_Payload_Inventory_Item {
 // Char.Stats
 // /////////////////
 type GMCPCharModule_Payload_Stats struct {
-	Strength   int `json:"strength,omitempty"`
-	Speed      int `json:"speed,omitempty"`
-	Smarts     int `json:"smarts,omitempty"`
-	Vitality   int `json:"vitality,omitempty"`
-	Mysticism  int `json:"mysticism,omitempty"`
-	Perception int `json:"perception,omitempty"`
+	Strength    int            `json:"strength,omitempty"`
+	Speed       int            `json:"speed,omitempty"`
+	Smarts      int            `json:"smarts,omitempty"`
+	Vitality    int            `json:"vitality,omitempty"`
+	Mysticism   int            `json:"mysticism,omitempty"`
+	Perception  int            `json:"perception,omitempty"`
+	Resistances map[string]int `json:"resistances,omitempty"` // element => % resisted. Negative is a vulnerability
 }
 
 // /////////////////
@@ -801,6 +895,20 @@ type GMCPCharModule_Payload_Quest struct {
 	Completion  int    `json:"completion"`
 }
 
+// /////////////////
+// Char.Recipes
+// /////////////////
+type GMCPCharModule_Payload_Recipe struct {
+	Id          string         `json:"id"`
+	Name        string         `json:"name"`
+	Description string         `json:"description"`
+	Station     string         `json:"station,omitempty"`
+	Inputs      []string       `json:"inputs"`
+	Tools       []string       `json:"tools,omitempty"`
+	Skills      map[string]int `json:"skills,omitempty"`
+	Output      string         `json:"output"`
+}
+
 // /////////////////
 // Char.Pets
 // /////////////////
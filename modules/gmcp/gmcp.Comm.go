@@ -1,6 +1,7 @@
 package gmcp
 
 import (
+	"github.com/GoMudEngine/GoMud/internal/channels"
 	"github.com/GoMudEngine/GoMud/internal/events"
 	"github.com/GoMudEngine/GoMud/internal/mobs"
 	"github.com/GoMudEngine/GoMud/internal/mudlog"
@@ -45,8 +46,13 @@ func (g *GMCPCommModule) onComm(e events.Event) events.ListenerReturn {
 		return events.Cancel
 	}
 
+	channelName := evt.CommType
+	if evt.CommType == `channel` {
+		channelName = evt.ChannelId
+	}
+
 	payload := GMCPCommModule_Payload{
-		Channel: evt.CommType,
+		Channel: channelName,
 		Sender:  evt.Name,
 		Text:    ansitags.Parse(evt.Message, ansitags.StripTags),
 	}
@@ -58,7 +64,7 @@ func (g *GMCPCommModule) onComm(e events.Event) events.ListenerReturn {
 	}
 
 	// Sent to everyone.
-	// say, party, broadcast, whisper
+	// say, party, broadcast, whisper, channel
 
 	sendToUserIds := []int{}
 
@@ -102,6 +108,24 @@ func (g *GMCPCommModule) onComm(e events.Event) events.ListenerReturn {
 			sendToUserIds = append(sendToUserIds, evt.TargetUserId)
 		}
 
+	} else if evt.CommType == `channel` {
+
+		sourceIsMod := false
+		if sourceUser := users.GetByUserId(evt.SourceUserId); sourceUser != nil {
+			sourceIsMod = sourceUser.Role != users.RoleUser
+		}
+
+		if c := channels.GetChannel(evt.ChannelId); c != nil {
+			for _, u := range users.GetAllActiveUsers() {
+				if u.Deafened && !sourceIsMod {
+					continue
+				}
+				if u.HearsChannel(c) {
+					sendToUserIds = append(sendToUserIds, u.UserId)
+				}
+			}
+		}
+
 	}
 
 	for _, userId := range sendToUserIds {
@@ -36,6 +36,7 @@ func init() {
 	events.RegisterListener(events.RoomChange{}, g.roomChangeHandler)
 	events.RegisterListener(events.PlayerDespawn{}, g.despawnHandler)
 	events.RegisterListener(GMCPRoomUpdate{}, g.buildAndSendGMCPPayload)
+	events.RegisterListener(events.WeatherChange{}, g.weatherChangeHandler)
 
 }
 
@@ -94,6 +95,33 @@ func (g *GMCPRoomModule) despawnHandler(e events.Event) events.ListenerReturn {
 	return events.Continue
 }
 
+// Resends Room.Info to players out in the weather when it changes
+func (g *GMCPRoomModule) weatherChangeHandler(e events.Event) events.ListenerReturn {
+
+	evt, typeOk := e.(events.WeatherChange)
+	if !typeOk {
+		mudlog.Error("Event", "Expected Type", "WeatherChange", "Actual Type", e.Type())
+		return events.Cancel
+	}
+
+	for _, roomId := range rooms.GetRoomsWithPlayers() {
+
+		room := rooms.LoadRoom(roomId)
+		if room == nil || room.Zone != evt.Zone || !room.IsOutdoors() {
+			continue
+		}
+
+		for _, uid := range room.GetPlayers() {
+			events.AddToQueue(GMCPRoomUpdate{
+				UserId:     uid,
+				Identifier: `Room.Info`,
+			})
+		}
+	}
+
+	return events.Continue
+}
+
 func (g *GMCPRoomModule) roomChangeHandler(e events.Event) events.ListenerReturn {
 
 	evt, typeOk := e.(events.RoomChange)
@@ -353,6 +381,7 @@ func (g *GMCPRoomModule) GetRoomNode(user *users.UserRecord, gmcpModule string)
 		payload.Name = room.Title
 		payload.Area = room.Zone
 		payload.Environment = room.GetBiome().Name
+		payload.Weather = room.GetWeather()
 		payload.Details = []string{}
 
 		// Coordinates
@@ -479,6 +508,7 @@ type GMCPRoomModule_Payload struct {
 	Name        string                                              `json:"name"`
 	Area        string                                              `json:"area"`
 	Environment string                                              `json:"environment"`
+	Weather     string                                              `json:"weather"`
 	Coordinates string                                              `json:"coords"`
 	Exits       map[string]int                                      `json:"exits"`
 	ExitsV2     map[string]GMCPRoomModule_Payload_Contents_ExitInfo `json:"exitsv2"`
@@ -58,6 +58,8 @@ func init() {
 
 	gmcpModule.plug.ExportFunction(`SendGMCPEvent`, gmcpModule.sendGMCPEvent)
 	gmcpModule.plug.ExportFunction(`IsMudlet`, gmcpModule.IsMudletExportedFunction)
+	gmcpModule.plug.ExportFunction(`GetGMCPSettings`, gmcpModule.getSettingsExportedFunction)
+	gmcpModule.plug.ExportFunction(`SetGMCPSettings`, gmcpModule.setSettingsExportedFunction)
 
 	gmcpModule.plug.Callbacks.SetIACHandler(gmcpModule.HandleIAC)
 	gmcpModule.plug.Callbacks.SetOnNetConnect(gmcpModule.onNetConnect)
@@ -137,6 +139,30 @@ func (g *GMCPModule) IsMudletExportedFunction(connectionId uint64) bool {
 	return gmcpData.IsMudlet()
 }
 
+// Returns the connection's GMCP settings as JSON, so they can be carried across a copyover
+func (g *GMCPModule) getSettingsExportedFunction(connectionId uint64) string {
+	gmcpData, ok := g.cache.Get(connectionId)
+	if !ok {
+		return ``
+	}
+
+	b, err := json.Marshal(gmcpData)
+	if err != nil {
+		return ``
+	}
+	return string(b)
+}
+
+// Restores settings previously returned by getSettingsExportedFunction()
+func (g *GMCPModule) setSettingsExportedFunction(connectionId uint64, settingsJson string) {
+	gmcpData := GMCPSettings{}
+	if err := json.Unmarshal([]byte(settingsJson), &gmcpData); err != nil {
+		mudlog.Error("GMCP", "connectionId", connectionId, "error", err)
+		return
+	}
+	g.cache.Add(connectionId, gmcpData)
+}
+
 func (g *GMCPModule) onNetConnect(n plugins.NetConnection) {
 
 	if n.IsWebSocket() {
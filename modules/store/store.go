@@ -0,0 +1,166 @@
+package store
+
+import (
+	"encoding/json"
+	"os"
+	"sort"
+
+	"github.com/GoMudEngine/GoMud/internal/mudlog"
+	"github.com/GoMudEngine/GoMud/internal/plugins"
+)
+
+// ////////////////////////////////////////////////////////////////////
+// NOTE: The init function in Go is a special function that is
+// automatically executed before the main function within a package.
+// It is used to initialize variables, set up configurations, or
+// perform any other setup tasks that need to be done before the
+// program starts running.
+// ////////////////////////////////////////////////////////////////////
+func init() {
+
+	s := StoreModule{
+		plug: plugins.New(`store`, `1.0`),
+		data: map[string]map[string]any{},
+	}
+
+	//
+	// Register callbacks for load/unload
+	//
+	s.plug.Callbacks.SetOnLoad(s.load)
+	s.plug.Callbacks.SetOnSave(s.save)
+
+	//
+	// Register any scripting functions
+	//
+	// Will be available in scripts as:
+	// modules.store.Get(namespace, key)
+	s.plug.AddScriptingFunction(`Get`, s.Scripting_Get)
+	s.plug.AddScriptingFunction(`Set`, s.Scripting_Set)
+	s.plug.AddScriptingFunction(`Delete`, s.Scripting_Delete)
+	s.plug.AddScriptingFunction(`Keys`, s.Scripting_Keys)
+}
+
+//////////////////////////////////////////////////////////////////////
+// NOTE: What follows is all custom code. For this module.
+//////////////////////////////////////////////////////////////////////
+
+// A world-wide key/value store for scripts, saved with the rest of the plugin data.
+// Namespaces keep unrelated scripts from stepping on each other's keys.
+type StoreModule struct {
+	// Keep a reference to the plugin when we create it so that we can call ReadBytes() and WriteBytes() on it.
+	plug *plugins.Plugin
+
+	data map[string]map[string]any // namespace => key => value
+}
+
+func (s *StoreModule) load() {
+
+	b, err := s.plug.ReadBytes(`store`)
+	if err != nil {
+		if !os.IsNotExist(err) {
+			mudlog.Error("store", "action", "load", "error", err)
+		}
+		return
+	}
+
+	if err := json.Unmarshal(b, &s.data); err != nil {
+		mudlog.Error("store", "action", "load", "error", err)
+	}
+
+	if s.data == nil {
+		s.data = map[string]map[string]any{}
+	}
+}
+
+func (s *StoreModule) save() {
+
+	b, err := json.MarshalIndent(s.data, ``, "\t")
+	if err != nil {
+		mudlog.Error("store", "action", "save", "error", err)
+		return
+	}
+
+	s.plug.WriteBytes(`store`, b)
+}
+
+// Intended to be invoked by a script.
+// Returns null if nothing is stored under the key.
+func (s *StoreModule) Scripting_Get(namespace string, key string) any {
+
+	value, ok := s.data[namespace][key]
+	if !ok {
+		return nil
+	}
+
+	// A copy, so changing it in a script doesn't quietly change what's stored
+	valueCopy, _ := copyValue(value)
+
+	return valueCopy
+}
+
+// Intended to be invoked by a script.
+// Values must be plain data (numbers, strings, bools, arrays and objects).
+// Returns false if the value could not be stored.
+func (s *StoreModule) Scripting_Set(namespace string, key string, value any) bool {
+
+	if value == nil {
+		s.Scripting_Delete(namespace, key)
+		return true
+	}
+
+	valueCopy, err := copyValue(value)
+	if err != nil {
+		mudlog.Error("store", "action", "Set", "namespace", namespace, "key", key, "error", err)
+		return false
+	}
+
+	if _, ok := s.data[namespace]; !ok {
+		s.data[namespace] = map[string]any{}
+	}
+	s.data[namespace][key] = valueCopy
+
+	return true
+}
+
+// Intended to be invoked by a script.
+// Returns false if there was nothing to delete.
+func (s *StoreModule) Scripting_Delete(namespace string, key string) bool {
+
+	if _, ok := s.data[namespace][key]; !ok {
+		return false
+	}
+
+	delete(s.data[namespace], key)
+	if len(s.data[namespace]) == 0 {
+		delete(s.data, namespace)
+	}
+
+	return true
+}
+
+// Intended to be invoked by a script.
+// Returns the keys in a namespace, sorted.
+func (s *StoreModule) Scripting_Keys(namespace string) []string {
+
+	keys := make([]string, 0, len(s.data[namespace]))
+	for k := range s.data[namespace] {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	return keys
+}
+
+// Deep copies a value by round tripping it through json, which is also how it's saved.
+func copyValue(value any) (any, error) {
+
+	b, err := json.Marshal(value)
+	if err != nil {
+		return nil, err
+	}
+
+	var valueCopy any
+	err = json.Unmarshal(b, &valueCopy)
+
+	return valueCopy, err
+}
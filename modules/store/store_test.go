@@ -0,0 +1,60 @@
+package store
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func newTestStore() *StoreModule {
+	return &StoreModule{
+		data: map[string]map[string]any{},
+	}
+}
+
+func TestStore_NamespaceIsolation(t *testing.T) {
+
+	s := newTestStore()
+
+	assert.True(t, s.Scripting_Set(`zone-frostfang`, `invaded`, true))
+	assert.True(t, s.Scripting_Set(`zone-mystarion`, `invaded`, false))
+
+	assert.Equal(t, true, s.Scripting_Get(`zone-frostfang`, `invaded`))
+	assert.Equal(t, false, s.Scripting_Get(`zone-mystarion`, `invaded`))
+	assert.Nil(t, s.Scripting_Get(`zone-whispers`, `invaded`))
+
+	assert.True(t, s.Scripting_Delete(`zone-frostfang`, `invaded`))
+	assert.Nil(t, s.Scripting_Get(`zone-frostfang`, `invaded`))
+	assert.Equal(t, false, s.Scripting_Get(`zone-mystarion`, `invaded`))
+
+	assert.Equal(t, []string{}, s.Scripting_Keys(`zone-frostfang`))
+	assert.Equal(t, []string{`invaded`}, s.Scripting_Keys(`zone-mystarion`))
+}
+
+func TestStore_GetReturnsCopy(t *testing.T) {
+
+	s := newTestStore()
+
+	s.Scripting_Set(`quest`, `progress`, map[string]any{`wolves`: 3})
+
+	value, ok := s.Scripting_Get(`quest`, `progress`).(map[string]any)
+	if !assert.True(t, ok) {
+		return
+	}
+	value[`wolves`] = 10
+
+	// Numbers come back as float64, since values are round tripped through json
+	assert.Equal(t, map[string]any{`wolves`: float64(3)}, s.Scripting_Get(`quest`, `progress`))
+}
+
+func TestStore_SetNilDeletes(t *testing.T) {
+
+	s := newTestStore()
+
+	s.Scripting_Set(`quest`, `progress`, 5)
+	assert.True(t, s.Scripting_Set(`quest`, `progress`, nil))
+
+	assert.Nil(t, s.Scripting_Get(`quest`, `progress`))
+	assert.Equal(t, []string{}, s.Scripting_Keys(`quest`))
+	assert.False(t, s.Scripting_Delete(`quest`, `progress`))
+}
@@ -0,0 +1,168 @@
+package main
+
+import (
+	"os"
+	"testing"
+
+	"github.com/GoMudEngine/GoMud/internal/items"
+	"github.com/GoMudEngine/GoMud/internal/rooms"
+	"github.com/GoMudEngine/GoMud/internal/scripting"
+	"github.com/GoMudEngine/GoMud/internal/users"
+	"github.com/GoMudEngine/GoMud/internal/util"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestScenario_KillRatAndLoot(t *testing.T) {
+
+	h := newTestHarness(t)
+
+	p := h.NewPlayer(`Ratcatcher`, 1)
+	assert.Contains(t, p.Output(), `Town Square`)
+
+	// Strong enough that the rat can't win
+	util.LockMud()
+	p.User().Character.Level = 10
+	p.User().Character.StatPoints = 10
+	p.User().Character.AutoTrain()
+	p.User().Character.Wear(items.New(10002))
+	p.User().Character.Validate(true)
+	p.User().Character.Health = p.User().Character.HealthMax.Value
+	util.UnlockMud()
+
+	p.Input(`north`)
+	assert.Contains(t, p.Output(), `Cobblestone Way`)
+
+	rat := h.SpawnMob(1, 2)
+	rat.Character.Gold = 7
+	potion := items.New(30001)
+	rat.Character.StoreItem(potion)
+
+	goldBefore := p.User().Character.Gold
+
+	p.Input(`attack ` + rat.ShorthandId())
+
+	out, ok := p.WaitFor(`gold drops to the ground`, 50)
+	if !assert.True(t, ok, "rat never died:\n%s", out) {
+		return
+	}
+	assert.Contains(t, out, `drops to the ground`)
+
+	p.Input(`get gold`)
+	assert.Equal(t, goldBefore+7, p.User().Character.Gold)
+
+	p.Input(`get all`)
+	h.Turns(1)
+
+	p.Input(`inventory`)
+	assert.Contains(t, p.Output(), potion.Name())
+}
+
+func TestScenario_PlayersSeeEachOther(t *testing.T) {
+
+	h := newTestHarness(t)
+
+	alice := h.NewPlayer(`Alicia`, 1)
+	bob := h.NewPlayer(`Roberto`, 1)
+
+	alice.Output()
+	bob.Output()
+
+	alice.Input(`say hello there`)
+	assert.Contains(t, bob.Output(), `hello there`)
+
+	bob.Input(`east`)
+	assert.Contains(t, alice.Output(), `Roberto`)
+
+	alice.Input(`look`)
+	assert.NotContains(t, alice.Output(), `Roberto`)
+}
+
+func TestScenario_ZoneScriptEvents(t *testing.T) {
+
+	zoneConfig := rooms.GetZoneConfig(rooms.LoadRoom(1).Zone)
+	scriptPath := zoneConfig.GetScriptPath()
+
+	// Keeps track of who is in the zone, and handles a couple of commands the room doesn't
+	err := os.WriteFile(scriptPath, []byte(`
+var present = {};
+
+function onZoneEnter(user, fromRoom, toRoom, zone) {
+    present[user.GetCharacterName(false)] = true;
+}
+
+function onZoneExit(user, fromRoom, toRoom, zone) {
+    delete present[user.GetCharacterName(false)];
+}
+
+function onCommand_zoneplayers(rest, user, room, zone) {
+    user.SendText("Zone players: " + Object.keys(present).sort().join(", "));
+    return true;
+}
+
+function onCommand(cmd, rest, user, room, zone) {
+    if (cmd == "read") {
+        user.SendText("The zone reads " + rest);
+        return true;
+    }
+    return false;
+}
+`), 0644)
+	if !assert.NoError(t, err) {
+		return
+	}
+	scripting.ReloadZoneScript(zoneConfig.Name)
+
+	t.Cleanup(func() {
+		os.Remove(scriptPath)
+		scripting.ReloadZoneScript(zoneConfig.Name)
+	})
+
+	h := newTestHarness(t)
+
+	zoner := h.NewPlayer(`Zoner`, 1)
+	visitor := h.NewPlayer(`Zonevisitor`, 1)
+
+	zoner.Output()
+
+	zoner.Input(`zoneplayers`)
+	assert.Contains(t, zoner.Output(), `Zone players: Zoner, Zonevisitor`)
+
+	// The room script handles reading the sign, so the zone never sees it
+	zoner.Input(`read sign`)
+	out := zoner.Output()
+	assert.Contains(t, out, `You look at the map nailed to the sign.`)
+	assert.NotContains(t, out, `The zone reads`)
+
+	// Anything else falls back to the zone script
+	zoner.Input(`read book`)
+	assert.Contains(t, zoner.Output(), `The zone reads book`)
+
+	// Leaving the world counts as leaving the zone
+	h.Despawn(visitor)
+	zoner.Output()
+
+	zoner.Input(`zoneplayers`)
+	out = zoner.Output()
+	assert.Contains(t, out, `Zone players: Zoner`)
+	assert.NotContains(t, out, `Zonevisitor`)
+}
+
+func TestScenario_ScriptEval(t *testing.T) {
+
+	h := newTestHarness(t)
+
+	p := h.NewPlayer(`Evaluator`, 1)
+
+	util.LockMud()
+	p.User().Role = users.RoleAdmin
+	util.UnlockMud()
+
+	p.Output()
+
+	// Extra spaces between the leading words are fine, and spacing inside the code is kept
+	p.Input(`script eval  room   here   "a  b".length`)
+	assert.Contains(t, p.Output(), "4\n")
+
+	p.Input(`script eval room here`)
+	assert.Contains(t, p.Output(), `Use script eval room`)
+}
@@ -10,6 +10,7 @@ import (
 	"time"
 
 	"github.com/GoMudEngine/GoMud/internal/badinputtracker"
+	"github.com/GoMudEngine/GoMud/internal/clans"
 	"github.com/GoMudEngine/GoMud/internal/configs"
 	"github.com/GoMudEngine/GoMud/internal/connections"
 	"github.com/GoMudEngine/GoMud/internal/events"
@@ -205,6 +206,24 @@ func (w *World) HandleSystemEvents(e events.Event) events.ListenerReturn {
 			SkipLineRefresh: true,
 		})
 
+	} else if sys.Command == `copyover` {
+
+		// Only comes back if it failed
+		if err := w.copyover(); err != nil {
+
+			mudlog.Error("Copyover", "error", err)
+
+			events.AddToQueue(events.Broadcast{
+				Text: `The world steadies itself. (Copyover failed)` + term.CRLFStr,
+			})
+
+			if userId, ok := sys.Data.(int); ok {
+				if user := users.GetByUserId(userId); user != nil {
+					user.SendText(`<ansi fg="red-bold">Copyover failed:</ansi> ` + err.Error())
+				}
+			}
+		}
+
 	} else if sys.Command == `kick` {
 		w.Kick(sys.Data.(int), sys.Description)
 	} else if sys.Command == `leaveworld` {
@@ -794,17 +813,7 @@ loop:
 			util.LockMud()
 			turnTimer.Reset(time.Duration(c.Timing.TurnMs) * time.Millisecond)
 
-			turnCt := util.IncrementTurnCount()
-
-			events.AddToQueue(events.NewTurn{TurnNumber: turnCt, TimeNow: time.Now()})
-
-			// After a full round of turns, we can do a round tick.
-			if turnCt%uint64(c.Timing.TurnsPerRound()) == 0 {
-
-				roundNumber := util.IncrementRoundCount()
-
-				events.AddToQueue(events.NewRound{RoundNumber: roundNumber, TimeNow: time.Now()})
-			}
+			w.advanceTurn(c)
 
 			util.UnlockMud()
 
@@ -866,14 +875,37 @@ loop:
 			mudlog.Warn(`InputWorker`, `action`, `shutdown received`)
 			break loop
 		case wi := <-w.worldInput:
+			w.queueInput(wi)
+		}
+	}
+}
 
-			events.AddToQueue(events.Input{
-				UserId:    wi.FromId,
-				InputText: wi.InputText,
-				ReadyTurn: util.GetTurnCount(),
-			})
+func (w *World) queueInput(wi WorldInput) {
+	events.AddToQueue(events.Input{
+		UserId:    wi.FromId,
+		InputText: wi.InputText,
+		ReadyTurn: util.GetTurnCount(),
+	})
+}
+
+// Moves the game forward one turn, and a round once enough turns have passed.
+// Only queues up the events, EventLoop() does the work.
+func (w *World) advanceTurn(c configs.Config) {
 
+	turnCt := util.IncrementTurnCount()
+
+	events.AddToQueue(events.NewTurn{TurnNumber: turnCt, TimeNow: time.Now()})
+
+	// After a full round of turns, we can do a round tick.
+	if turnCt%uint64(c.Timing.TurnsPerRound()) == 0 {
+
+		roundNumber := util.IncrementRoundCount()
+
+		if c.Server.RandomDebug {
+			mudlog.Info("RNG", "round", roundNumber, "seed", util.SeedRoundRand(roundNumber))
 		}
+
+		events.AddToQueue(events.NewRound{RoundNumber: roundNumber, TimeNow: time.Now()})
 	}
 }
 
@@ -1050,6 +1082,8 @@ func (w *World) UpdateStats() {
 		return s.OnlineUsers[i].OnlineTime > s.OnlineUsers[j].OnlineTime
 	})
 
+	s.Clans = clans.GetLeaderboard()
+
 	for _, t := range c.TelnetPort {
 		p, _ := strconv.Atoi(t)
 		if p > 0 {
@@ -1057,6 +1091,7 @@ func (w *World) UpdateStats() {
 		}
 	}
 
+	s.TelnetTLSPort = int(c.TelnetTLSPort)
 	s.WebSocketPort = int(c.HttpPort)
 
 	web.UpdateStats(s)